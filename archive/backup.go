@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -17,6 +18,24 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// offlineBackupLogInterval is the number of the blocks between the progress logs of the offline backup
+const offlineBackupLogInterval = 10000
+
+var (
+	errNoNewBlocks      = errors.New("no new blocks since the previous backup")
+	errPreviousDiverged = errors.New("the chain does not contain the latest block of the previous backup")
+)
+
+// BackupOptions are the options of the backup
+type BackupOptions struct {
+	// Compress compresses the backup with zstd
+	Compress bool
+
+	// Previous is the metadata of the backup the new one continues (incremental backup).
+	// The new backup starts after its latest block, which has to be in the chain
+	Previous *Metadata
+}
+
 // CreateBackup fetches blockchain data with the specific range via gRPC
 // and save this data as binary archive to given path
 func CreateBackup(
@@ -25,34 +44,14 @@ func CreateBackup(
 	from uint64,
 	to *uint64,
 	outPath string,
+	opts BackupOptions,
 ) (uint64, uint64, error) {
 	// always create new file, throw error if the file exists
-	fs, err := os.OpenFile(outPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	fs, err := createBackupFile(outPath, opts.Compress)
 	if err != nil {
 		return 0, 0, err
 	}
 
-	closeFile := func() error {
-		if err := fs.Close(); err != nil {
-			logger.Error("an error occurred while closing file", "err", err)
-
-			return err
-		}
-
-		return nil
-	}
-	removeFile := func() {
-		if err := os.Remove(outPath); err != nil {
-			logger.Error("an error occurred while removing file", "err", err)
-		}
-	}
-	// clean up function for the file when error occurs in the middle of function
-	closeAndRemoveFile := func() {
-		if err := closeFile(); err == nil {
-			removeFile()
-		}
-	}
-
 	signalCh := common.GetTerminationSignalCh()
 	ctx, cancelFn := context.WithCancel(context.Background())
 
@@ -66,38 +65,54 @@ func CreateBackup(
 
 	clt := proto.NewSystemClient(conn)
 
+	if opts.Previous != nil {
+		from = opts.Previous.Latest + 1
+
+		if err := checkPreviousBackup(ctx, clt, opts.Previous); err != nil {
+			fs.discard(logger)
+
+			return 0, 0, err
+		}
+	}
+
 	reqTo, reqToHash, err := determineTo(ctx, clt, to)
 	if err != nil {
-		closeAndRemoveFile()
+		fs.discard(logger)
 
 		return 0, 0, err
 	}
 
+	if opts.Previous != nil && reqTo < from {
+		fs.discard(logger)
+
+		return 0, 0, errNoNewBlocks
+	}
+
 	stream, err := clt.Export(ctx, &proto.ExportRequest{
 		From: from,
 		To:   reqTo,
 	})
 	if err != nil {
-		closeAndRemoveFile()
+		fs.discard(logger)
 
 		return 0, 0, err
 	}
 
 	if err := writeMetadata(fs, logger, reqTo, reqToHash); err != nil {
-		closeAndRemoveFile()
+		fs.discard(logger)
 
 		return 0, 0, err
 	}
 
 	resFrom, resTo, err := processExportStream(stream, logger, fs, from, reqTo)
 	if err != nil {
-		closeAndRemoveFile()
+		fs.discard(logger)
 
 		return 0, 0, err
 	}
 
-	if err := closeFile(); err != nil {
-		removeFile()
+	if err := fs.close(); err != nil {
+		_ = os.Remove(outPath)
 
 		return 0, 0, err
 	}
@@ -105,6 +120,155 @@ func CreateBackup(
 	return *resFrom, *resTo, nil
 }
 
+// checkPreviousBackup checks the latest block of the previous backup is in the chain of the node
+func checkPreviousBackup(ctx context.Context, clt proto.SystemClient, previous *Metadata) error {
+	resp, err := clt.BlockByNumber(ctx, &proto.BlockByNumberRequest{Number: previous.Latest})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errPreviousDiverged, err)
+	}
+
+	block := types.Block{}
+	if err := block.UnmarshalRLP(resp.Data); err != nil {
+		return err
+	}
+
+	if block.Hash() != previous.LatestHash {
+		return fmt.Errorf("%w: block %d is %s, expected %s",
+			errPreviousDiverged, previous.Latest, block.Hash(), previous.LatestHash)
+	}
+
+	return nil
+}
+
+// CreateOfflineBackup reads blockchain data with the specific range directly from the storage of a stopped node
+// and save this data as binary archive to given path
+func CreateOfflineBackup(
+	db storage.Storage,
+	logger hclog.Logger,
+	from uint64,
+	to *uint64,
+	outPath string,
+	opts BackupOptions,
+) (uint64, uint64, error) {
+	head, ok := db.ReadHeadNumber()
+	if !ok {
+		return 0, 0, errors.New("the chain is empty")
+	}
+
+	if opts.Previous != nil {
+		from = opts.Previous.Latest + 1
+
+		hash, ok := db.ReadCanonicalHash(opts.Previous.Latest)
+		if !ok || hash != opts.Previous.LatestHash {
+			return 0, 0, fmt.Errorf("%w: block %d is %s, expected %s",
+				errPreviousDiverged, opts.Previous.Latest, hash, opts.Previous.LatestHash)
+		}
+	}
+
+	latest := head
+	if to != nil && *to < head {
+		latest = *to
+	}
+
+	if from > latest {
+		if opts.Previous != nil {
+			return 0, 0, errNoNewBlocks
+		}
+
+		return 0, 0, fmt.Errorf("from %d is above the latest block %d", from, latest)
+	}
+
+	latestHash, ok := db.ReadCanonicalHash(latest)
+	if !ok {
+		return 0, 0, fmt.Errorf("block %d not found", latest)
+	}
+
+	// always create new file, throw error if the file exists
+	fs, err := createBackupFile(outPath, opts.Compress)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := writeMetadata(fs, logger, latest, latestHash); err != nil {
+		fs.discard(logger)
+
+		return 0, 0, err
+	}
+
+	signalCh := common.GetTerminationSignalCh()
+
+	for n := from; n <= latest; n++ {
+		block, err := readCanonicalBlock(db, n)
+		if err != nil {
+			fs.discard(logger)
+
+			return 0, 0, err
+		}
+
+		if _, err := fs.Write(block.MarshalRLP()); err != nil {
+			fs.discard(logger)
+
+			return 0, 0, err
+		}
+
+		if written := n - from + 1; written%offlineBackupLogInterval == 0 || n == latest {
+			logger.Info(
+				fmt.Sprintf("%d blocks are written", written),
+				"from", from,
+				"to", latest,
+				"progress", fmt.Sprintf("%.2f%%", 100*float64(written)/float64(latest-from+1)),
+			)
+		}
+
+		select {
+		case <-signalCh:
+			logger.Info("Caught termination signal, shutting down...")
+			fs.discard(logger)
+
+			return 0, 0, errors.New("backup interrupted")
+		default:
+		}
+	}
+
+	if err := fs.close(); err != nil {
+		_ = os.Remove(outPath)
+
+		return 0, 0, err
+	}
+
+	return from, latest, nil
+}
+
+// readCanonicalBlock reads the canonical block with the given number from the storage
+func readCanonicalBlock(db storage.Storage, n uint64) (*types.Block, error) {
+	hash, ok := db.ReadCanonicalHash(n)
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", n)
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header of block %d: %w", n, err)
+	}
+
+	block := &types.Block{Header: header}
+
+	// the body of the genesis block is not stored
+	if n == 0 {
+		return block, nil
+	}
+
+	body, err := db.ReadBody(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the body of block %d: %w", n, err)
+	}
+
+	block.Transactions = body.Transactions
+	block.Uncles = body.Uncles
+
+	return block, nil
+}
+
 func determineTo(ctx context.Context, clt proto.SystemClient, to *uint64) (uint64, types.Hash, error) {
 	status, err := clt.GetStatus(ctx, &emptypb.Empty{})
 	if err != nil {
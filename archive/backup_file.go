@@ -0,0 +1,129 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/klauspost/compress/zstd"
+)
+
+// zstdMagic is the magic number of the zstd frame, the compressed backups start with it
+var zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// backupFile is the new backup file, optionally compressed with zstd
+type backupFile struct {
+	path    string
+	file    *os.File
+	buf     *bufio.Writer
+	encoder *zstd.Encoder
+	out     io.Writer
+}
+
+// createBackupFile creates the new backup file, it throws error if the file exists
+func createBackupFile(path string, compress bool) (*backupFile, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &backupFile{
+		path: path,
+		file: file,
+		buf:  bufio.NewWriterSize(file, 1024*1024),
+	}
+
+	f.out = f.buf
+
+	if compress {
+		if f.encoder, err = zstd.NewWriter(f.buf); err != nil {
+			_ = file.Close()
+			_ = os.Remove(path)
+
+			return nil, err
+		}
+
+		f.out = f.encoder
+	}
+
+	return f, nil
+}
+
+func (f *backupFile) Write(p []byte) (int, error) {
+	return f.out.Write(p)
+}
+
+// close flushes the written data and closes the file
+func (f *backupFile) close() error {
+	if f.encoder != nil {
+		if err := f.encoder.Close(); err != nil {
+			_ = f.file.Close()
+
+			return err
+		}
+	}
+
+	if err := f.buf.Flush(); err != nil {
+		_ = f.file.Close()
+
+		return err
+	}
+
+	return f.file.Close()
+}
+
+// discard closes and removes the file, it is used when the backup fails in the middle
+func (f *backupFile) discard(logger hclog.Logger) {
+	if f.encoder != nil {
+		_ = f.encoder.Close()
+	}
+
+	if err := f.file.Close(); err != nil {
+		logger.Error("an error occurred while closing file", "err", err)
+	}
+
+	if err := os.Remove(f.path); err != nil {
+		logger.Error("an error occurred while removing file", "err", err)
+	}
+}
+
+// openBackupFile opens the backup file, the compressed backups are decompressed on the fly
+func openBackupFile(path string) (io.ReadCloser, bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	in := bufio.NewReaderSize(file, 1024*1024)
+
+	// the backups too short for the magic number are read as is
+	if magic, _ := in.Peek(len(zstdMagic)); !bytes.Equal(magic, zstdMagic) {
+		return &backupReader{Reader: in, file: file}, false, nil
+	}
+
+	decoder, err := zstd.NewReader(in)
+	if err != nil {
+		_ = file.Close()
+
+		return nil, false, err
+	}
+
+	return &backupReader{Reader: decoder, file: file, decoder: decoder}, true, nil
+}
+
+// backupReader reads the backup file
+type backupReader struct {
+	io.Reader
+	file    *os.File
+	decoder *zstd.Decoder
+}
+
+func (r *backupReader) Close() error {
+	if r.decoder != nil {
+		r.decoder.Close()
+	}
+
+	return r.file.Close()
+}
@@ -28,11 +28,13 @@ type blockchainInterface interface {
 
 // RestoreChain reads blocks from the archive and write to the chain
 func RestoreChain(chain blockchainInterface, filePath string, progression *progress.ProgressionWrapper) error {
-	fp, err := os.Open(filePath)
+	fp, _, err := openBackupFile(filePath)
 	if err != nil {
 		return err
 	}
 
+	defer fp.Close()
+
 	blockStream := newBlockStream(fp)
 
 	return importBlocks(chain, blockStream, progression)
@@ -48,7 +50,7 @@ func importBlocks(chain blockchainInterface, blockStream *blockStream, progressi
 	}
 
 	if metadata == nil {
-		return errors.New("expected metadata in archive but doesn't exist")
+		return errBackupNoMetadata
 	}
 
 	// check whether the local chain has the latest block already
@@ -67,6 +69,14 @@ func importBlocks(chain blockchainInterface, blockStream *blockStream, progressi
 		return nil
 	}
 
+	// the incremental backups are restored after the backups they continue
+	if parent := firstBlock.Number() - 1; chain.GetHashByNumber(parent) != firstBlock.ParentHash() {
+		return fmt.Errorf(
+			"block %d is not in the chain, the backup it is included in has to be restored first",
+			parent,
+		)
+	}
+
 	// Create a blockchain subscription for the sync progression and start tracking
 	progression.StartProgression(firstBlock.Number(), chain.SubscribeEvents())
 	// Stop monitoring the sync progression upon exit
@@ -241,17 +251,15 @@ func (b *blockStream) loadPrefixSize(offset uint64, prefix byte) (uint64, uint64
 
 		b.reserveCap(offset + payloadSizeSize)
 		payloadSizeBytes := b.buffer[offset : offset+payloadSizeSize]
-		n, err := b.input.Read(payloadSizeBytes)
+		if _, err := io.ReadFull(b.input, payloadSizeBytes); err != nil {
+			// couldn't load required amount of bytes
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return 0, 0, io.EOF
+			}
 
-		if err != nil {
 			return 0, 0, err
 		}
 
-		if uint64(n) < payloadSizeSize {
-			// couldn't load required amount of bytes
-			return 0, 0, io.EOF
-		}
-
 		payloadSize := new(big.Int).SetBytes(payloadSizeBytes).Int64()
 
 		return payloadSizeSize + 1, uint64(payloadSize), nil
@@ -265,7 +273,8 @@ func (b *blockStream) loadPayload(offset uint64, size uint64) error {
 	b.reserveCap(offset + size)
 	buf := b.buffer[offset : offset+size]
 
-	if _, err := b.input.Read(buf); err != nil {
+	// the decompressed backups may return less data than requested by a single read
+	if _, err := io.ReadFull(b.input, buf); err != nil {
 		return err
 	}
 
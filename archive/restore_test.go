@@ -124,6 +124,31 @@ func Test_importBlocks(t *testing.T) {
 	}
 }
 
+func Test_importBlocks_MissingParent(t *testing.T) {
+	block := &types.Block{
+		Header: &types.Header{
+			ParentHash: blocks[0].Hash(),
+			Number:     2,
+		},
+	}
+	block.Header.ComputeHash()
+
+	var buf bytes.Buffer
+
+	buf.Write((&Metadata{Latest: 2, LatestHash: block.Hash()}).MarshalRLP())
+	buf.Write(block.MarshalRLP())
+
+	chain := &mockChain{
+		genesis: genesis,
+		blocks:  []*types.Block{},
+	}
+
+	// the backup continues the backup which is not restored
+	err := importBlocks(chain, newBlockStream(&buf), progress.NewProgressionWrapper(progress.ChainSyncRestore))
+	assert.ErrorContains(t, err, "block 1 is not in the chain")
+	assert.Empty(t, chain.blocks)
+}
+
 func Test_consumeCommonBlocks(t *testing.T) {
 	newTestArchiveStream := func(blocks ...*types.Block) *blockStream {
 		var buf bytes.Buffer
@@ -0,0 +1,319 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+const (
+	// SnapshotVersion is the version of the state snapshot format written by the node
+	SnapshotVersion uint64 = 1
+
+	// maxSnapshotRecordSize limits the memory allocated for a single record of a corrupted snapshot
+	maxSnapshotRecordSize = 256 * 1024 * 1024
+)
+
+// snapshotMagic is the first field of the state snapshot
+var snapshotMagic = []byte("polygon-edge state snapshot")
+
+// The state snapshot is a stream of RLP arrays: the header, followed by the records tagged with their kind.
+// The blocks come first, then the accounts, each followed by its storage slots, and the code before
+// the first account using it. The last record holds the SHA-256 checksum of all the preceding bytes
+const (
+	// [kind, block, receipts, total difficulty]
+	recordBlock uint64 = iota + 1
+	// [kind, hash of the address, account]
+	recordAccount
+	// [kind, hash of the storage key, RLP encoded value]
+	recordStorage
+	// [kind, code hash, code]
+	recordCode
+	// [kind, checksum]
+	recordEnd
+)
+
+var (
+	errNotSnapshot             = errors.New("not a state snapshot")
+	errUnsupportedVersion      = errors.New("unsupported state snapshot version")
+	errSnapshotTruncated       = errors.New("state snapshot is truncated")
+	errSnapshotChecksum        = errors.New("state snapshot checksum mismatch")
+	errUnknownSnapshotRecord   = errors.New("unknown state snapshot record")
+	errInvalidSnapshotRecord   = errors.New("invalid state snapshot record")
+	errSnapshotRecordAfterLast = errors.New("data after the last state snapshot record")
+)
+
+// SnapshotHeader is the header of the state snapshot, it describes the block the state is taken at
+type SnapshotHeader struct {
+	Version   uint64
+	Genesis   types.Hash
+	Number    uint64
+	Hash      types.Hash
+	StateRoot types.Hash
+}
+
+// MarshalRLPWith appends own field into arena for encode
+func (h *SnapshotHeader) MarshalRLPWith(arena *fastrlp.Arena) *fastrlp.Value {
+	vv := arena.NewArray()
+
+	vv.Set(arena.NewBytes(snapshotMagic))
+	vv.Set(arena.NewUint(h.Version))
+	vv.Set(arena.NewBytes(h.Genesis.Bytes()))
+	vv.Set(arena.NewUint(h.Number))
+	vv.Set(arena.NewBytes(h.Hash.Bytes()))
+	vv.Set(arena.NewBytes(h.StateRoot.Bytes()))
+
+	return vv
+}
+
+// UnmarshalRLPFrom sets the fields from parsed RLP encoded value
+func (h *SnapshotHeader) UnmarshalRLPFrom(_ *fastrlp.Parser, v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	if len(elems) < 2 {
+		return errNotSnapshot
+	}
+
+	if magic, err := elems[0].Bytes(); err != nil || !bytes.Equal(magic, snapshotMagic) {
+		return errNotSnapshot
+	}
+
+	if h.Version, err = elems[1].GetUint64(); err != nil {
+		return err
+	}
+
+	if h.Version > SnapshotVersion {
+		return fmt.Errorf("%w: %d", errUnsupportedVersion, h.Version)
+	}
+
+	if len(elems) < 6 {
+		return fmt.Errorf("incorrect number of elements to decode SnapshotHeader, expected 6 but found %d", len(elems))
+	}
+
+	if err = elems[2].GetHash(h.Genesis[:]); err != nil {
+		return err
+	}
+
+	if h.Number, err = elems[3].GetUint64(); err != nil {
+		return err
+	}
+
+	if err = elems[4].GetHash(h.Hash[:]); err != nil {
+		return err
+	}
+
+	return elems[5].GetHash(h.StateRoot[:])
+}
+
+// ReadSnapshotHeader reads the header of the state snapshot
+func ReadSnapshotHeader(in io.Reader) (*SnapshotHeader, error) {
+	return newSnapshotReader(in).readHeader()
+}
+
+// snapshotWriter writes the records of the state snapshot and keeps their checksum
+type snapshotWriter struct {
+	out      io.Writer
+	checksum hash.Hash
+	arena    fastrlp.Arena
+	buf      []byte
+}
+
+func newSnapshotWriter(out io.Writer) *snapshotWriter {
+	return &snapshotWriter{
+		out:      out,
+		checksum: sha256.New(),
+	}
+}
+
+func (w *snapshotWriter) writeHeader(header *SnapshotHeader) error {
+	w.arena.Reset()
+
+	return w.write(header.MarshalRLPWith(&w.arena))
+}
+
+func (w *snapshotWriter) writeRecord(kind uint64, fields ...[]byte) error {
+	w.arena.Reset()
+
+	vv := w.arena.NewArray()
+	vv.Set(w.arena.NewUint(kind))
+
+	for _, field := range fields {
+		vv.Set(w.arena.NewBytes(field))
+	}
+
+	return w.write(vv)
+}
+
+// writeEnd writes the last record with the checksum of the snapshot
+func (w *snapshotWriter) writeEnd() error {
+	checksum := w.checksum.Sum(nil)
+
+	w.arena.Reset()
+
+	vv := w.arena.NewArray()
+	vv.Set(w.arena.NewUint(recordEnd))
+	vv.Set(w.arena.NewBytes(checksum))
+
+	w.buf = vv.MarshalTo(w.buf[:0])
+
+	_, err := w.out.Write(w.buf)
+
+	return err
+}
+
+func (w *snapshotWriter) write(vv *fastrlp.Value) error {
+	w.buf = vv.MarshalTo(w.buf[:0])
+	w.checksum.Write(w.buf)
+
+	_, err := w.out.Write(w.buf)
+
+	return err
+}
+
+// snapshotRecord is a record read from the state snapshot
+type snapshotRecord struct {
+	kind   uint64
+	fields [][]byte
+}
+
+// snapshotReader reads the records of the state snapshot and verifies its checksum
+type snapshotReader struct {
+	in       *bufio.Reader
+	checksum hash.Hash
+	parser   fastrlp.Parser
+	buf      []byte
+}
+
+func newSnapshotReader(in io.Reader) *snapshotReader {
+	return &snapshotReader{
+		in:       bufio.NewReaderSize(in, 1024*1024),
+		checksum: sha256.New(),
+	}
+}
+
+func (r *snapshotReader) readHeader() (*SnapshotHeader, error) {
+	vv, err := r.read()
+	if errors.Is(err, io.EOF) || errors.Is(err, errInvalidSnapshotRecord) {
+		return nil, errNotSnapshot
+	} else if err != nil {
+		return nil, err
+	}
+
+	r.checksum.Write(r.buf)
+
+	header := &SnapshotHeader{}
+	if err := header.UnmarshalRLPFrom(&r.parser, vv); err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// readRecord returns the next record. The checksum is verified on the last record
+func (r *snapshotReader) readRecord() (*snapshotRecord, error) {
+	vv, err := r.read()
+	if errors.Is(err, io.EOF) {
+		return nil, errSnapshotTruncated
+	} else if err != nil {
+		return nil, err
+	}
+
+	elems, err := vv.GetElems()
+	if err != nil || len(elems) == 0 {
+		return nil, errInvalidSnapshotRecord
+	}
+
+	record := &snapshotRecord{
+		fields: make([][]byte, 0, len(elems)-1),
+	}
+
+	if record.kind, err = elems[0].GetUint64(); err != nil {
+		return nil, errInvalidSnapshotRecord
+	}
+
+	for _, elem := range elems[1:] {
+		field, err := elem.GetBytes(nil)
+		if err != nil {
+			return nil, errInvalidSnapshotRecord
+		}
+
+		record.fields = append(record.fields, field)
+	}
+
+	if record.kind != recordEnd {
+		r.checksum.Write(r.buf)
+
+		return record, nil
+	}
+
+	if len(record.fields) != 1 || !bytes.Equal(record.fields[0], r.checksum.Sum(nil)) {
+		return nil, errSnapshotChecksum
+	}
+
+	if _, err := r.in.Peek(1); !errors.Is(err, io.EOF) {
+		return nil, errSnapshotRecordAfterLast
+	}
+
+	return record, nil
+}
+
+// read reads and parses the next RLP array
+func (r *snapshotReader) read() (*fastrlp.Value, error) {
+	prefix, err := r.in.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	var size uint64
+
+	switch {
+	case prefix >= 0xc0 && prefix <= 0xf7:
+		r.buf = append(r.buf[:0], prefix)
+		size = uint64(prefix - 0xc0)
+
+	case prefix >= 0xf8:
+		sizeLen := int(prefix - 0xf7)
+
+		r.buf = append(r.buf[:0], prefix)
+		r.buf = append(r.buf, make([]byte, sizeLen)...)
+
+		if _, err := io.ReadFull(r.in, r.buf[1:]); err != nil {
+			return nil, errSnapshotTruncated
+		}
+
+		for _, b := range r.buf[1:] {
+			size = size<<8 | uint64(b)
+		}
+
+	default:
+		return nil, errInvalidSnapshotRecord
+	}
+
+	if size > maxSnapshotRecordSize {
+		return nil, fmt.Errorf("%w: record of %d bytes", errInvalidSnapshotRecord, size)
+	}
+
+	offset := len(r.buf)
+	r.buf = append(r.buf, make([]byte, size)...)
+
+	if _, err := io.ReadFull(r.in, r.buf[offset:]); err != nil {
+		return nil, errSnapshotTruncated
+	}
+
+	vv, err := r.parser.Parse(r.buf)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidSnapshotRecord, err)
+	}
+
+	return vv, nil
+}
@@ -0,0 +1,213 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// DefaultSnapshotBlocks is the default number of the recent blocks included in the state snapshot
+const DefaultSnapshotBlocks = 256
+
+// SnapshotChain is the chain the state snapshot is taken from
+type SnapshotChain interface {
+	Header() *types.Header
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+	GetBodyByHash(types.Hash) (*types.Body, bool)
+	GetReceiptsByHash(types.Hash) ([]*types.Receipt, error)
+	GetTD(types.Hash) (*big.Int, bool)
+}
+
+// storageChain reads the chain directly from the storage of a stopped node
+type storageChain struct {
+	db storage.Storage
+}
+
+// NewStorageSnapshotChain returns the chain stored in the given storage
+func NewStorageSnapshotChain(db storage.Storage) SnapshotChain {
+	return &storageChain{db: db}
+}
+
+func (c *storageChain) Header() *types.Header {
+	hash, ok := c.db.ReadHeadHash()
+	if !ok {
+		return nil
+	}
+
+	header, err := c.db.ReadHeader(hash)
+	if err != nil {
+		return nil
+	}
+
+	return header
+}
+
+func (c *storageChain) GetHeaderByNumber(n uint64) (*types.Header, bool) {
+	hash, ok := c.db.ReadCanonicalHash(n)
+	if !ok {
+		return nil, false
+	}
+
+	header, err := c.db.ReadHeader(hash)
+	if err != nil {
+		return nil, false
+	}
+
+	return header, true
+}
+
+func (c *storageChain) GetBodyByHash(hash types.Hash) (*types.Body, bool) {
+	body, err := c.db.ReadBody(hash)
+	if err != nil {
+		return nil, false
+	}
+
+	return body, true
+}
+
+func (c *storageChain) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return c.db.ReadReceipts(hash)
+}
+
+func (c *storageChain) GetTD(hash types.Hash) (*big.Int, bool) {
+	return c.db.ReadTotalDifficulty(hash)
+}
+
+// ExportSnapshot writes the state snapshot at the given block (0 for the latest one) to the writer.
+// The snapshot contains the genesis block and the given number of the recent blocks
+// up to the snapshot block, so the node importing it is able to continue the chain
+func ExportSnapshot(
+	chain SnapshotChain,
+	stateStorage itrie.Storage,
+	number uint64,
+	blocks uint64,
+	out io.Writer,
+) (*SnapshotHeader, error) {
+	head := chain.Header()
+	if head == nil {
+		return nil, errors.New("the chain is empty")
+	}
+
+	if number == 0 {
+		number = head.Number
+	} else if number > head.Number {
+		return nil, fmt.Errorf("block %d is above the latest block %d", number, head.Number)
+	}
+
+	genesis, ok := chain.GetHeaderByNumber(0)
+	if !ok {
+		return nil, errors.New("genesis block not found")
+	}
+
+	target, ok := chain.GetHeaderByNumber(number)
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", number)
+	}
+
+	if blocks == 0 {
+		blocks = 1
+	}
+
+	header := &SnapshotHeader{
+		Version:   SnapshotVersion,
+		Genesis:   genesis.Hash,
+		Number:    target.Number,
+		Hash:      target.Hash,
+		StateRoot: target.StateRoot,
+	}
+
+	w := newSnapshotWriter(out)
+
+	if err := w.writeHeader(header); err != nil {
+		return nil, err
+	}
+
+	if err := writeSnapshotBlock(w, chain, genesis); err != nil {
+		return nil, err
+	}
+
+	first := uint64(1)
+	if number >= blocks {
+		first = number - blocks + 1
+	}
+
+	for n := first; n <= number; n++ {
+		blockHeader, ok := chain.GetHeaderByNumber(n)
+		if !ok {
+			return nil, fmt.Errorf("block %d not found", n)
+		}
+
+		if err := writeSnapshotBlock(w, chain, blockHeader); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := itrie.ExportState(target.StateRoot, stateStorage, &snapshotStateWriter{w: w}); err != nil {
+		return nil, fmt.Errorf("failed to export the state at block %d: %w", number, err)
+	}
+
+	if err := w.writeEnd(); err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func writeSnapshotBlock(w *snapshotWriter, chain SnapshotChain, header *types.Header) error {
+	// the body of the genesis block is not stored
+	body := &types.Body{}
+
+	if header.Number != 0 {
+		var ok bool
+
+		if body, ok = chain.GetBodyByHash(header.Hash); !ok {
+			return fmt.Errorf("body of block %d not found", header.Number)
+		}
+	}
+
+	receipts, err := chain.GetReceiptsByHash(header.Hash)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("failed to read the receipts of block %d: %w", header.Number, err)
+	}
+
+	td, ok := chain.GetTD(header.Hash)
+	if !ok {
+		return fmt.Errorf("total difficulty of block %d not found", header.Number)
+	}
+
+	block := &types.Block{
+		Header:       header,
+		Transactions: body.Transactions,
+		Uncles:       body.Uncles,
+	}
+
+	return w.writeRecord(
+		recordBlock,
+		block.MarshalRLP(),
+		types.Receipts(receipts).MarshalStoreRLPTo(nil),
+		td.Bytes(),
+	)
+}
+
+// snapshotStateWriter writes the entries of the state trie as the records of the snapshot
+type snapshotStateWriter struct {
+	w *snapshotWriter
+}
+
+func (s *snapshotStateWriter) VisitAccount(key types.Hash, account *state.Account) error {
+	return s.w.writeRecord(recordAccount, key.Bytes(), account.MarshalWith(&s.w.arena).MarshalTo(nil))
+}
+
+func (s *snapshotStateWriter) VisitStorage(key types.Hash, value []byte) error {
+	return s.w.writeRecord(recordStorage, key.Bytes(), value)
+}
+
+func (s *snapshotStateWriter) VisitCode(hash types.Hash, code []byte) error {
+	return s.w.writeRecord(recordCode, hash.Bytes(), code)
+}
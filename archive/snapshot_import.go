@@ -0,0 +1,188 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+)
+
+var (
+	errChainNotEmpty        = errors.New("the chain is not empty")
+	errSnapshotGenesis      = errors.New("first block of the state snapshot is not its genesis block")
+	errSnapshotBlockOrder   = errors.New("blocks of the state snapshot are not in order")
+	errSnapshotBlockInvalid = errors.New("invalid block in the state snapshot")
+	errSnapshotMismatch     = errors.New("state snapshot does not match its header")
+)
+
+// ImportSnapshot reads the state snapshot and writes its blocks and state into the empty storages.
+// The head of the chain is set to the snapshot block only after the whole snapshot is verified
+func ImportSnapshot(in io.Reader, db storage.Storage, stateStorage itrie.Storage) (*SnapshotHeader, error) {
+	if _, ok := db.ReadHeadHash(); ok {
+		return nil, errChainNotEmpty
+	}
+
+	r := newSnapshotReader(in)
+
+	header, err := r.readHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		batch    = storage.NewBatchWriter(db)
+		importer = itrie.NewStateImporter(stateStorage)
+
+		// the last imported block, and the first one after the genesis block
+		last, first *types.Header
+		inState     bool
+	)
+
+	for {
+		record, err := r.readRecord()
+		if err != nil {
+			return nil, err
+		}
+
+		if record.kind == recordEnd {
+			break
+		}
+
+		if record.kind == recordBlock {
+			if inState {
+				return nil, fmt.Errorf("%w: block after the state", errSnapshotBlockOrder)
+			}
+
+			block, err := importSnapshotBlock(batch, record)
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case last == nil:
+				if block.Number() != 0 || block.Hash() != header.Genesis {
+					return nil, errSnapshotGenesis
+				}
+
+			case first == nil:
+				// the blocks between the genesis block and the recent blocks are not included
+				first = block.Header
+
+			default:
+				if block.Number() != last.Number+1 || block.ParentHash() != last.Hash {
+					return nil, fmt.Errorf("%w: block %d follows block %d", errSnapshotBlockOrder, block.Number(), last.Number)
+				}
+			}
+
+			last = block.Header
+
+			continue
+		}
+
+		inState = true
+
+		if err := importSnapshotState(importer, record); err != nil {
+			return nil, err
+		}
+	}
+
+	if last == nil || last.Number != header.Number || last.Hash != header.Hash || last.StateRoot != header.StateRoot {
+		return nil, fmt.Errorf("%w: the last block is not the snapshot block", errSnapshotMismatch)
+	}
+
+	root, err := importer.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if root != header.StateRoot {
+		return nil, fmt.Errorf("%w: expected state root %s, got %s", errSnapshotMismatch, header.StateRoot, root)
+	}
+
+	// the log index covers only the recent blocks
+	if first != nil {
+		batch.PutLogIndexHead(first.Number - 1)
+	}
+
+	batch.PutHeadHash(last.Hash)
+	batch.PutHeadNumber(last.Number)
+
+	if err := batch.WriteBatch(); err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+// importSnapshotBlock verifies the block record and adds the block to the batch as the canonical one
+func importSnapshotBlock(batch *storage.BatchWriter, record *snapshotRecord) (*types.Block, error) {
+	if len(record.fields) != 3 {
+		return nil, errInvalidSnapshotRecord
+	}
+
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(record.fields[0]); err != nil {
+		return nil, fmt.Errorf("%w: %w", errSnapshotBlockInvalid, err)
+	}
+
+	receipts := types.Receipts{}
+	if err := receipts.UnmarshalStoreRLP(record.fields[1]); err != nil {
+		return nil, fmt.Errorf("%w: receipts of block %d: %w", errSnapshotBlockInvalid, block.Number(), err)
+	}
+
+	if root := buildroot.CalculateTransactionsRoot(block.Transactions); root != block.Header.TxRoot {
+		return nil, fmt.Errorf("%w: transactions root of block %d", errSnapshotBlockInvalid, block.Number())
+	}
+
+	if root := buildroot.CalculateReceiptsRoot(receipts); root != block.Header.ReceiptsRoot {
+		return nil, fmt.Errorf("%w: receipts root of block %d", errSnapshotBlockInvalid, block.Number())
+	}
+
+	hash := block.Hash()
+
+	batch.PutHeader(block.Header)
+	batch.PutBody(hash, block.Body())
+	batch.PutReceipts(hash, receipts)
+	batch.PutCanonicalHash(block.Number(), hash)
+	batch.PutTotalDifficulty(hash, new(big.Int).SetBytes(record.fields[2]))
+
+	for _, tx := range block.Transactions {
+		batch.PutTxLookup(tx.Hash, hash)
+	}
+
+	return block, nil
+}
+
+// importSnapshotState passes the state record to the importer
+func importSnapshotState(importer *itrie.StateImporter, record *snapshotRecord) error {
+	if len(record.fields) != 2 || len(record.fields[0]) != types.HashLength {
+		return errInvalidSnapshotRecord
+	}
+
+	key := types.BytesToHash(record.fields[0])
+
+	switch record.kind {
+	case recordAccount:
+		var account state.Account
+		if err := account.UnmarshalRlp(record.fields[1]); err != nil {
+			return fmt.Errorf("%w: account %s: %w", errInvalidSnapshotRecord, key, err)
+		}
+
+		return importer.VisitAccount(key, &account)
+
+	case recordStorage:
+		return importer.VisitStorage(key, record.fields[1])
+
+	case recordCode:
+		return importer.VisitCode(key, record.fields[1])
+
+	default:
+		return fmt.Errorf("%w: %d", errUnknownSnapshotRecord, record.kind)
+	}
+}
@@ -0,0 +1,300 @@
+package archive
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	snapshotTestContract = types.StringToAddress("0x1001")
+	snapshotTestSlot     = types.StringToHash("0x1")
+)
+
+// newSnapshotTestChain writes the chain with the given number of blocks after the genesis block,
+// every block has a single transaction and all of them share the same state
+func newSnapshotTestChain(t *testing.T, blocks uint64) (storage.Storage, itrie.Storage) {
+	t.Helper()
+
+	// the preimages are recorded since the genesis, so the state can be dumped
+	stateStorage := itrie.NewMemoryStorage()
+	itrie.SetCompletePreimages(stateStorage, true)
+
+	st := itrie.NewState(stateStorage)
+	st.EnablePreimages()
+
+	_, root := st.NewSnapshot().Commit([]*state.Object{
+		{
+			Address:  types.StringToAddress("0x1000"),
+			Balance:  big.NewInt(100),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.EmptyCodeHash,
+		},
+		{
+			Address:   snapshotTestContract,
+			Balance:   big.NewInt(0),
+			Nonce:     1,
+			Root:      types.EmptyRootHash,
+			Code:      []byte{0x60, 0x00},
+			CodeHash:  types.BytesToHash(crypto.Keccak256([]byte{0x60, 0x00})),
+			DirtyCode: true,
+			Storage: []*state.StorageObject{
+				{Key: snapshotTestSlot.Bytes(), Val: types.StringToHash("0x5").Bytes()},
+			},
+		},
+	})
+
+	db, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	batch := storage.NewBatchWriter(db)
+
+	parent := (&types.Header{
+		StateRoot:    types.BytesToHash(root),
+		TxRoot:       types.EmptyRootHash,
+		ReceiptsRoot: types.EmptyRootHash,
+		Sha3Uncles:   types.EmptyUncleHash,
+		Difficulty:   1,
+	}).ComputeHash()
+
+	batch.PutCanonicalHeader(parent, big.NewInt(1))
+
+	for n := uint64(1); n <= blocks; n++ {
+		tx := (&types.Transaction{
+			Nonce:    n,
+			GasPrice: big.NewInt(1),
+			Gas:      21000,
+			To:       &snapshotTestContract,
+			Value:    big.NewInt(1),
+			V:        big.NewInt(1),
+			R:        big.NewInt(1),
+			S:        big.NewInt(1),
+		}).ComputeHash()
+
+		receipt := &types.Receipt{
+			CumulativeGasUsed: 21000,
+			GasUsed:           21000,
+			TxHash:            tx.Hash,
+			Logs: []*types.Log{
+				{Address: snapshotTestContract, Topics: []types.Hash{snapshotTestSlot}},
+			},
+		}
+		receipt.SetStatus(types.ReceiptSuccess)
+
+		header := (&types.Header{
+			ParentHash:   parent.Hash,
+			Number:       n,
+			StateRoot:    types.BytesToHash(root),
+			TxRoot:       buildroot.CalculateTransactionsRoot([]*types.Transaction{tx}),
+			ReceiptsRoot: buildroot.CalculateReceiptsRoot([]*types.Receipt{receipt}),
+			Sha3Uncles:   types.EmptyUncleHash,
+			Difficulty:   1,
+		}).ComputeHash()
+
+		batch.PutHeader(header)
+		batch.PutBody(header.Hash, &types.Body{Transactions: []*types.Transaction{tx}})
+		batch.PutReceipts(header.Hash, []*types.Receipt{receipt})
+		batch.PutCanonicalHash(n, header.Hash)
+		batch.PutTotalDifficulty(header.Hash, big.NewInt(int64(n+1)))
+		batch.PutTxLookup(tx.Hash, header.Hash)
+
+		parent = header
+	}
+
+	batch.PutHeadHash(parent.Hash)
+	batch.PutHeadNumber(parent.Number)
+
+	require.NoError(t, batch.WriteBatch())
+
+	return db, stateStorage
+}
+
+func exportTestSnapshot(t *testing.T, number, blocks uint64) (*SnapshotHeader, []byte, storage.Storage) {
+	t.Helper()
+
+	db, stateStorage := newSnapshotTestChain(t, 10)
+
+	var buf bytes.Buffer
+
+	header, err := ExportSnapshot(NewStorageSnapshotChain(db), stateStorage, number, blocks, &buf)
+	require.NoError(t, err)
+
+	return header, buf.Bytes(), db
+}
+
+func newEmptySnapshotTarget(t *testing.T) (storage.Storage, itrie.Storage) {
+	t.Helper()
+
+	db, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	return db, itrie.NewMemoryStorage()
+}
+
+func TestSnapshot_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	header, data, source := exportTestSnapshot(t, 0, 4)
+
+	sourceHead, ok := source.ReadHeadHash()
+	require.True(t, ok)
+
+	assert.Equal(t, SnapshotVersion, header.Version)
+	assert.Equal(t, uint64(10), header.Number)
+	assert.Equal(t, sourceHead, header.Hash)
+
+	db, stateStorage := newEmptySnapshotTarget(t)
+
+	imported, err := ImportSnapshot(bytes.NewReader(data), db, stateStorage)
+	require.NoError(t, err)
+	assert.Equal(t, header, imported)
+
+	head, ok := db.ReadHeadHash()
+	require.True(t, ok)
+	assert.Equal(t, header.Hash, head)
+
+	number, ok := db.ReadHeadNumber()
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), number)
+
+	// only the genesis block and the recent blocks are imported
+	for n := uint64(0); n <= 10; n++ {
+		hash, ok := db.ReadCanonicalHash(n)
+		if n != 0 && n < 7 {
+			assert.False(t, ok)
+
+			continue
+		}
+
+		require.True(t, ok)
+
+		sourceHash, _ := source.ReadCanonicalHash(n)
+		assert.Equal(t, sourceHash, hash)
+	}
+
+	body, err := db.ReadBody(head)
+	require.NoError(t, err)
+	require.Len(t, body.Transactions, 1)
+
+	lookup, ok := db.ReadTxLookup(body.Transactions[0].Hash)
+	require.True(t, ok)
+	assert.Equal(t, head, lookup)
+
+	receipts, err := db.ReadReceipts(head)
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	assert.Equal(t, body.Transactions[0].Hash, receipts[0].TxHash)
+
+	td, ok := db.ReadTotalDifficulty(head)
+	require.True(t, ok)
+	assert.Equal(t, big.NewInt(11), td)
+
+	logIndexHead, ok := db.ReadLogIndexHead()
+	require.True(t, ok)
+	assert.Equal(t, uint64(6), logIndexHead)
+
+	// the state is readable at the snapshot block
+	snap, err := itrie.NewState(stateStorage).NewSnapshotAt(header.StateRoot)
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(snapshotTestContract)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	value, err := snap.GetStorage(snapshotTestContract, account.Root, snapshotTestSlot)
+	require.NoError(t, err)
+	assert.Equal(t, types.StringToHash("0x5"), value)
+
+	code, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+	require.True(t, ok)
+	assert.Equal(t, []byte{0x60, 0x00}, code)
+
+	// the imported snapshot is exported again unchanged
+	var buf bytes.Buffer
+
+	_, err = ExportSnapshot(NewStorageSnapshotChain(db), stateStorage, 0, 4, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, data, buf.Bytes())
+}
+
+func TestSnapshot_ExportAtNumber(t *testing.T) {
+	t.Parallel()
+
+	header, data, _ := exportTestSnapshot(t, 5, DefaultSnapshotBlocks)
+	assert.Equal(t, uint64(5), header.Number)
+
+	db, stateStorage := newEmptySnapshotTarget(t)
+
+	_, err := ImportSnapshot(bytes.NewReader(data), db, stateStorage)
+	require.NoError(t, err)
+
+	// all the blocks up to the snapshot block are included
+	for n := uint64(0); n <= 5; n++ {
+		_, ok := db.ReadCanonicalHash(n)
+		assert.True(t, ok)
+	}
+
+	_, ok := db.ReadCanonicalHash(6)
+	assert.False(t, ok)
+
+	logIndexHead, ok := db.ReadLogIndexHead()
+	require.True(t, ok)
+	assert.Equal(t, uint64(0), logIndexHead)
+
+	db, stateStorage = newSnapshotTestChain(t, 2)
+
+	_, err = ExportSnapshot(NewStorageSnapshotChain(db), stateStorage, 3, 1, &bytes.Buffer{})
+	assert.Error(t, err)
+}
+
+func TestImportSnapshot_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, data, source := exportTestSnapshot(t, 0, 2)
+
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-1] ^= 0xff
+
+	cases := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"not a snapshot", []byte("not a snapshot"), errNotSnapshot},
+		{"empty", nil, errNotSnapshot},
+		{"truncated", data[:len(data)/2], errSnapshotTruncated},
+		{"checksum", corrupted, errSnapshotChecksum},
+		{"trailing data", append(append([]byte{}, data...), 0xc0), errSnapshotRecordAfterLast},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, stateStorage := newEmptySnapshotTarget(t)
+
+			_, err := ImportSnapshot(bytes.NewReader(c.data), db, stateStorage)
+			assert.ErrorIs(t, err, c.err)
+
+			// the head is written only when the whole snapshot is imported
+			_, ok := db.ReadHeadHash()
+			assert.False(t, ok)
+		})
+	}
+
+	// the existing chain is never overwritten
+	_, err := ImportSnapshot(bytes.NewReader(data), source, itrie.NewMemoryStorage())
+	assert.ErrorIs(t, err, errChainNotEmpty)
+}
@@ -0,0 +1,79 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// StateDump describes the written state dump
+type StateDump struct {
+	Number    uint64
+	Hash      types.Hash
+	StateRoot types.Hash
+	Accounts  uint64
+}
+
+// DumpState writes the accounts of the state at the given block (the latest one if nil) to the writer,
+// in the alloc format accepted by the genesis command
+func DumpState(
+	blockchain SnapshotChain,
+	stateStorage itrie.Storage,
+	number *uint64,
+	format chain.AllocFormat,
+	out io.Writer,
+) (*StateDump, error) {
+	head := blockchain.Header()
+	if head == nil {
+		return nil, errors.New("the chain is empty")
+	}
+
+	target := head
+
+	if number != nil {
+		if *number > head.Number {
+			return nil, fmt.Errorf("block %d is above the latest block %d", *number, head.Number)
+		}
+
+		var ok bool
+
+		if target, ok = blockchain.GetHeaderByNumber(*number); !ok {
+			return nil, fmt.Errorf("block %d not found", *number)
+		}
+	}
+
+	// the state can't be dumped without the preimages of its keys, which are not recorded by default
+	if !itrie.HasCompletePreimages(stateStorage) {
+		return nil, fmt.Errorf("%w: the node has to sync from the genesis with the preimages enabled", itrie.ErrNoPreimages)
+	}
+
+	w, err := chain.NewAllocWriter(out, format)
+	if err != nil {
+		return nil, err
+	}
+
+	dump := &StateDump{
+		Number:    target.Number,
+		Hash:      target.Hash,
+		StateRoot: target.StateRoot,
+	}
+
+	err = itrie.DumpState(target.StateRoot, stateStorage, func(addr types.Address, account *chain.GenesisAccount) error {
+		dump.Accounts++
+
+		return w.Write(addr, account)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump the state of block %d: %w", target.Number, err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return dump, nil
+}
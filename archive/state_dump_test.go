@@ -0,0 +1,63 @@
+package archive
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpState(t *testing.T) {
+	t.Parallel()
+
+	db, stateStorage := newSnapshotTestChain(t, 3)
+
+	for _, format := range []chain.AllocFormat{chain.AllocFormatJSON, chain.AllocFormatRLP} {
+		var buf bytes.Buffer
+
+		dump, err := DumpState(NewStorageSnapshotChain(db), stateStorage, nil, format, &buf)
+		require.NoError(t, err)
+
+		head, _ := db.ReadHeadHash()
+		assert.Equal(t, uint64(3), dump.Number)
+		assert.Equal(t, head, dump.Hash)
+		assert.Equal(t, uint64(2), dump.Accounts)
+
+		alloc, err := chain.ReadAlloc(&buf)
+		require.NoError(t, err)
+		require.Len(t, alloc, 2)
+
+		assert.Equal(t, big.NewInt(100), alloc[types.StringToAddress("0x1000")].Balance)
+
+		contract := alloc[snapshotTestContract]
+		require.NotNil(t, contract)
+		assert.Equal(t, uint64(1), contract.Nonce)
+		assert.Equal(t, []byte{0x60, 0x00}, contract.Code)
+		assert.Equal(t, map[types.Hash]types.Hash{snapshotTestSlot: types.StringToHash("0x5")}, contract.Storage)
+	}
+
+	genesis := uint64(0)
+
+	dump, err := DumpState(NewStorageSnapshotChain(db), stateStorage, &genesis, chain.AllocFormatJSON, &bytes.Buffer{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), dump.Number)
+
+	above := uint64(4)
+
+	_, err = DumpState(NewStorageSnapshotChain(db), stateStorage, &above, chain.AllocFormatJSON, &bytes.Buffer{})
+	assert.Error(t, err)
+
+	// the state without the preimages is not walked
+	itrie.SetCompletePreimages(stateStorage, false)
+
+	var buf bytes.Buffer
+
+	_, err = DumpState(NewStorageSnapshotChain(db), stateStorage, nil, chain.AllocFormatJSON, &buf)
+	assert.ErrorIs(t, err, itrie.ErrNoPreimages)
+	assert.Zero(t, buf.Len())
+}
@@ -0,0 +1,163 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+)
+
+var (
+	errBackupNoMetadata     = errors.New("expected metadata in archive but doesn't exist")
+	errBackupEmpty          = errors.New("backup contains no blocks")
+	errBackupMetadata       = errors.New("backup does not match its metadata")
+	errBackupBlockSequence  = errors.New("blocks of the backup are not in sequence")
+	errBackupBlockInvalid   = errors.New("invalid block in the backup")
+	errBackupNotIncremental = errors.New("backup does not continue the previous one")
+)
+
+// BackupSummary describes the verified backup
+type BackupSummary struct {
+	Path       string
+	Compressed bool
+	From       uint64
+	To         uint64
+	LatestHash types.Hash
+}
+
+// VerifyBackups checks the integrity of the backups without importing them: the transactions
+// and uncles roots and the parent links of the blocks, and the latest block recorded in the metadata.
+// The backups are verified in the given order, every following backup has to continue the previous one
+func VerifyBackups(paths []string) ([]*BackupSummary, error) {
+	summaries := make([]*BackupSummary, 0, len(paths))
+
+	var previous *BackupSummary
+
+	for _, path := range paths {
+		summary, err := verifyBackup(path, previous)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		summaries = append(summaries, summary)
+		previous = summary
+	}
+
+	return summaries, nil
+}
+
+// ReadBackupMetadata returns the metadata of the backup
+func ReadBackupMetadata(path string) (*Metadata, error) {
+	fp, _, err := openBackupFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer fp.Close()
+
+	metadata, err := newBlockStream(fp).getMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata == nil {
+		return nil, errBackupNoMetadata
+	}
+
+	return metadata, nil
+}
+
+func verifyBackup(path string, previous *BackupSummary) (*BackupSummary, error) {
+	fp, compressed, err := openBackupFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer fp.Close()
+
+	blockStream := newBlockStream(fp)
+
+	metadata, err := blockStream.getMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata == nil {
+		return nil, errBackupNoMetadata
+	}
+
+	var last *types.Header
+
+	summary := &BackupSummary{
+		Path:       path,
+		Compressed: compressed,
+	}
+
+	for {
+		block, err := blockStream.nextBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		if block == nil {
+			break
+		}
+
+		if err := verifyBackupBlock(block); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case last != nil:
+			if block.Number() != last.Number+1 || block.ParentHash() != last.Hash {
+				return nil, fmt.Errorf("%w: block %d follows block %d", errBackupBlockSequence, block.Number(), last.Number)
+			}
+
+		case previous != nil:
+			if block.Number() != previous.To+1 || block.ParentHash() != previous.LatestHash {
+				return nil, fmt.Errorf("%w: it starts at block %d, the previous one ends at block %d",
+					errBackupNotIncremental, block.Number(), previous.To)
+			}
+
+			summary.From = block.Number()
+
+		default:
+			summary.From = block.Number()
+		}
+
+		last = block.Header
+	}
+
+	if last == nil {
+		return nil, errBackupEmpty
+	}
+
+	if last.Number != metadata.Latest || last.Hash != metadata.LatestHash {
+		return nil, fmt.Errorf("%w: the last block is %d (%s), expected %d (%s)",
+			errBackupMetadata, last.Number, last.Hash, metadata.Latest, metadata.LatestHash)
+	}
+
+	summary.To = last.Number
+	summary.LatestHash = last.Hash
+
+	return summary, nil
+}
+
+// verifyBackupBlock checks the block body matches its header
+func verifyBackupBlock(block *types.Block) error {
+	// the genesis block is written without its body
+	if block.Number() == 0 {
+		return nil
+	}
+
+	if hash := buildroot.CalculateUncleRoot(block.Uncles); hash != block.Header.Sha3Uncles {
+		return fmt.Errorf("%w: uncles root mismatch in block %d", errBackupBlockInvalid, block.Number())
+	}
+
+	if hash := buildroot.CalculateTransactionsRoot(block.Transactions); hash != block.Header.TxRoot {
+		return fmt.Errorf("%w: transactions root mismatch in block %d", errBackupBlockInvalid, block.Number())
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOfflineBackup_Incremental(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db, _       = newSnapshotTestChain(t, 10)
+		dir         = t.TempDir()
+		base        = filepath.Join(dir, "base.zst")
+		incremental = filepath.Join(dir, "incremental")
+		to          = uint64(5)
+	)
+
+	from, latest, err := CreateOfflineBackup(db, hclog.NewNullLogger(), 0, &to, base, BackupOptions{Compress: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), from)
+	assert.Equal(t, uint64(5), latest)
+
+	// the existing backup is never overwritten
+	_, _, err = CreateOfflineBackup(db, hclog.NewNullLogger(), 0, &to, base, BackupOptions{})
+	assert.ErrorIs(t, err, os.ErrExist)
+
+	previous, err := ReadBackupMetadata(base)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), previous.Latest)
+
+	from, latest, err = CreateOfflineBackup(db, hclog.NewNullLogger(), 0, nil, incremental, BackupOptions{Previous: previous})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), from)
+	assert.Equal(t, uint64(10), latest)
+
+	summaries, err := VerifyBackups([]string{base, incremental})
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+
+	assert.True(t, summaries[0].Compressed)
+	assert.Equal(t, uint64(0), summaries[0].From)
+	assert.Equal(t, uint64(5), summaries[0].To)
+	assert.Equal(t, previous.LatestHash, summaries[0].LatestHash)
+
+	assert.False(t, summaries[1].Compressed)
+	assert.Equal(t, uint64(6), summaries[1].From)
+	assert.Equal(t, uint64(10), summaries[1].To)
+
+	// the incremental backup is valid on its own, but it does not continue the later blocks
+	_, err = VerifyBackups([]string{incremental})
+	require.NoError(t, err)
+
+	_, err = VerifyBackups([]string{incremental, base})
+	assert.ErrorIs(t, err, errBackupNotIncremental)
+
+	// there are no blocks after the incremental backup
+	latestMetadata, err := ReadBackupMetadata(incremental)
+	require.NoError(t, err)
+
+	_, _, err = CreateOfflineBackup(db, hclog.NewNullLogger(), 0, nil, filepath.Join(dir, "empty"),
+		BackupOptions{Previous: latestMetadata})
+	assert.ErrorIs(t, err, errNoNewBlocks)
+
+	// the previous backup of another chain is rejected
+	_, _, err = CreateOfflineBackup(db, hclog.NewNullLogger(), 0, nil, filepath.Join(dir, "diverged"),
+		BackupOptions{Previous: &Metadata{Latest: 5, LatestHash: types.StringToHash("0x1")}})
+	assert.ErrorIs(t, err, errPreviousDiverged)
+
+	_, err = os.Stat(filepath.Join(dir, "diverged"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestVerifyBackups_Invalid(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db, _ = newSnapshotTestChain(t, 4)
+		dir   = t.TempDir()
+		valid = filepath.Join(dir, "valid")
+	)
+
+	_, _, err := CreateOfflineBackup(db, hclog.NewNullLogger(), 1, nil, valid, BackupOptions{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(valid)
+	require.NoError(t, err)
+
+	metadata, err := ReadBackupMetadata(valid)
+	require.NoError(t, err)
+
+	var (
+		metadataSize = len(metadata.MarshalRLP())
+		block1, _    = readCanonicalBlock(db, 1)
+		block3, _    = readCanonicalBlock(db, 3)
+		block4, _    = readCanonicalBlock(db, 4)
+	)
+
+	// the transactions of another block
+	block3.Transactions = block4.Transactions
+
+	cases := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{
+			name: "empty",
+			data: metadata.MarshalRLP(),
+			err:  errBackupEmpty,
+		},
+		{
+			name: "metadata mismatch",
+			data: append(append([]byte{}, data[:metadataSize]...), block1.MarshalRLP()...),
+			err:  errBackupMetadata,
+		},
+		{
+			name: "gap",
+			data: append(append(append([]byte{}, data[:metadataSize]...), block1.MarshalRLP()...), block4.MarshalRLP()...),
+			err:  errBackupBlockSequence,
+		},
+		{
+			name: "invalid body",
+			data: append(append([]byte{}, data[:metadataSize]...), block3.MarshalRLP()...),
+			err:  errBackupBlockInvalid,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(dir, c.name)
+			require.NoError(t, os.WriteFile(path, c.data, 0600))
+
+			_, err := VerifyBackups([]string{path})
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+
+	// the truncated backup is reported
+	truncated := filepath.Join(dir, "truncated")
+	require.NoError(t, os.WriteFile(truncated, data[:len(data)-10], 0600))
+
+	_, err = VerifyBackups([]string{truncated})
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,10 +10,13 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/types/buildroot"
@@ -38,6 +42,8 @@ var (
 	ErrInvalidStateRoot     = errors.New("invalid block state root")
 	ErrInvalidGasUsed       = errors.New("invalid block gas used")
 	ErrInvalidReceiptsRoot  = errors.New("invalid block receipts root")
+
+	spanTracer = tracing.Tracer("blockchain")
 )
 
 // Blockchain is a blockchain reference
@@ -73,6 +79,10 @@ type Blockchain struct {
 
 	gpAverage *gasPriceAverage // A reference to the average gas price
 
+	logIndex *logIndex // Index of the log addresses and topics, nil if disabled
+
+	checkpoint *Checkpoint // The trusted block the chain has to contain, nil if not set
+
 	writeLock sync.Mutex
 }
 
@@ -93,7 +103,12 @@ type Verifier interface {
 }
 
 type Executor interface {
-	ProcessBlock(parentRoot types.Hash, block *types.Block, blockCreator types.Address) (*state.Transition, error)
+	ProcessBlockWithContext(
+		ctx context.Context,
+		parentRoot types.Hash,
+		block *types.Block,
+		blockCreator types.Address,
+	) (*state.Transition, error)
 }
 
 type TxSigner interface {
@@ -596,7 +611,7 @@ func (b *Blockchain) WriteHeadersWithBodies(headers []*types.Header) error {
 // outside the method call
 func (b *Blockchain) VerifyPotentialBlock(block *types.Block) error {
 	// Do just the initial block verification
-	_, err := b.verifyBlock(block)
+	_, err := b.verifyBlock(context.Background(), block)
 
 	return err
 }
@@ -604,13 +619,21 @@ func (b *Blockchain) VerifyPotentialBlock(block *types.Block) error {
 // VerifyFinalizedBlock verifies that the block is valid by performing a series of checks.
 // It is assumed that the block status is sealed (committed)
 func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) (*types.FullBlock, error) {
+	return b.VerifyFinalizedBlockWithContext(context.Background(), block)
+}
+
+// VerifyFinalizedBlockWithContext is VerifyFinalizedBlock tracing the block execution within the given context
+func (b *Blockchain) VerifyFinalizedBlockWithContext(
+	ctx context.Context,
+	block *types.Block,
+) (*types.FullBlock, error) {
 	// Make sure the consensus layer verifies this block header
 	if err := b.consensus.VerifyHeader(block.Header); err != nil {
 		return nil, fmt.Errorf("failed to verify the header: %w", err)
 	}
 
 	// Do the initial block verification
-	receipts, err := b.verifyBlock(block)
+	receipts, err := b.verifyBlock(ctx, block)
 	if err != nil {
 		return nil, err
 	}
@@ -618,21 +641,45 @@ func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) (*types.FullBlock,
 	return &types.FullBlock{Block: block, Receipts: receipts}, nil
 }
 
+// VerifyFinalizedHeader verifies the sealed (committed) header without its body,
+// by the consensus layer and against the locally saved parent header.
+// It is used by the light nodes, which keep the headers only
+func (b *Blockchain) VerifyFinalizedHeader(header *types.Header) error {
+	if header == nil {
+		return ErrNoBlock
+	}
+
+	if err := verifyCheckpoint(b.checkpoint, header); err != nil {
+		return err
+	}
+
+	if err := b.consensus.VerifyHeader(header); err != nil {
+		return fmt.Errorf("failed to verify the header: %w", err)
+	}
+
+	return b.verifyBlockParent(&types.Block{Header: header})
+}
+
 // verifyBlock does the base (common) block verification steps by
 // verifying the block body as well as the parent information
-func (b *Blockchain) verifyBlock(block *types.Block) ([]*types.Receipt, error) {
+func (b *Blockchain) verifyBlock(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
 	// Make sure the block is present
 	if block == nil {
 		return nil, ErrNoBlock
 	}
 
+	// Make sure the block is the checkpoint block, if it is at its height
+	if err := verifyCheckpoint(b.checkpoint, block.Header); err != nil {
+		return nil, err
+	}
+
 	// Make sure the block is in line with the parent block
 	if err := b.verifyBlockParent(block); err != nil {
 		return nil, err
 	}
 
 	// Make sure the block body data is valid
-	return b.verifyBlockBody(block)
+	return b.verifyBlockBody(ctx, block)
 }
 
 // verifyBlockParent makes sure that the child block is in line
@@ -690,7 +737,7 @@ func (b *Blockchain) verifyBlockParent(childBlock *types.Block) error {
 // - The trie roots match up (state, transactions, receipts, uncles)
 // - The receipts match up
 // - The execution result matches up
-func (b *Blockchain) verifyBlockBody(block *types.Block) ([]*types.Receipt, error) {
+func (b *Blockchain) verifyBlockBody(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
 	// Make sure the Uncles root matches up
 	if hash := buildroot.CalculateUncleRoot(block.Uncles); hash != block.Header.Sha3Uncles {
 		b.logger.Error(fmt.Sprintf(
@@ -714,7 +761,7 @@ func (b *Blockchain) verifyBlockBody(block *types.Block) ([]*types.Receipt, erro
 	}
 
 	// Execute the transactions in the block and grab the result
-	blockResult, executeErr := b.executeBlockTransactions(block)
+	blockResult, executeErr := b.executeBlockTransactions(ctx, block)
 	if executeErr != nil {
 		return nil, fmt.Errorf("unable to execute block transactions, %w", executeErr)
 	}
@@ -756,7 +803,7 @@ func (br *BlockResult) verifyBlockResult(referenceBlock *types.Block) error {
 
 // executeBlockTransactions executes the transactions in the block locally,
 // and reports back the block execution result
-func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult, error) {
+func (b *Blockchain) executeBlockTransactions(ctx context.Context, block *types.Block) (*BlockResult, error) {
 	header := block.Header
 
 	parent, ok := b.readHeader(header.ParentHash)
@@ -769,7 +816,7 @@ func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult,
 		return nil, err
 	}
 
-	txn, err := b.executor.ProcessBlock(parent.StateRoot, block, blockCreator)
+	txn, err := b.executor.ProcessBlockWithContext(ctx, parent.StateRoot, block, blockCreator)
 	if err != nil {
 		return nil, err
 	}
@@ -778,7 +825,7 @@ func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult,
 		return nil, err
 	}
 
-	_, root, err := txn.Commit()
+	_, root, err := txn.CommitWithContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit the state changes: %w", err)
 	}
@@ -798,11 +845,25 @@ func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult,
 // This function is a copy of WriteBlock but with a full block which does not
 // require to compute again the Receipts.
 func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) error {
+	return b.WriteFullBlockWithContext(context.Background(), fblock, source)
+}
+
+// WriteFullBlockWithContext is WriteFullBlock traced within the given context
+func (b *Blockchain) WriteFullBlockWithContext(
+	ctx context.Context,
+	fblock *types.FullBlock,
+	source string,
+) (err error) {
 	b.writeLock.Lock()
 	defer b.writeLock.Unlock()
 
 	block := fblock.Block
 
+	ctx, span := traceWriteBlock(ctx, block, source)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	if block.Number() <= b.Header().Number {
 		b.logger.Info("block already inserted", "block", block.Number(), "source", source)
 
@@ -838,10 +899,15 @@ func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) erro
 	// Update the average gas price
 	b.updateGasPriceAvgWithBlock(block)
 
-	if err := b.writeBatchAndUpdate(batchWriter, header, newTD, isCanonical); err != nil {
+	_, batchSpan := spanTracer.Start(ctx, "blockchain.write_batch")
+	err = b.writeBatchAndUpdate(batchWriter, header, newTD, isCanonical)
+
+	if tracing.End(batchSpan, err); err != nil {
 		return err
 	}
 
+	b.indexBlockLogs(header, fblock.Receipts)
+
 	b.dispatchEvent(evnt)
 
 	logArgs := []interface{}{
@@ -865,9 +931,19 @@ func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) erro
 // WriteBlock writes a single block to the local blockchain.
 // It doesn't do any kind of verification, only commits the block to the DB
 func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
+	return b.WriteBlockWithContext(context.Background(), block, source)
+}
+
+// WriteBlockWithContext is WriteBlock traced within the given context
+func (b *Blockchain) WriteBlockWithContext(ctx context.Context, block *types.Block, source string) (err error) {
 	b.writeLock.Lock()
 	defer b.writeLock.Unlock()
 
+	ctx, span := traceWriteBlock(ctx, block, source)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	if block.Number() <= b.Header().Number {
 		b.logger.Info("block already inserted", "block", block.Number(), "source", source)
 
@@ -891,7 +967,7 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 	}
 
 	// Fetch the block receipts
-	blockReceipts, receiptsErr := b.extractBlockReceipts(block)
+	blockReceipts, receiptsErr := b.extractBlockReceipts(ctx, block)
 	if receiptsErr != nil {
 		return receiptsErr
 	}
@@ -909,10 +985,15 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 	// Update the average gas price
 	b.updateGasPriceAvgWithBlock(block)
 
-	if err := b.writeBatchAndUpdate(batchWriter, header, newTD, isCanonical); err != nil {
+	_, batchSpan := spanTracer.Start(ctx, "blockchain.write_batch")
+	err = b.writeBatchAndUpdate(batchWriter, header, newTD, isCanonical)
+
+	if tracing.End(batchSpan, err); err != nil {
 		return err
 	}
 
+	b.indexBlockLogs(header, blockReceipts)
+
 	b.dispatchEvent(evnt)
 
 	logArgs := []interface{}{
@@ -933,6 +1014,43 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 	return nil
 }
 
+// WriteHeader writes a single header to the local blockchain, without the block body and receipts.
+// It doesn't do any kind of verification, and is used by the light nodes
+func (b *Blockchain) WriteHeader(header *types.Header, source string) error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	if header.Number <= b.Header().Number {
+		b.logger.Info("header already inserted", "block", header.Number, "source", source)
+
+		return nil
+	}
+
+	batchWriter := storage.NewBatchWriter(b.db)
+
+	evnt := &Event{Source: source}
+
+	isCanonical, newTD, err := b.writeHeaderImpl(batchWriter, evnt, header)
+	if err != nil {
+		return err
+	}
+
+	// update snapshot
+	if err := b.consensus.ProcessHeaders([]*types.Header{header}); err != nil {
+		return err
+	}
+
+	if err := b.writeBatchAndUpdate(batchWriter, header, newTD, isCanonical); err != nil {
+		return err
+	}
+
+	b.dispatchEvent(evnt)
+
+	b.logger.Info("new header", "number", header.Number, "hash", header.Hash, "source", source)
+
+	return nil
+}
+
 // GetCachedReceipts retrieves cached receipts for given headerHash
 func (b *Blockchain) GetCachedReceipts(headerHash types.Hash) ([]*types.Receipt, error) {
 	receipts, found := b.receiptsCache.Get(headerHash)
@@ -949,13 +1067,13 @@ func (b *Blockchain) GetCachedReceipts(headerHash types.Hash) ([]*types.Receipt,
 }
 
 // extractBlockReceipts extracts the receipts from the passed in block
-func (b *Blockchain) extractBlockReceipts(block *types.Block) ([]*types.Receipt, error) {
+func (b *Blockchain) extractBlockReceipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
 	// Check the cache for the block receipts
 	receipts, ok := b.receiptsCache.Get(block.Header.Hash)
 	if !ok {
 		// No receipts found in the cache, execute the transactions from the block
 		// and fetch them
-		blockResult, err := b.executeBlockTransactions(block)
+		blockResult, err := b.executeBlockTransactions(ctx, block)
 		if err != nil {
 			return nil, err
 		}
@@ -1349,6 +1467,8 @@ func (b *Blockchain) GetBlockByNumber(blockNumber uint64, full bool) (*types.Blo
 
 // Close closes the DB connection
 func (b *Blockchain) Close() error {
+	b.closeLogIndex()
+
 	return b.db.Close()
 }
 
@@ -1428,3 +1548,12 @@ func (b *Blockchain) writeBatchAndUpdate(
 
 	return nil
 }
+
+// traceWriteBlock starts the span covering a single block write within the given context
+func traceWriteBlock(ctx context.Context, block *types.Block, source string) (context.Context, trace.Span) {
+	return spanTracer.Start(ctx, "blockchain.write_block", trace.WithAttributes(
+		attribute.Int64("block.number", int64(block.Number())),
+		attribute.Int("block.txs", len(block.Transactions)),
+		attribute.String("block.source", source),
+	))
+}
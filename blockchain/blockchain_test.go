@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -16,6 +17,10 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
@@ -1227,7 +1232,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, ErrInvalidSha3Uncles)
 	})
 
@@ -1245,7 +1250,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, ErrInvalidTxRoot)
 	})
 
@@ -1273,7 +1278,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, ErrParentNotFound)
 	})
 
@@ -1313,7 +1318,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, errBlockCreatorNotFound)
 	})
 
@@ -1356,7 +1361,7 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 			},
 		}
 
-		_, err = blockchain.verifyBlockBody(block)
+		_, err = blockchain.verifyBlockBody(context.Background(), block)
 		assert.ErrorIs(t, err, errUnableToExecute)
 	})
 }
@@ -1642,3 +1647,90 @@ func TestBlockchain_WriteFullBlock(t *testing.T) {
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.CANONICAL, common.EncodeUint64ToBytes(header.Number)))])
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.RECEIPTS, header.Hash.Bytes()))])
 }
+
+func TestBlockchain_WriteFullBlockWithContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	b := NewTestBlockchain(t, nil)
+	headers := NewTestHeadersWithSeed(b.Header(), 2, b.Header().GasLimit)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "test.import_block")
+
+	require.NoError(t, b.WriteFullBlockWithContext(ctx, &types.FullBlock{
+		Block: &types.Block{Header: headers[1]},
+	}, "test"))
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	// the block write is traced within the span of the caller
+	assert.Equal(t, "blockchain.write_batch", spans[0].Name())
+	assert.Equal(t, "blockchain.write_block", spans[1].Name())
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, spans[2].SpanContext().SpanID(), spans[1].Parent().SpanID())
+}
+
+func TestBlockchain_WriteHeader(t *testing.T) {
+	t.Parallel()
+
+	b := NewTestBlockchain(t, nil)
+	headers := NewTestHeadersWithSeed(b.Header(), 4, b.Header().GasLimit)
+
+	processed := 0
+
+	verifier, ok := b.consensus.(*MockVerifier)
+	require.True(t, ok)
+
+	verifier.HookProcessHeaders(func(hs []*types.Header) error {
+		processed += len(hs)
+
+		return nil
+	})
+
+	for _, header := range headers[1:] {
+		require.NoError(t, b.VerifyFinalizedHeader(header))
+		require.NoError(t, b.WriteHeader(header, "test"))
+	}
+
+	assert.Equal(t, headers[3].Hash, b.Header().Hash)
+	assert.Equal(t, 3, processed)
+
+	header, ok := b.GetHeaderByNumber(2)
+	require.True(t, ok)
+	assert.Equal(t, headers[2].Hash, header.Hash)
+
+	// the bodies are not written
+	_, ok = b.GetBlockByNumber(2, true)
+	assert.False(t, ok)
+
+	// already inserted headers are skipped
+	require.NoError(t, b.WriteHeader(headers[2], "test"))
+	assert.Equal(t, headers[3].Hash, b.Header().Hash)
+}
+
+func TestBlockchain_VerifyFinalizedHeader(t *testing.T) {
+	t.Parallel()
+
+	b := NewTestBlockchain(t, nil)
+	headers := NewTestHeadersWithSeed(b.Header(), 3, b.Header().GasLimit)
+
+	// the parent is not known
+	assert.ErrorIs(t, b.VerifyFinalizedHeader(headers[2]), ErrParentNotFound)
+
+	verifier, ok := b.consensus.(*MockVerifier)
+	require.True(t, ok)
+
+	verifyErr := errors.New("invalid committed seals")
+	verifier.HookVerifyHeader(func(*types.Header) error {
+		return verifyErr
+	})
+
+	assert.ErrorIs(t, b.VerifyFinalizedHeader(headers[1]), verifyErr)
+	assert.ErrorIs(t, b.VerifyFinalizedHeader(nil), ErrNoBlock)
+}
@@ -0,0 +1,176 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrInvalidCheckpoint        = errors.New("invalid checkpoint, expected <number>:<hash>")
+	ErrCheckpointMismatch       = errors.New("block does not match the checkpoint")
+	ErrNoCheckpoint             = errors.New("checkpoint is not set")
+	ErrCheckpointChainNotEmpty  = errors.New("chain is not empty, it can not be started from the checkpoint")
+	ErrInvalidCheckpointHeaders = errors.New("invalid checkpoint headers")
+)
+
+// Checkpoint is the trusted block the chain has to contain.
+// Blocks at its height with a different hash are rejected, so the node
+// can not be led onto a chain forking off before the checkpoint
+type Checkpoint struct {
+	Number uint64
+	Hash   types.Hash
+}
+
+// ParseCheckpoint parses the checkpoint in the <number>:<hash> format
+func ParseCheckpoint(raw string) (*Checkpoint, error) {
+	rawNumber, rawHash, ok := strings.Cut(raw, ":")
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCheckpoint, raw)
+	}
+
+	number, err := strconv.ParseUint(strings.TrimSpace(rawNumber), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid number %s", ErrInvalidCheckpoint, rawNumber)
+	}
+
+	hash, err := hex.DecodeHex(strings.TrimSpace(rawHash))
+	if err != nil || len(hash) != types.HashLength {
+		return nil, fmt.Errorf("%w: invalid hash %s", ErrInvalidCheckpoint, rawHash)
+	}
+
+	return &Checkpoint{
+		Number: number,
+		Hash:   types.BytesToHash(hash),
+	}, nil
+}
+
+func (c *Checkpoint) String() string {
+	return fmt.Sprintf("%d:%s", c.Number, c.Hash)
+}
+
+// SetCheckpoint sets the trusted checkpoint. If the local chain already reached its height,
+// the canonical block has to match it, otherwise the node is on a different chain
+// and ErrCheckpointMismatch is returned
+func (b *Blockchain) SetCheckpoint(checkpoint *Checkpoint) error {
+	if header, ok := b.GetHeaderByNumber(checkpoint.Number); ok {
+		if err := verifyCheckpoint(checkpoint, header); err != nil {
+			return fmt.Errorf("local chain does not contain the checkpoint: %w", err)
+		}
+	}
+
+	b.checkpoint = checkpoint
+
+	b.logger.Info("checkpoint set", "number", checkpoint.Number, "hash", checkpoint.Hash)
+
+	return nil
+}
+
+// Checkpoint returns the trusted checkpoint, or nil if it is not set
+func (b *Blockchain) Checkpoint() *Checkpoint {
+	return b.checkpoint
+}
+
+// verifyCheckpoint makes sure the header at the checkpoint height is the checkpoint block
+func verifyCheckpoint(checkpoint *Checkpoint, header *types.Header) error {
+	if checkpoint == nil || header.Number != checkpoint.Number || header.Hash == checkpoint.Hash {
+		return nil
+	}
+
+	return fmt.Errorf("%w: block %d has hash %s, expected %s",
+		ErrCheckpointMismatch, header.Number, header.Hash, checkpoint.Hash)
+}
+
+// VerifyCheckpointHeaders makes sure the headers end with the checkpoint block and are linked
+// by their parent hashes, so they are all trusted along with the checkpoint.
+// The headers starting after the genesis block have to be linked to it as well
+func (b *Blockchain) VerifyCheckpointHeaders(headers []*types.Header) error {
+	if b.checkpoint == nil {
+		return ErrNoCheckpoint
+	}
+
+	if len(headers) == 0 {
+		return fmt.Errorf("%w: no headers", ErrInvalidCheckpointHeaders)
+	}
+
+	last := headers[len(headers)-1]
+	if last.Number != b.checkpoint.Number || last.Hash != b.checkpoint.Hash {
+		return fmt.Errorf("%w: block %d has hash %s, expected %s at %d",
+			ErrCheckpointMismatch, last.Number, last.Hash, b.checkpoint.Hash, b.checkpoint.Number)
+	}
+
+	for i := len(headers) - 1; i > 0; i-- {
+		if headers[i-1].Number+1 != headers[i].Number || headers[i-1].Hash != headers[i].ParentHash {
+			return fmt.Errorf("%w: block %d is not the parent of block %d",
+				ErrInvalidCheckpointHeaders, headers[i-1].Number, headers[i].Number)
+		}
+	}
+
+	if first := headers[0]; first.Number == 0 || (first.Number == 1 && first.ParentHash != b.genesis) {
+		return fmt.Errorf("%w: block %d does not follow the genesis block", ErrInvalidCheckpointHeaders, first.Number)
+	}
+
+	return nil
+}
+
+// WriteCheckpointHeaders starts the empty chain from the checkpoint, writing the given headers up to it
+// as the canonical ones, without the blocks preceding them. The state at the checkpoint has to be saved already.
+// The difficulty of the skipped blocks is unknown, so the total difficulty is counted from the first written header.
+// The log index covers the blocks after the checkpoint only
+func (b *Blockchain) WriteCheckpointHeaders(headers []*types.Header) error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	if b.Header().Number != 0 {
+		return ErrCheckpointChainNotEmpty
+	}
+
+	if err := b.VerifyCheckpointHeaders(headers); err != nil {
+		return err
+	}
+
+	var (
+		batchWriter = storage.NewBatchWriter(b.db)
+		td          = new(big.Int).Set(b.CurrentTD())
+		last        = headers[len(headers)-1]
+	)
+
+	for _, header := range headers {
+		td.Add(td, new(big.Int).SetUint64(header.Difficulty))
+
+		batchWriter.PutHeader(header)
+		batchWriter.PutCanonicalHash(header.Number, header.Hash)
+		batchWriter.PutTotalDifficulty(header.Hash, new(big.Int).Set(td))
+	}
+
+	batchWriter.PutHeadHash(last.Hash)
+	batchWriter.PutHeadNumber(last.Number)
+	batchWriter.PutLogIndexHead(last.Number)
+
+	if err := b.writeBatchAndUpdate(batchWriter, last, td, true); err != nil {
+		return err
+	}
+
+	if b.logIndex != nil {
+		b.logIndex.Lock()
+		b.logIndex.head = last.Number
+		b.logIndex.Unlock()
+	}
+
+	evnt := &Event{Type: EventHead, Source: "checkpoint"}
+	evnt.AddNewHeader(last)
+	evnt.SetDifficulty(td)
+
+	b.dispatchEvent(evnt)
+
+	b.logger.Info("chain started from the checkpoint", "number", last.Number, "hash", last.Hash,
+		"headers", len(headers))
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	hash := types.StringToHash("0x1234")
+
+	tests := []struct {
+		name     string
+		raw      string
+		expected *Checkpoint
+	}{
+		{
+			name:     "valid",
+			raw:      "100:" + hash.String(),
+			expected: &Checkpoint{Number: 100, Hash: hash},
+		},
+		{
+			name:     "valid with spaces",
+			raw:      " 100 : " + hash.String(),
+			expected: &Checkpoint{Number: 100, Hash: hash},
+		},
+		{
+			name: "missing separator",
+			raw:  "100",
+		},
+		{
+			name: "invalid number",
+			raw:  "-1:" + hash.String(),
+		},
+		{
+			name: "invalid hash",
+			raw:  "100:0x12zz",
+		},
+		{
+			name: "short hash",
+			raw:  "100:0x1234",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			checkpoint, err := ParseCheckpoint(test.raw)
+			if test.expected == nil {
+				assert.ErrorIs(t, err, ErrInvalidCheckpoint)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, checkpoint)
+			assert.Equal(t, "100:"+hash.String(), checkpoint.String())
+		})
+	}
+}
+
+func TestBlockchain_SetCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	b := NewTestBlockchain(t, nil)
+	headers := NewTestHeadersWithSeed(b.Header(), 5, b.Header().GasLimit)
+
+	for _, header := range headers[1:3] {
+		require.NoError(t, b.WriteHeader(header, "test"))
+	}
+
+	// the local chain already has another block at the checkpoint height
+	assert.ErrorIs(t,
+		b.SetCheckpoint(&Checkpoint{Number: 2, Hash: types.StringToHash("0x1")}),
+		ErrCheckpointMismatch,
+	)
+	assert.Nil(t, b.Checkpoint())
+
+	checkpoint := &Checkpoint{Number: 2, Hash: headers[2].Hash}
+
+	require.NoError(t, b.SetCheckpoint(checkpoint))
+	assert.Equal(t, checkpoint, b.Checkpoint())
+
+	// the checkpoint ahead of the local chain
+	require.NoError(t, b.SetCheckpoint(&Checkpoint{Number: 4, Hash: headers[4].Hash}))
+}
+
+func TestBlockchain_VerifyCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	b := NewTestBlockchain(t, nil)
+	headers := NewTestHeadersWithSeed(b.Header(), 3, b.Header().GasLimit)
+
+	require.NoError(t, b.WriteHeader(headers[1], "test"))
+
+	require.NoError(t, b.SetCheckpoint(&Checkpoint{Number: 2, Hash: types.StringToHash("0x1")}))
+
+	assert.ErrorIs(t, b.VerifyFinalizedHeader(headers[2]), ErrCheckpointMismatch)
+	assert.ErrorIs(t, b.VerifyPotentialBlock(&types.Block{Header: headers[2]}), ErrCheckpointMismatch)
+
+	require.NoError(t, b.SetCheckpoint(&Checkpoint{Number: 2, Hash: headers[2].Hash}))
+
+	assert.NoError(t, b.VerifyFinalizedHeader(headers[2]))
+}
+
+func TestBlockchain_WriteCheckpointHeaders(t *testing.T) {
+	t.Parallel()
+
+	b := NewTestBlockchain(t, nil)
+	headers := NewTestHeadersWithSeed(b.Header(), 7, b.Header().GasLimit)
+
+	// the checkpoint has to be set first
+	assert.ErrorIs(t, b.WriteCheckpointHeaders(headers[3:6]), ErrNoCheckpoint)
+
+	require.NoError(t, b.SetCheckpoint(&Checkpoint{Number: 5, Hash: headers[5].Hash}))
+
+	tests := []struct {
+		name    string
+		headers []*types.Header
+		err     error
+	}{
+		{
+			name: "no headers",
+			err:  ErrInvalidCheckpointHeaders,
+		},
+		{
+			name:    "not ending with the checkpoint",
+			headers: headers[3:5],
+			err:     ErrCheckpointMismatch,
+		},
+		{
+			name:    "not linked",
+			headers: []*types.Header{headers[2], headers[4], headers[5]},
+			err:     ErrInvalidCheckpointHeaders,
+		},
+		{
+			name:    "not following the genesis",
+			headers: []*types.Header{{Number: 1}, headers[2], headers[3], headers[4], headers[5]},
+			err:     ErrInvalidCheckpointHeaders,
+		},
+	}
+
+	for _, test := range tests {
+		assert.ErrorIs(t, b.VerifyCheckpointHeaders(test.headers), test.err, test.name)
+	}
+
+	require.NoError(t, b.VerifyCheckpointHeaders(headers[1:6]))
+	require.NoError(t, b.WriteCheckpointHeaders(headers[3:6]))
+
+	assert.Equal(t, headers[5].Hash, b.Header().Hash)
+
+	// the blocks preceding the headers are skipped
+	_, ok := b.GetHeaderByNumber(2)
+	assert.False(t, ok)
+
+	header, ok := b.GetHeaderByNumber(3)
+	require.True(t, ok)
+	assert.Equal(t, headers[3].Hash, header.Hash)
+
+	head, ok := b.db.ReadLogIndexHead()
+	require.True(t, ok)
+	assert.Equal(t, uint64(5), head)
+
+	// the chain is only started once, and extended after the checkpoint
+	assert.ErrorIs(t, b.WriteCheckpointHeaders(headers[3:6]), ErrCheckpointChainNotEmpty)
+
+	require.NoError(t, b.WriteHeader(headers[6], "test"))
+	assert.Equal(t, uint64(6), b.Header().Number)
+}
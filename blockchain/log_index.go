@@ -0,0 +1,316 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// LogIndexSectionSize is the number of blocks covered by a single bit vector of the log index
+	LogIndexSectionSize uint64 = 4096
+
+	// logIndexBackfillLogInterval is the number of backfilled blocks after which the progress is logged
+	logIndexBackfillLogInterval uint64 = 10000
+)
+
+// logIndex is a persistent inverted index of the log addresses and topics.
+// For every address and topic it keeps a bit vector per section of blocks,
+// where a set bit marks a block containing at least one log with that address or topic.
+// The index covers all the blocks up to its head
+type logIndex struct {
+	sync.Mutex
+
+	logger hclog.Logger
+	db     storage.Storage
+
+	head uint64 // the last block covered by the index
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newLogIndex(logger hclog.Logger, db storage.Storage) *logIndex {
+	head, _ := db.ReadLogIndexHead()
+
+	return &logIndex{
+		logger:  logger,
+		db:      db,
+		head:    head,
+		closeCh: make(chan struct{}),
+	}
+}
+
+// Head returns the last block covered by the index
+func (l *logIndex) Head() uint64 {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.head
+}
+
+// indexBlock adds the logs of the given block to the index.
+// Blocks after the index head are skipped, they are added by the backfill
+func (l *logIndex) indexBlock(header *types.Header, receipts []*types.Receipt) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if header.Number > l.head+1 {
+		return nil
+	}
+
+	var (
+		section = header.Number / LogIndexSectionSize
+		offset  = header.Number % LogIndexSectionSize
+		batch   = storage.NewBatchWriter(l.db)
+		items   = make(map[string]struct{})
+	)
+
+	for _, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			items[string(log.Address.Bytes())] = struct{}{}
+
+			for _, topic := range log.Topics {
+				items[string(topic.Bytes())] = struct{}{}
+			}
+		}
+	}
+
+	for item := range items {
+		bits := make([]byte, LogIndexSectionSize/8)
+
+		if stored, ok := l.db.ReadLogIndexBits(section, []byte(item)); ok {
+			copy(bits, stored)
+		}
+
+		bits[offset/8] |= 1 << (7 - offset%8)
+
+		batch.PutLogIndexBits(section, []byte(item), bits)
+	}
+
+	// blocks already covered by the index (i.e. reorged ones) are merged into it,
+	// which can only produce false positives filtered out by the log matching
+	isNext := header.Number == l.head+1
+	if isNext {
+		batch.PutLogIndexHead(header.Number)
+	}
+
+	if err := batch.WriteBatch(); err != nil {
+		return err
+	}
+
+	if isNext {
+		l.head = header.Number
+	}
+
+	return nil
+}
+
+// filter returns the numbers of the blocks in the given range which may contain logs
+// with one of the given addresses and topics. Blocks after the index head are never returned
+func (l *logIndex) filter(
+	from, to uint64,
+	addresses []types.Address,
+	topics [][]types.Hash,
+) []uint64 {
+	if head := l.Head(); to > head {
+		to = head
+	}
+
+	blocks := make([]uint64, 0)
+
+	for section := from / LogIndexSectionSize; from <= to && section <= to/LogIndexSectionSize; section++ {
+		bits := l.sectionBits(section, addresses, topics)
+
+		start := section * LogIndexSectionSize
+		first, last := start, start+LogIndexSectionSize-1
+
+		if first < from {
+			first = from
+		}
+
+		if last > to {
+			last = to
+		}
+
+		for number := first; number <= last; number++ {
+			offset := number - start
+
+			if bits == nil || bits[offset/8]&(1<<(7-offset%8)) != 0 {
+				blocks = append(blocks, number)
+			}
+		}
+	}
+
+	return blocks
+}
+
+// sectionBits returns the bit vector of the section blocks matching the given addresses and topics.
+// Returns nil if every block of the section matches (no addresses and topics are given)
+func (l *logIndex) sectionBits(section uint64, addresses []types.Address, topics [][]types.Hash) []byte {
+	var result []byte
+
+	// the blocks have to match one of the addresses and one of the topics of every position
+	groups := make([][][]byte, 0, len(topics)+1)
+
+	if len(addresses) > 0 {
+		group := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			group[i] = address.Bytes()
+		}
+
+		groups = append(groups, group)
+	}
+
+	for _, position := range topics {
+		if len(position) == 0 {
+			continue
+		}
+
+		group := make([][]byte, len(position))
+		for i, topic := range position {
+			group[i] = topic.Bytes()
+		}
+
+		groups = append(groups, group)
+	}
+
+	for _, group := range groups {
+		bits := make([]byte, LogIndexSectionSize/8)
+
+		for _, item := range group {
+			stored, ok := l.db.ReadLogIndexBits(section, item)
+			if !ok {
+				continue
+			}
+
+			for i := 0; i < len(bits) && i < len(stored); i++ {
+				bits[i] |= stored[i]
+			}
+		}
+
+		if result == nil {
+			result = bits
+
+			continue
+		}
+
+		for i := range result {
+			result[i] &= bits[i]
+		}
+	}
+
+	return result
+}
+
+// EnableLogIndex enables the log index, which is updated on every block import.
+// Blocks written before the index has been enabled are added in the background
+func (b *Blockchain) EnableLogIndex() {
+	b.logIndex = newLogIndex(b.logger.Named("log_index"), b.db)
+
+	b.logIndex.wg.Add(1)
+
+	go b.backfillLogIndex()
+}
+
+// LogIndexHead returns the last block covered by the log index.
+// Returns false if the log index is disabled
+func (b *Blockchain) LogIndexHead() (uint64, bool) {
+	if b.logIndex == nil {
+		return 0, false
+	}
+
+	return b.logIndex.Head(), true
+}
+
+// FilterLogBlocks returns the numbers of the blocks in the given range which may contain logs
+// with one of the given addresses and one of the given topics of every position.
+// Only the blocks covered by the log index are returned
+func (b *Blockchain) FilterLogBlocks(
+	from, to uint64,
+	addresses []types.Address,
+	topics [][]types.Hash,
+) []uint64 {
+	if b.logIndex == nil {
+		return nil
+	}
+
+	return b.logIndex.filter(from, to, addresses, topics)
+}
+
+// indexBlockLogs adds the logs of the written block to the log index, if enabled
+func (b *Blockchain) indexBlockLogs(header *types.Header, receipts []*types.Receipt) {
+	if b.logIndex == nil {
+		return
+	}
+
+	if err := b.logIndex.indexBlock(header, receipts); err != nil {
+		// the block is added by the backfill on the next start
+		b.logIndex.logger.Error("failed to add block to the log index", "block", header.Number, "err", err)
+	}
+}
+
+// backfillLogIndex adds the blocks between the log index head and the chain head to the log index
+func (b *Blockchain) backfillLogIndex() {
+	defer b.logIndex.wg.Done()
+
+	start := b.logIndex.Head()
+
+	for {
+		select {
+		case <-b.logIndex.closeCh:
+			return
+		default:
+		}
+
+		next := b.logIndex.Head() + 1
+		if next > b.Header().Number {
+			break
+		}
+
+		if err := b.indexBlockByNumber(next); err != nil {
+			b.logIndex.logger.Error("failed to backfill the log index", "block", next, "err", err)
+
+			return
+		}
+
+		if (next-start)%logIndexBackfillLogInterval == 0 {
+			b.logIndex.logger.Info("backfilling the log index", "block", next, "head", b.Header().Number)
+		}
+	}
+
+	if head := b.logIndex.Head(); head > start {
+		b.logIndex.logger.Info("log index backfilled", "from", start+1, "to", head)
+	}
+}
+
+// indexBlockByNumber adds the logs of the stored block with the given number to the log index
+func (b *Blockchain) indexBlockByNumber(number uint64) error {
+	header, ok := b.GetHeaderByNumber(number)
+	if !ok {
+		return fmt.Errorf("header %d not found", number)
+	}
+
+	// blocks written without the receipts have no logs to index
+	receipts, err := b.GetReceiptsByHash(header.Hash)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+
+	return b.logIndex.indexBlock(header, receipts)
+}
+
+// closeLogIndex stops the log index backfill
+func (b *Blockchain) closeLogIndex() {
+	if b.logIndex == nil {
+		return
+	}
+
+	close(b.logIndex.closeCh)
+	b.logIndex.wg.Wait()
+}
@@ -0,0 +1,120 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestBlockchain_LogIndex(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr1  = types.StringToAddress("1")
+		addr2  = types.StringToAddress("2")
+		topic1 = types.StringToHash("100")
+		topic2 = types.StringToHash("200")
+	)
+
+	headers := NewTestHeaders(10)
+	b := NewTestBlockchain(t, headers)
+
+	// blocks written before the log index is enabled
+	batchWriter := storage.NewBatchWriter(b.db)
+	batchWriter.PutReceipts(headers[3].Hash, []*types.Receipt{
+		{Logs: []*types.Log{{Address: addr1, Topics: []types.Hash{topic1}}}},
+	})
+	batchWriter.PutReceipts(headers[7].Hash, []*types.Receipt{
+		{Logs: []*types.Log{{Address: addr2}}},
+		{Logs: []*types.Log{{Address: addr2, Topics: []types.Hash{topic1, topic2}}}},
+	})
+	require.NoError(t, batchWriter.WriteBatch())
+
+	_, enabled := b.LogIndexHead()
+	require.False(t, enabled)
+	assert.Nil(t, b.FilterLogBlocks(1, 9, nil, nil))
+
+	b.EnableLogIndex()
+	b.logIndex.wg.Wait()
+
+	head, enabled := b.LogIndexHead()
+	require.True(t, enabled)
+	require.Equal(t, uint64(9), head)
+
+	assert.Equal(t, []uint64{3}, b.FilterLogBlocks(1, 9, []types.Address{addr1}, nil))
+	assert.Equal(t, []uint64{3, 7}, b.FilterLogBlocks(1, 9, []types.Address{addr1, addr2}, nil))
+	assert.Equal(t, []uint64{3, 7}, b.FilterLogBlocks(1, 9, nil, [][]types.Hash{{topic1}}))
+	assert.Equal(t, []uint64{7}, b.FilterLogBlocks(1, 9, nil, [][]types.Hash{{topic1}, {topic2}}))
+	assert.Equal(t, []uint64{7}, b.FilterLogBlocks(4, 9, nil, [][]types.Hash{{topic1}}))
+	assert.Empty(t, b.FilterLogBlocks(1, 9, []types.Address{addr1}, [][]types.Hash{nil, {topic2}}))
+	assert.Equal(t, []uint64{8, 9}, b.FilterLogBlocks(8, 100, nil, nil))
+
+	// new blocks are indexed on import
+	header := &types.Header{
+		Number:     10,
+		ParentHash: headers[9].Hash,
+	}
+	header.ComputeHash()
+
+	require.NoError(t, b.WriteFullBlock(&types.FullBlock{
+		Block: &types.Block{Header: header},
+		Receipts: []*types.Receipt{
+			{Logs: []*types.Log{{Address: addr1}}},
+		},
+	}, "test"))
+
+	head, _ = b.LogIndexHead()
+	require.Equal(t, uint64(10), head)
+
+	assert.Equal(t, []uint64{3, 10}, b.FilterLogBlocks(1, 10, []types.Address{addr1}, nil))
+
+	require.NoError(t, b.Close())
+}
+
+func TestLogIndex_Sections(t *testing.T) {
+	t.Parallel()
+
+	addr1 := types.StringToAddress("1")
+
+	db, err := memory.NewMemoryStorage(nil)
+	require.NoError(t, err)
+
+	index := newLogIndex(hclog.NewNullLogger(), db)
+	index.head = LogIndexSectionSize - 2
+
+	newHeader := func(number uint64) *types.Header {
+		return &types.Header{Number: number}
+	}
+
+	receipts := []*types.Receipt{
+		{Logs: []*types.Log{{Address: addr1}}},
+	}
+
+	// blocks after the next one are skipped
+	require.NoError(t, index.indexBlock(newHeader(LogIndexSectionSize), receipts))
+	assert.Equal(t, LogIndexSectionSize-2, index.Head())
+
+	require.NoError(t, index.indexBlock(newHeader(LogIndexSectionSize-1), receipts))
+	require.NoError(t, index.indexBlock(newHeader(LogIndexSectionSize), receipts))
+	assert.Equal(t, LogIndexSectionSize, index.Head())
+
+	// already indexed blocks are merged
+	require.NoError(t, index.indexBlock(newHeader(5), receipts))
+	assert.Equal(t, LogIndexSectionSize, index.Head())
+
+	assert.Equal(t,
+		[]uint64{5, LogIndexSectionSize - 1, LogIndexSectionSize},
+		index.filter(1, 2*LogIndexSectionSize, []types.Address{addr1}, nil),
+	)
+
+	// the head is persisted
+	head, ok := db.ReadLogIndexHead()
+	require.True(t, ok)
+	assert.Equal(t, LogIndexSectionSize, head)
+}
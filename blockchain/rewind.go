@@ -0,0 +1,74 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+)
+
+var ErrRewindAboveHead = errors.New("rewind target is above the chain head")
+
+// RewindHead moves the head of the chain back to the canonical block with the given number.
+// The blocks above it are removed from the canonical chain and their transactions can no longer
+// be looked up, while their data is kept in the storage. Listeners get a reorg event.
+// It is meant for the dev mode, the consensus engines never rewind finalized blocks
+func (b *Blockchain) RewindHead(number uint64) error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	head := b.Header()
+	if number > head.Number {
+		return fmt.Errorf("%w: target %d, head %d", ErrRewindAboveHead, number, head.Number)
+	}
+
+	if number == head.Number {
+		return nil
+	}
+
+	header, ok := b.GetHeaderByNumber(number)
+	if !ok {
+		return fmt.Errorf("header %d not found", number)
+	}
+
+	td, ok := b.readTotalDifficulty(header.Hash)
+	if !ok {
+		return fmt.Errorf("total difficulty of %s (%d) not found", header.Hash, number)
+	}
+
+	batchWriter := storage.NewBatchWriter(b.db)
+	evnt := &Event{Type: EventReorg}
+
+	for n := head.Number; n > number; n-- {
+		removed, ok := b.GetHeaderByNumber(n)
+		if !ok {
+			return fmt.Errorf("header %d not found", n)
+		}
+
+		if body, ok := b.readBody(removed.Hash); ok {
+			for _, tx := range body.Transactions {
+				batchWriter.DeleteTxLookup(tx.Hash)
+			}
+		}
+
+		batchWriter.DeleteCanonicalHash(n)
+		evnt.AddOldHeader(removed)
+	}
+
+	batchWriter.PutHeadHash(header.Hash)
+	batchWriter.PutHeadNumber(header.Number)
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		return err
+	}
+
+	b.setCurrentHeader(header, td)
+
+	evnt.AddNewHeader(header)
+	evnt.SetDifficulty(td)
+	b.dispatchEvent(evnt)
+
+	b.logger.Info("chain head rewound", "number", number, "hash", header.Hash, "removed", head.Number-number)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchain_RewindHead(t *testing.T) {
+	t.Parallel()
+
+	b := NewTestBlockchain(t, nil)
+	headers := NewTestHeadersWithSeed(b.Header(), 5, b.Header().GasLimit)
+
+	for _, header := range headers[1:] {
+		require.NoError(t, b.WriteHeader(header, "test"))
+	}
+
+	tx := &types.Transaction{
+		Value: big.NewInt(1),
+		V:     big.NewInt(1),
+		From:  types.StringToAddress("0x1"),
+	}
+	tx.ComputeHash()
+
+	batchWriter := storage.NewBatchWriter(b.db)
+	require.NoError(t, b.writeBody(batchWriter, &types.Block{
+		Header:       headers[3],
+		Transactions: []*types.Transaction{tx},
+	}))
+	require.NoError(t, batchWriter.WriteBatch())
+
+	sub := b.SubscribeEvents()
+	defer sub.Close()
+
+	assert.ErrorIs(t, b.RewindHead(5), ErrRewindAboveHead)
+
+	require.NoError(t, b.RewindHead(2))
+
+	assert.Equal(t, headers[2].Hash, b.Header().Hash)
+
+	head, ok := b.db.ReadHeadHash()
+	require.True(t, ok)
+	assert.Equal(t, headers[2].Hash, head)
+
+	// the removed blocks are no longer canonical
+	for _, header := range headers[3:] {
+		_, ok := b.GetHeaderByNumber(header.Number)
+		assert.False(t, ok)
+
+		// but are still stored
+		_, ok = b.GetHeaderByHash(header.Hash)
+		assert.True(t, ok)
+	}
+
+	_, ok = b.ReadTxLookup(tx.Hash)
+	assert.False(t, ok)
+
+	evnt := <-sub.GetEventCh()
+	assert.Equal(t, EventReorg, evnt.Type)
+	assert.Equal(t, headers[2].Hash, evnt.Header().Hash)
+	require.Len(t, evnt.OldChain, 2)
+	assert.Equal(t, headers[4].Hash, evnt.OldChain[0].Hash)
+	assert.Equal(t, headers[3].Hash, evnt.OldChain[1].Hash)
+
+	// the chain continues from the new head
+	forkHeaders := AppendNewTestheadersWithSeed(headers[:3], 1, 100)
+	require.NoError(t, b.WriteHeader(forkHeaders[3], "test"))
+
+	assert.Equal(t, forkHeaders[3].Hash, b.Header().Hash)
+}
@@ -39,6 +39,9 @@ var (
 
 	// TX_LOOKUP_PREFIX is the prefix for transaction lookups
 	TX_LOOKUP_PREFIX = []byte("l")
+
+	// LOG_INDEX is the prefix for the log index
+	LOG_INDEX = []byte("i")
 )
 
 // Sub-prefixes
@@ -185,6 +188,27 @@ func (s *KeyValueStorage) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
 	return types.BytesToHash(blockHash), true
 }
 
+// LOG INDEX //
+
+// ReadLogIndexHead returns the number of the last block added to the log index
+func (s *KeyValueStorage) ReadLogIndexHead() (uint64, bool) {
+	data, ok := s.get(LOG_INDEX, NUMBER)
+	if !ok {
+		return 0, false
+	}
+
+	if len(data) != 8 {
+		return 0, false
+	}
+
+	return common.EncodeBytesToUint64(data), true
+}
+
+// ReadLogIndexBits reads the bit vector of the log index section for the given address or topic
+func (s *KeyValueStorage) ReadLogIndexBits(section uint64, item []byte) ([]byte, bool) {
+	return s.get(LOG_INDEX, logIndexKey(section, item))
+}
+
 var ErrNotFound = fmt.Errorf("not found")
 
 func (s *KeyValueStorage) readRLP(p, k []byte, raw types.RLPUnmarshaler) error {
@@ -244,6 +268,15 @@ func (s *KeyValueStorage) Close() error {
 	return s.db.Close()
 }
 
+// Compact compacts the underlying database, if it supports the compaction
+func (s *KeyValueStorage) Compact() error {
+	if db, ok := s.db.(interface{ Compact() error }); ok {
+		return db.Compact()
+	}
+
+	return nil
+}
+
 // NewBatch creates batch used for write/update/delete operations
 func (s *KeyValueStorage) NewBatch() Batch {
 	return s.db.NewBatch()
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const (
@@ -82,6 +83,11 @@ func (l *levelDBKV) Close() error {
 	return l.db.Close()
 }
 
+// Compact compacts the whole leveldb storage, discarding the deleted and overwritten entries
+func (l *levelDBKV) Compact() error {
+	return l.db.CompactRange(util.Range{})
+}
+
 func (l *levelDBKV) NewBatch() storage.Batch {
 	return NewBatchLevelDB(l.db)
 }
@@ -48,6 +48,32 @@ func TestStorage(t *testing.T) {
 	storage.TestStorage(t, newStorage)
 }
 
+func TestCompact(t *testing.T) {
+	s, closeFn := newStorage(t)
+	defer closeFn()
+
+	batch := storage.NewBatchWriter(s)
+
+	for i := uint64(0); i < 100; i++ {
+		batch.PutCanonicalHash(i, types.StringToHash("0x1"))
+	}
+
+	for i := uint64(0); i < 50; i++ {
+		batch.DeleteCanonicalHash(i)
+	}
+
+	require.NoError(t, batch.WriteBatch())
+
+	compacter, ok := s.(interface{ Compact() error })
+	require.True(t, ok)
+	require.NoError(t, compacter.Compact())
+
+	// the data is kept
+	hash, ok := s.ReadCanonicalHash(99)
+	require.True(t, ok)
+	require.Equal(t, types.StringToHash("0x1"), hash)
+}
+
 func generateTxs(t *testing.T, startNonce, count int, from types.Address, to *types.Address) []*types.Transaction {
 	t.Helper()
 
@@ -26,6 +26,9 @@ type Storage interface {
 
 	ReadTxLookup(hash types.Hash) (types.Hash, bool)
 
+	ReadLogIndexHead() (uint64, bool)
+	ReadLogIndexBits(section uint64, item []byte) ([]byte, bool)
+
 	NewBatch() Batch
 
 	Close() error
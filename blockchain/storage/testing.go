@@ -49,6 +49,9 @@ func TestStorage(t *testing.T, m PlaceholderStorage) {
 	t.Run("testReceipts", func(t *testing.T) {
 		testReceipts(t, m)
 	})
+	t.Run("testLogIndex", func(t *testing.T) {
+		testLogIndex(t, m)
+	})
 }
 
 func testCanonicalChain(t *testing.T, m PlaceholderStorage) {
@@ -394,6 +397,44 @@ func testReceipts(t *testing.T, m PlaceholderStorage) {
 	assert.True(t, reflect.DeepEqual(receipts, found))
 }
 
+func testLogIndex(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	_, ok := s.ReadLogIndexHead()
+	assert.False(t, ok)
+
+	batch := NewBatchWriter(s)
+
+	batch.PutLogIndexHead(10)
+	batch.PutLogIndexBits(1, addr1.Bytes(), []byte{0x1})
+	batch.PutLogIndexBits(2, addr1.Bytes(), []byte{0x2})
+	batch.PutLogIndexBits(1, hash1.Bytes(), []byte{0x3})
+
+	require.NoError(t, batch.WriteBatch())
+
+	head, ok := s.ReadLogIndexHead()
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), head)
+
+	bits, ok := s.ReadLogIndexBits(1, addr1.Bytes())
+	require.True(t, ok)
+	assert.Equal(t, []byte{0x1}, bits)
+
+	bits, ok = s.ReadLogIndexBits(2, addr1.Bytes())
+	require.True(t, ok)
+	assert.Equal(t, []byte{0x2}, bits)
+
+	bits, ok = s.ReadLogIndexBits(1, hash1.Bytes())
+	require.True(t, ok)
+	assert.Equal(t, []byte{0x3}, bits)
+
+	_, ok = s.ReadLogIndexBits(1, addr2.Bytes())
+	assert.False(t, ok)
+}
+
 func testWriteCanonicalHeader(t *testing.T, m PlaceholderStorage) {
 	t.Helper()
 
@@ -460,6 +501,8 @@ type readBodyDelegate func(types.Hash) (*types.Body, error)
 type readSnapshotDelegate func(types.Hash) ([]byte, bool)
 type readReceiptsDelegate func(types.Hash) ([]*types.Receipt, error)
 type readTxLookupDelegate func(types.Hash) (types.Hash, bool)
+type readLogIndexHeadDelegate func() (uint64, bool)
+type readLogIndexBitsDelegate func(uint64, []byte) ([]byte, bool)
 type closeDelegate func() error
 type newBatchDelegate func() Batch
 
@@ -473,6 +516,8 @@ type MockStorage struct {
 	readBodyFn            readBodyDelegate
 	readReceiptsFn        readReceiptsDelegate
 	readTxLookupFn        readTxLookupDelegate
+	readLogIndexHeadFn    readLogIndexHeadDelegate
+	readLogIndexBitsFn    readLogIndexBitsDelegate
 	closeFn               closeDelegate
 	newBatchFn            newBatchDelegate
 }
@@ -589,6 +634,30 @@ func (m *MockStorage) HookReadTxLookup(fn readTxLookupDelegate) {
 	m.readTxLookupFn = fn
 }
 
+func (m *MockStorage) ReadLogIndexHead() (uint64, bool) {
+	if m.readLogIndexHeadFn != nil {
+		return m.readLogIndexHeadFn()
+	}
+
+	return 0, false
+}
+
+func (m *MockStorage) HookReadLogIndexHead(fn readLogIndexHeadDelegate) {
+	m.readLogIndexHeadFn = fn
+}
+
+func (m *MockStorage) ReadLogIndexBits(section uint64, item []byte) ([]byte, bool) {
+	if m.readLogIndexBitsFn != nil {
+		return m.readLogIndexBitsFn(section, item)
+	}
+
+	return nil, false
+}
+
+func (m *MockStorage) HookReadLogIndexBits(fn readLogIndexBitsDelegate) {
+	m.readLogIndexBitsFn = fn
+}
+
 func (m *MockStorage) Close() error {
 	if m.closeFn != nil {
 		return m.closeFn()
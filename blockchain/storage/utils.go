@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/fastrlp"
 )
@@ -52,3 +53,9 @@ func (f *Forks) UnmarshalRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) error {
 
 	return nil
 }
+
+// logIndexKey returns the key (without the prefix) of the log index bit vector
+// of the given section for the given address or topic
+func logIndexKey(section uint64, item []byte) []byte {
+	return append(common.EncodeUint64ToBytes(section), item...)
+}
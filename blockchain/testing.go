@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -305,7 +306,8 @@ type mockExecutor struct {
 	processBlockFn processBlockDelegate
 }
 
-func (m *mockExecutor) ProcessBlock(
+func (m *mockExecutor) ProcessBlockWithContext(
+	_ context.Context,
 	parentRoot types.Hash,
 	block *types.Block,
 	blockCreator types.Address,
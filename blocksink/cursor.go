@@ -0,0 +1,71 @@
+package blocksink
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// CursorFileName is the name of the file persisting the last delivered block in the data directory
+const CursorFileName = "blocksink.cursor"
+
+// Cursor is the last block delivered to the sink
+type Cursor struct {
+	Number uint64     `json:"number"`
+	Hash   types.Hash `json:"hash"`
+}
+
+// readCursor reads the cursor from the file, returns nil if the cursor is not persisted yet
+func readCursor(path string) (*Cursor, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read the cursor: %w", err)
+	}
+
+	cursor := &Cursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("failed to decode the cursor: %w", err)
+	}
+
+	return cursor, nil
+}
+
+// writeCursor persists the cursor. The cursor is written to a temporary file
+// and renamed over the previous one, so a crash never leaves a partially written cursor
+func writeCursor(path string, cursor *Cursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create the cursor: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("failed to write the cursor: %w", err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("failed to sync the cursor: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write the cursor: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
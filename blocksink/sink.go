@@ -0,0 +1,136 @@
+package blocksink
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var errNoTarget = errors.New("the file sink requires the target file")
+
+// writerSink writes the blocks as JSON lines to the writer
+type writerSink struct {
+	lock sync.Mutex
+
+	writer io.Writer
+	sync   func() error
+	close  func() error
+}
+
+// NewStdoutSink creates the sink writing the blocks to the standard output
+func NewStdoutSink(_ string) (Sink, error) {
+	return &writerSink{
+		writer: os.Stdout,
+		sync:   func() error { return nil },
+		close:  func() error { return nil },
+	}, nil
+}
+
+// NewFileSink creates the sink appending the blocks to the target file.
+// The file is synced after every block, so the delivered blocks survive a crash
+func NewFileSink(target string) (Sink, error) {
+	if target == "" {
+		return nil, errNoTarget
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the sink file: %w", err)
+	}
+
+	return &writerSink{
+		writer: file,
+		sync:   file.Sync,
+		close:  file.Close,
+	}, nil
+}
+
+// Publish writes the block as a single JSON line
+func (s *writerSink) Publish(block *Block) error {
+	data, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, err := s.writer.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return s.sync()
+}
+
+// Close closes the underlying file
+func (s *writerSink) Close() error {
+	return s.close()
+}
+
+// MemorySink keeps the published blocks in memory. It is meant for the in-process consumers
+// and the tests, which can make the publishing fail to simulate an unavailable message bus
+type MemorySink struct {
+	lock sync.Mutex
+
+	blocks   []*Block
+	err      error
+	notifyCh chan struct{}
+}
+
+// NewMemorySink creates the in-memory sink
+func NewMemorySink() *MemorySink {
+	return &MemorySink{
+		notifyCh: make(chan struct{}, 1),
+	}
+}
+
+// Publish keeps the block, unless the publishing is set to fail
+func (s *MemorySink) Publish(block *Block) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	s.blocks = append(s.blocks, block)
+
+	select {
+	case s.notifyCh <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Close does nothing, the published blocks are kept
+func (s *MemorySink) Close() error {
+	return nil
+}
+
+// SetError makes the publishing fail with the given error, nil makes it succeed again
+func (s *MemorySink) SetError(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.err = err
+}
+
+// Blocks returns the published blocks
+func (s *MemorySink) Blocks() []*Block {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	blocks := make([]*Block, len(s.blocks))
+	copy(blocks, s.blocks)
+
+	return blocks
+}
+
+// NotifyCh returns the channel notified whenever a block is published
+func (s *MemorySink) NotifyCh() <-chan struct{} {
+	return s.notifyCh
+}
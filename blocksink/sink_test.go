@@ -0,0 +1,100 @@
+package blocksink
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFileSink("")
+	require.ErrorIs(t, err, errNoTarget)
+
+	path := filepath.Join(t.TempDir(), "blocks.jsonl")
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Publish(&Block{Number: 1, Transactions: []*Transaction{}}))
+	require.NoError(t, sink.Close())
+
+	// the blocks are appended to the existing file
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Publish(&Block{Number: 2, Transactions: []*Transaction{}}))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	var numbers []uint64
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		block := &Block{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), block))
+
+		numbers = append(numbers, block.Number)
+	}
+
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []uint64{1, 2}, numbers)
+}
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), CursorFileName)
+
+	cursor, err := readCursor(path)
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	expected := &Cursor{Number: 10, Hash: types.StringToHash("1")}
+
+	require.NoError(t, writeCursor(path, expected))
+	require.NoError(t, writeCursor(path, expected))
+
+	cursor, err = readCursor(path)
+	require.NoError(t, err)
+	assert.Equal(t, expected, cursor)
+
+	// the temporary files are not left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestToBlock_LogIndex(t *testing.T) {
+	t.Parallel()
+
+	block := &types.Block{
+		Header:       &types.Header{Number: 5},
+		Transactions: []*types.Transaction{{Nonce: 1}, {Nonce: 2}},
+	}
+
+	receipts := []*types.Receipt{
+		{Logs: []*types.Log{{}, {}}},
+		{Logs: []*types.Log{{}}},
+	}
+
+	msg := toBlock(block, receipts)
+
+	require.Len(t, msg.Transactions, 2)
+	assert.Equal(t, "0x0", msg.Transactions[0].Value)
+	assert.Equal(t, uint64(0), msg.Transactions[0].Receipt.Logs[0].Index)
+	assert.Equal(t, uint64(1), msg.Transactions[0].Receipt.Logs[1].Index)
+	assert.Equal(t, uint64(2), msg.Transactions[1].Receipt.Logs[0].Index)
+}
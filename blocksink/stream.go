@@ -0,0 +1,235 @@
+package blocksink
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+)
+
+const (
+	// DefaultRetryInterval is the initial interval between the attempts to publish the block
+	DefaultRetryInterval = time.Second
+
+	// maxRetryInterval is the interval the retries back off to while the sink is unavailable
+	maxRetryInterval = 30 * time.Second
+)
+
+var errBlockNotFound = errors.New("block not found")
+
+// Config is the configuration of the block stream
+type Config struct {
+	// Type is the type of the sink the blocks are published to
+	Type SinkType
+
+	// Target is the target of the sink, i.e. the path of the file
+	Target string
+
+	// CursorPath is the path of the file persisting the last delivered block
+	CursorPath string
+
+	// ReplayFrom is the block the stream is replayed from.
+	// If nil, the stream resumes after the cursor, or starts with the next block
+	ReplayFrom *uint64
+
+	// Traces enables the call traces of the published transactions
+	Traces bool
+}
+
+// Stream publishes every finalized block, in order, to the sink. The cursor is persisted
+// once the block is published, so the blocks are delivered at least once: after a restart
+// the stream resumes after the cursor, which may deliver the last published block again
+type Stream struct {
+	logger  hclog.Logger
+	config  *Config
+	backend Backend
+	tracer  Tracer
+	sink    Sink
+
+	// next is the number of the next block to publish
+	next uint64
+
+	retryInterval time.Duration
+
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewStream creates the stream publishing the blocks of the backend to the sink.
+// The tracer is only used if the call traces are enabled
+func NewStream(logger hclog.Logger, config *Config, backend Backend, tracer Tracer, sink Sink) *Stream {
+	return &Stream{
+		logger:        logger.Named("block_sink"),
+		config:        config,
+		backend:       backend,
+		tracer:        tracer,
+		sink:          sink,
+		retryInterval: DefaultRetryInterval,
+		closeCh:       make(chan struct{}),
+	}
+}
+
+// Start starts publishing the blocks in the background
+func (s *Stream) Start() error {
+	cursor, err := readCursor(s.config.CursorPath)
+	if err != nil {
+		return err
+	}
+
+	// the new blocks are published once they are notified,
+	// the notifications missed before the subscription are covered by the catch up
+	sub := s.backend.SubscribeEvents()
+
+	switch {
+	case s.config.ReplayFrom != nil:
+		s.next = *s.config.ReplayFrom
+
+		s.logger.Info("replaying the blocks", "from", s.next)
+	case cursor != nil:
+		s.checkCursor(cursor)
+		s.next = cursor.Number + 1
+
+		s.logger.Info("resuming after the cursor", "number", cursor.Number)
+	default:
+		s.next = s.backend.Header().Number + 1
+
+		s.logger.Info("starting with the next block", "number", s.next)
+	}
+
+	notifyCh := make(chan struct{}, 1)
+
+	s.wg.Add(2)
+
+	go s.listen(sub, notifyCh)
+	go s.run(notifyCh)
+
+	return nil
+}
+
+// Close stops publishing the blocks and closes the sink
+func (s *Stream) Close() error {
+	close(s.closeCh)
+	s.wg.Wait()
+
+	return s.sink.Close()
+}
+
+// checkCursor warns if the delivered block is not part of the chain anymore, i.e. after a regenesis
+func (s *Stream) checkCursor(cursor *Cursor) {
+	block, ok := s.backend.GetBlockByNumber(cursor.Number, false)
+	if ok && block.Hash() != cursor.Hash {
+		s.logger.Warn("the cursor does not match the chain, use the replay to publish the chain again",
+			"number", cursor.Number, "cursor", cursor.Hash, "chain", block.Hash())
+	}
+}
+
+// listen notifies the publishing of the new blocks
+func (s *Stream) listen(sub blockchain.Subscription, notifyCh chan<- struct{}) {
+	defer s.wg.Done()
+	defer sub.Close()
+
+	for {
+		select {
+		case <-s.closeCh:
+			return
+		case <-sub.GetEventCh():
+			select {
+			case notifyCh <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// run publishes the blocks up to the head of the chain, whenever a new block is notified
+func (s *Stream) run(notifyCh <-chan struct{}) {
+	defer s.wg.Done()
+
+	for {
+		for s.next <= s.backend.Header().Number {
+			if !s.publishWithRetry(s.next) {
+				return
+			}
+
+			s.next++
+		}
+
+		select {
+		case <-s.closeCh:
+			return
+		case <-notifyCh:
+		}
+	}
+}
+
+// publishWithRetry publishes the block until it is delivered, backing off while the sink is unavailable.
+// Returns false if the stream is closed before the block is delivered
+func (s *Stream) publishWithRetry(number uint64) bool {
+	interval := s.retryInterval
+
+	for {
+		err := s.publish(number)
+		if err == nil {
+			return true
+		}
+
+		s.logger.Error("failed to publish the block", "number", number, "retry_in", interval, "err", err)
+
+		select {
+		case <-s.closeCh:
+			return false
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > maxRetryInterval {
+			interval = maxRetryInterval
+		}
+	}
+}
+
+// publish publishes the block to the sink and moves the cursor to it
+func (s *Stream) publish(number uint64) error {
+	block, err := s.buildBlock(number)
+	if err != nil {
+		return err
+	}
+
+	if err := s.sink.Publish(block); err != nil {
+		return fmt.Errorf("failed to publish the block: %w", err)
+	}
+
+	return writeCursor(s.config.CursorPath, &Cursor{Number: block.Number, Hash: block.Hash})
+}
+
+// buildBlock builds the message of the block, along with its receipts and call traces
+func (s *Stream) buildBlock(number uint64) (*Block, error) {
+	block, ok := s.backend.GetBlockByNumber(number, true)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", errBlockNotFound, number)
+	}
+
+	if len(block.Transactions) == 0 {
+		return toBlock(block, nil), nil
+	}
+
+	receipts, err := s.backend.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the receipts of the block %d: %w", number, err)
+	}
+
+	msg := toBlock(block, receipts)
+
+	if s.config.Traces && s.tracer != nil {
+		if msg.Traces, err = s.tracer.TraceBlock(block, calltracer.NewCallTracer()); err != nil {
+			return nil, fmt.Errorf("failed to trace the block %d: %w", number, err)
+		}
+	}
+
+	return msg, nil
+}
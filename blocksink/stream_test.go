@@ -0,0 +1,298 @@
+package blocksink
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockBackend struct {
+	lock sync.Mutex
+
+	blocks   []*types.Block
+	receipts map[types.Hash][]*types.Receipt
+	sub      *blockchain.MockSubscription
+}
+
+func newMockBackend(t *testing.T, blocks uint64) *mockBackend {
+	t.Helper()
+
+	backend := &mockBackend{receipts: make(map[types.Hash][]*types.Receipt)}
+
+	for i := uint64(0); i <= blocks; i++ {
+		backend.appendBlock()
+	}
+
+	return backend
+}
+
+// appendBlock appends the block with a single transaction emitting a log
+func (m *mockBackend) appendBlock() *types.Block {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	number := uint64(len(m.blocks))
+	header := &types.Header{Number: number}
+
+	if number > 0 {
+		header.ParentHash = m.blocks[number-1].Hash()
+	}
+
+	block := &types.Block{Header: header.ComputeHash()}
+
+	if number > 0 {
+		tx := &types.Transaction{Nonce: number, Value: big.NewInt(1), Gas: 21000}
+		block.Transactions = []*types.Transaction{tx.ComputeHash()}
+
+		receipt := &types.Receipt{
+			GasUsed: 21000,
+			TxHash:  tx.Hash,
+			Logs:    []*types.Log{{Address: types.StringToAddress("1"), Data: []byte{byte(number)}}},
+		}
+		receipt.SetStatus(types.ReceiptSuccess)
+
+		m.receipts[block.Hash()] = []*types.Receipt{receipt}
+	}
+
+	m.blocks = append(m.blocks, block)
+
+	return block
+}
+
+// writeBlock appends the block and notifies the subscription
+func (m *mockBackend) writeBlock() {
+	block := m.appendBlock()
+
+	m.lock.Lock()
+	sub := m.sub
+	m.lock.Unlock()
+
+	sub.Push(&blockchain.Event{NewChain: []*types.Header{block.Header}})
+}
+
+func (m *mockBackend) Header() *types.Header {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.blocks[len(m.blocks)-1].Header
+}
+
+func (m *mockBackend) GetBlockByNumber(number uint64, _ bool) (*types.Block, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if number >= uint64(len(m.blocks)) {
+		return nil, false
+	}
+
+	return m.blocks[number], true
+}
+
+func (m *mockBackend) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.receipts[hash], nil
+}
+
+func (m *mockBackend) SubscribeEvents() blockchain.Subscription {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.sub = blockchain.NewMockSubscription()
+
+	return m.sub
+}
+
+type mockTracer struct{}
+
+func (mockTracer) TraceBlock(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
+	if _, ok := tracer.(*calltracer.CallTracer); !ok {
+		return nil, errors.New("unexpected tracer")
+	}
+
+	traces := make([]interface{}, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		traces[i] = tx.Hash
+	}
+
+	return traces, nil
+}
+
+// startStream starts the stream publishing the backend blocks to the memory sink
+func startStream(t *testing.T, config *Config, backend *mockBackend) (*Stream, *MemorySink) {
+	t.Helper()
+
+	sink := NewMemorySink()
+
+	stream := NewStream(hclog.NewNullLogger(), config, backend, mockTracer{}, sink)
+	stream.retryInterval = 10 * time.Millisecond
+
+	require.NoError(t, stream.Start())
+
+	return stream, sink
+}
+
+// waitForBlock waits until the block with the given number is published
+func waitForBlock(t *testing.T, sink *MemorySink, number uint64) []*Block {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+
+	for {
+		blocks := sink.Blocks()
+		if len(blocks) > 0 && blocks[len(blocks)-1].Number >= number {
+			return blocks
+		}
+
+		select {
+		case <-sink.NotifyCh():
+		case <-timeout:
+			t.Fatalf("block %d not published", number)
+		}
+	}
+}
+
+func blockNumbers(blocks []*Block) []uint64 {
+	numbers := make([]uint64, len(blocks))
+	for i, block := range blocks {
+		numbers[i] = block.Number
+	}
+
+	return numbers
+}
+
+func TestStream_ResumeAfterCursor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend = newMockBackend(t, 3)
+		config  = &Config{CursorPath: filepath.Join(t.TempDir(), CursorFileName)}
+	)
+
+	// without the cursor the stream starts with the next block
+	stream, sink := startStream(t, config, backend)
+
+	backend.writeBlock()
+	backend.writeBlock()
+
+	blocks := waitForBlock(t, sink, 5)
+	require.NoError(t, stream.Close())
+
+	assert.Equal(t, []uint64{4, 5}, blockNumbers(blocks))
+
+	cursor, err := readCursor(config.CursorPath)
+	require.NoError(t, err)
+	assert.Equal(t, &Cursor{Number: 5, Hash: blocks[1].Hash}, cursor)
+
+	// the blocks written while the stream was stopped are published after the restart
+	backend.appendBlock()
+	backend.appendBlock()
+
+	stream, sink = startStream(t, config, backend)
+
+	blocks = waitForBlock(t, sink, 7)
+	require.NoError(t, stream.Close())
+
+	assert.Equal(t, []uint64{6, 7}, blockNumbers(blocks))
+}
+
+func TestStream_Replay(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend    = newMockBackend(t, 4)
+		replayFrom = uint64(2)
+		config     = &Config{
+			CursorPath: filepath.Join(t.TempDir(), CursorFileName),
+			ReplayFrom: &replayFrom,
+		}
+	)
+
+	// the replay ignores the cursor
+	require.NoError(t, writeCursor(config.CursorPath, &Cursor{Number: 4}))
+
+	stream, sink := startStream(t, config, backend)
+
+	blocks := waitForBlock(t, sink, 4)
+	require.NoError(t, stream.Close())
+
+	assert.Equal(t, []uint64{2, 3, 4}, blockNumbers(blocks))
+}
+
+func TestStream_RetryUnavailableSink(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend    = newMockBackend(t, 2)
+		replayFrom = uint64(1)
+		sink       = NewMemorySink()
+		config     = &Config{
+			CursorPath: filepath.Join(t.TempDir(), CursorFileName),
+			ReplayFrom: &replayFrom,
+		}
+	)
+
+	sink.SetError(errors.New("bus unavailable"))
+
+	stream := NewStream(hclog.NewNullLogger(), config, backend, nil, sink)
+	stream.retryInterval = 10 * time.Millisecond
+
+	require.NoError(t, stream.Start())
+
+	// the cursor does not move while the sink is unavailable
+	time.Sleep(50 * time.Millisecond)
+
+	cursor, err := readCursor(config.CursorPath)
+	require.NoError(t, err)
+	require.Nil(t, cursor)
+
+	sink.SetError(nil)
+
+	blocks := waitForBlock(t, sink, 2)
+	require.NoError(t, stream.Close())
+
+	assert.Equal(t, []uint64{1, 2}, blockNumbers(blocks))
+}
+
+func TestStream_Traces(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend    = newMockBackend(t, 1)
+		replayFrom = uint64(0)
+		config     = &Config{
+			CursorPath: filepath.Join(t.TempDir(), CursorFileName),
+			ReplayFrom: &replayFrom,
+			Traces:     true,
+		}
+	)
+
+	stream, sink := startStream(t, config, backend)
+
+	blocks := waitForBlock(t, sink, 1)
+	require.NoError(t, stream.Close())
+
+	require.Len(t, blocks, 2)
+
+	// the genesis block has no transactions to trace
+	assert.Empty(t, blocks[0].Traces)
+	assert.Equal(t, []interface{}{blocks[1].Transactions[0].Hash}, blocks[1].Traces)
+
+	receipt := blocks[1].Transactions[0].Receipt
+	require.NotNil(t, receipt)
+	assert.Equal(t, uint64(types.ReceiptSuccess), receipt.Status)
+	assert.Equal(t, "0x01", receipt.Logs[0].Data)
+}
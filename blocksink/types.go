@@ -0,0 +1,184 @@
+package blocksink
+
+import (
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// SinkType is the type of the sink the blocks are published to
+type SinkType string
+
+const (
+	// None disables the block stream
+	None SinkType = "none"
+
+	// Stdout publishes the blocks as JSON lines to the standard output
+	Stdout SinkType = "stdout"
+
+	// File appends the blocks as JSON lines to the target file
+	File SinkType = "file"
+)
+
+// Sink publishes the blocks to the external consumers, i.e. a message bus.
+// The block is delivered once Publish returns without an error
+type Sink interface {
+	// Publish publishes the block to the sink
+	Publish(block *Block) error
+
+	// Close releases the resources of the sink
+	Close() error
+}
+
+// Factory creates the sink publishing to the given target
+type Factory func(target string) (Sink, error)
+
+// Backend provides the finalized blocks published to the sink
+type Backend interface {
+	// Header returns the current head of the chain
+	Header() *types.Header
+
+	// GetBlockByNumber returns the block of the canonical chain by its number
+	GetBlockByNumber(number uint64, full bool) (*types.Block, bool)
+
+	// GetReceiptsByHash returns the receipts of the block
+	GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
+
+	// SubscribeEvents returns the subscription to the new blocks
+	SubscribeEvents() blockchain.Subscription
+}
+
+// Tracer replays the transactions of the block with the given tracer
+type Tracer interface {
+	// TraceBlock returns the results of the tracer for every transaction of the block
+	TraceBlock(block *types.Block, tracer tracer.Tracer) ([]interface{}, error)
+}
+
+// Block is the message published to the sink for every finalized block
+type Block struct {
+	Number       uint64         `json:"number"`
+	Hash         types.Hash     `json:"hash"`
+	ParentHash   types.Hash     `json:"parentHash"`
+	Timestamp    uint64         `json:"timestamp"`
+	Miner        string         `json:"miner"`
+	StateRoot    types.Hash     `json:"stateRoot"`
+	GasLimit     uint64         `json:"gasLimit"`
+	GasUsed      uint64         `json:"gasUsed"`
+	BaseFee      uint64         `json:"baseFeePerGas"`
+	Transactions []*Transaction `json:"transactions"`
+
+	// Traces are the call traces of the transactions, if enabled
+	Traces []interface{} `json:"traces,omitempty"`
+}
+
+// Transaction is the transaction of the published block, along with its receipt
+type Transaction struct {
+	Hash     types.Hash     `json:"hash"`
+	Index    uint64         `json:"index"`
+	Type     uint64         `json:"type"`
+	From     types.Address  `json:"from"`
+	To       *types.Address `json:"to"`
+	Nonce    uint64         `json:"nonce"`
+	Value    string         `json:"value"`
+	Gas      uint64         `json:"gas"`
+	GasPrice string         `json:"gasPrice,omitempty"`
+	Input    string         `json:"input"`
+	Receipt  *Receipt       `json:"receipt"`
+}
+
+// Receipt is the receipt of the published transaction
+type Receipt struct {
+	Status            uint64         `json:"status"`
+	GasUsed           uint64         `json:"gasUsed"`
+	CumulativeGasUsed uint64         `json:"cumulativeGasUsed"`
+	ContractAddress   *types.Address `json:"contractAddress,omitempty"`
+	Logs              []*Log         `json:"logs"`
+}
+
+// Log is the log emitted by the published transaction
+type Log struct {
+	// Index is the index of the log in the block
+	Index   uint64        `json:"index"`
+	Address types.Address `json:"address"`
+	Topics  []types.Hash  `json:"topics"`
+	Data    string        `json:"data"`
+}
+
+// toBlock converts the block and its receipts to the published message
+func toBlock(block *types.Block, receipts []*types.Receipt) *Block {
+	header := block.Header
+
+	msg := &Block{
+		Number:       header.Number,
+		Hash:         header.Hash,
+		ParentHash:   header.ParentHash,
+		Timestamp:    header.Timestamp,
+		Miner:        hex.EncodeToHex(header.Miner),
+		StateRoot:    header.StateRoot,
+		GasLimit:     header.GasLimit,
+		GasUsed:      header.GasUsed,
+		BaseFee:      header.BaseFee,
+		Transactions: make([]*Transaction, len(block.Transactions)),
+	}
+
+	var logIndex uint64
+
+	for i, tx := range block.Transactions {
+		msgTx := &Transaction{
+			Hash:  tx.Hash,
+			Index: uint64(i),
+			Type:  uint64(tx.Type),
+			From:  tx.From,
+			To:    tx.To,
+			Nonce: tx.Nonce,
+			Value: "0x0",
+			Gas:   tx.Gas,
+			Input: hex.EncodeToHex(tx.Input),
+		}
+
+		if tx.Value != nil {
+			msgTx.Value = hex.EncodeBig(tx.Value)
+		}
+
+		if tx.GasPrice != nil {
+			msgTx.GasPrice = hex.EncodeBig(tx.GasPrice)
+		}
+
+		if i < len(receipts) {
+			msgTx.Receipt = toReceipt(receipts[i], &logIndex)
+		}
+
+		msg.Transactions[i] = msgTx
+	}
+
+	return msg
+}
+
+// toReceipt converts the receipt to the published message,
+// numbering its logs from the given index of the block
+func toReceipt(receipt *types.Receipt, logIndex *uint64) *Receipt {
+	msg := &Receipt{
+		GasUsed:           receipt.GasUsed,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		ContractAddress:   receipt.ContractAddress,
+		Logs:              make([]*Log, len(receipt.Logs)),
+	}
+
+	if receipt.Status != nil {
+		msg.Status = uint64(*receipt.Status)
+	}
+
+	for i, log := range receipt.Logs {
+		msg.Logs[i] = &Log{
+			Index:   *logIndex,
+			Address: log.Address,
+			Topics:  log.Topics,
+			Data:    hex.EncodeToHex(log.Data),
+		}
+
+		*logIndex++
+	}
+
+	return msg
+}
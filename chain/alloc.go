@@ -0,0 +1,370 @@
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+// AllocFormat is the encoding of the genesis alloc file
+type AllocFormat string
+
+const (
+	// AllocFormatJSON is the geth style alloc, the JSON object of the accounts keyed by their addresses
+	AllocFormatJSON AllocFormat = "json"
+
+	// AllocFormatRLP is the stream of the RLP encoded accounts,
+	// every account is encoded as [address, balance, nonce, code, [[key, value], ...]]
+	AllocFormatRLP AllocFormat = "rlp"
+)
+
+// maxAllocRecordSize is the limit of the single RLP encoded account
+const maxAllocRecordSize = 1 << 30
+
+var (
+	errUnknownAllocFormat = errors.New("unknown alloc format")
+	errInvalidAllocRecord = errors.New("invalid alloc record")
+	errDuplicateAlloc     = errors.New("duplicate account in alloc")
+)
+
+// ReadAllocFile reads the genesis accounts from the JSON or RLP encoded alloc file
+func ReadAllocFile(path string) (map[types.Address]*GenesisAccount, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return ReadAlloc(file)
+}
+
+// ReadAlloc reads the genesis accounts, the format is detected from the content.
+// The JSON alloc is either the bare object of the accounts or an object with the "alloc" field, like the genesis file
+func ReadAlloc(in io.Reader) (map[types.Address]*GenesisAccount, error) {
+	reader := bufio.NewReaderSize(in, 1024*1024)
+
+	for {
+		b, err := reader.Peek(1)
+		if errors.Is(err, io.EOF) {
+			return map[types.Address]*GenesisAccount{}, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = reader.ReadByte()
+
+		case '{':
+			return readJSONAlloc(reader)
+
+		default:
+			return readRLPAlloc(reader)
+		}
+	}
+}
+
+func readJSONAlloc(in io.Reader) (map[types.Address]*GenesisAccount, error) {
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(in).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	if genesisAlloc, ok := raw["alloc"]; ok {
+		raw = nil
+
+		if err := json.Unmarshal(genesisAlloc, &raw); err != nil {
+			return nil, err
+		}
+	}
+
+	alloc := make(map[types.Address]*GenesisAccount, len(raw))
+
+	for key, data := range raw {
+		if err := types.IsValidAddress(key); err != nil {
+			return nil, fmt.Errorf("invalid account %s in alloc: %w", key, err)
+		}
+
+		addr := types.StringToAddress(key)
+		if _, ok := alloc[addr]; ok {
+			return nil, fmt.Errorf("%w: %s", errDuplicateAlloc, addr)
+		}
+
+		account := &GenesisAccount{}
+		if err := json.Unmarshal(data, account); err != nil {
+			return nil, fmt.Errorf("invalid account %s in alloc: %w", addr, err)
+		}
+
+		alloc[addr] = account
+	}
+
+	return alloc, nil
+}
+
+func readRLPAlloc(in *bufio.Reader) (map[types.Address]*GenesisAccount, error) {
+	var (
+		alloc  = map[types.Address]*GenesisAccount{}
+		parser fastrlp.Parser
+		buf    []byte
+	)
+
+	for {
+		prefix, err := in.ReadByte()
+		if errors.Is(err, io.EOF) {
+			return alloc, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if buf, err = readRLPList(in, prefix, buf[:0]); err != nil {
+			return nil, err
+		}
+
+		vv, err := parser.Parse(buf)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidAllocRecord, err)
+		}
+
+		addr, account, err := unmarshalAllocRecord(vv)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := alloc[addr]; ok {
+			return nil, fmt.Errorf("%w: %s", errDuplicateAlloc, addr)
+		}
+
+		alloc[addr] = account
+	}
+}
+
+// readRLPList reads the RLP list starting with the given prefix into the buffer
+func readRLPList(in io.Reader, prefix byte, buf []byte) ([]byte, error) {
+	var size uint64
+
+	buf = append(buf, prefix)
+
+	switch {
+	case prefix >= 0xc0 && prefix <= 0xf7:
+		size = uint64(prefix - 0xc0)
+
+	case prefix >= 0xf8:
+		sizeLen := int(prefix - 0xf7)
+		buf = append(buf, make([]byte, sizeLen)...)
+
+		if _, err := io.ReadFull(in, buf[1:]); err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidAllocRecord, err)
+		}
+
+		for _, b := range buf[1:] {
+			size = size<<8 | uint64(b)
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: not an RLP list", errInvalidAllocRecord)
+	}
+
+	if size > maxAllocRecordSize {
+		return nil, fmt.Errorf("%w: record of %d bytes", errInvalidAllocRecord, size)
+	}
+
+	offset := len(buf)
+	buf = append(buf, make([]byte, size)...)
+
+	if _, err := io.ReadFull(in, buf[offset:]); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidAllocRecord, err)
+	}
+
+	return buf, nil
+}
+
+func unmarshalAllocRecord(vv *fastrlp.Value) (types.Address, *GenesisAccount, error) {
+	var addr types.Address
+
+	elems, err := vv.GetElems()
+	if err != nil || len(elems) != 5 {
+		return addr, nil, fmt.Errorf("%w: expected 5 fields", errInvalidAllocRecord)
+	}
+
+	if err := elems[0].GetAddr(addr[:]); err != nil {
+		return addr, nil, fmt.Errorf("%w: address: %w", errInvalidAllocRecord, err)
+	}
+
+	account := &GenesisAccount{
+		Balance: new(big.Int),
+	}
+
+	if err := elems[1].GetBigInt(account.Balance); err != nil {
+		return addr, nil, fmt.Errorf("%w: balance of %s: %w", errInvalidAllocRecord, addr, err)
+	}
+
+	if account.Nonce, err = elems[2].GetUint64(); err != nil {
+		return addr, nil, fmt.Errorf("%w: nonce of %s: %w", errInvalidAllocRecord, addr, err)
+	}
+
+	if account.Code, err = elems[3].GetBytes(nil); err != nil {
+		return addr, nil, fmt.Errorf("%w: code of %s: %w", errInvalidAllocRecord, addr, err)
+	}
+
+	if len(account.Code) == 0 {
+		account.Code = nil
+	}
+
+	slots, err := elems[4].GetElems()
+	if err != nil {
+		return addr, nil, fmt.Errorf("%w: storage of %s: %w", errInvalidAllocRecord, addr, err)
+	}
+
+	if len(slots) != 0 {
+		account.Storage = make(map[types.Hash]types.Hash, len(slots))
+	}
+
+	for _, slot := range slots {
+		kv, err := slot.GetElems()
+		if err != nil || len(kv) != 2 {
+			return addr, nil, fmt.Errorf("%w: storage of %s", errInvalidAllocRecord, addr)
+		}
+
+		var key, value types.Hash
+
+		if err := kv[0].GetHash(key[:]); err != nil {
+			return addr, nil, fmt.Errorf("%w: storage key of %s: %w", errInvalidAllocRecord, addr, err)
+		}
+
+		if err := kv[1].GetHash(value[:]); err != nil {
+			return addr, nil, fmt.Errorf("%w: storage value of %s: %w", errInvalidAllocRecord, addr, err)
+		}
+
+		account.Storage[key] = value
+	}
+
+	return addr, account, nil
+}
+
+// AllocWriter writes the genesis accounts one by one in the given format
+type AllocWriter struct {
+	out    io.Writer
+	format AllocFormat
+	arena  fastrlp.Arena
+	buf    []byte
+	count  int
+}
+
+// NewAllocWriter creates the writer of the alloc in the given format
+func NewAllocWriter(out io.Writer, format AllocFormat) (*AllocWriter, error) {
+	if format != AllocFormatJSON && format != AllocFormatRLP {
+		return nil, fmt.Errorf("%w: %s", errUnknownAllocFormat, format)
+	}
+
+	return &AllocWriter{
+		out:    out,
+		format: format,
+	}, nil
+}
+
+// Write writes the account
+func (w *AllocWriter) Write(addr types.Address, account *GenesisAccount) error {
+	var err error
+
+	if w.format == AllocFormatJSON {
+		err = w.writeJSON(addr, account)
+	} else {
+		err = w.writeRLP(addr, account)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	w.count++
+
+	return nil
+}
+
+// Close finishes the alloc, it does not close the underlying writer
+func (w *AllocWriter) Close() error {
+	if w.format != AllocFormatJSON {
+		return nil
+	}
+
+	end := "\n}\n"
+	if w.count == 0 {
+		end = "{}\n"
+	}
+
+	_, err := io.WriteString(w.out, end)
+
+	return err
+}
+
+func (w *AllocWriter) writeJSON(addr types.Address, account *GenesisAccount) error {
+	data, err := json.Marshal(account)
+	if err != nil {
+		return err
+	}
+
+	separator := ",\n"
+	if w.count == 0 {
+		separator = "{\n"
+	}
+
+	w.buf = append(append(w.buf[:0], separator...), fmt.Sprintf("  %q: ", addr.String())...)
+	w.buf = append(w.buf, data...)
+
+	_, err = w.out.Write(w.buf)
+
+	return err
+}
+
+func (w *AllocWriter) writeRLP(addr types.Address, account *GenesisAccount) error {
+	w.arena.Reset()
+
+	balance := account.Balance
+	if balance == nil {
+		balance = new(big.Int)
+	}
+
+	vv := w.arena.NewArray()
+	vv.Set(w.arena.NewCopyBytes(addr.Bytes()))
+	vv.Set(w.arena.NewBigInt(balance))
+	vv.Set(w.arena.NewUint(account.Nonce))
+	vv.Set(w.arena.NewCopyBytes(account.Code))
+
+	keys := make([]types.Hash, 0, len(account.Storage))
+	for key := range account.Storage {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+
+	storage := w.arena.NewArray()
+
+	for _, key := range keys {
+		value := account.Storage[key]
+
+		slot := w.arena.NewArray()
+		slot.Set(w.arena.NewCopyBytes(key.Bytes()))
+		slot.Set(w.arena.NewCopyBytes(value.Bytes()))
+		storage.Set(slot)
+	}
+
+	vv.Set(storage)
+
+	w.buf = vv.MarshalTo(w.buf[:0])
+
+	_, err := w.out.Write(w.buf)
+
+	return err
+}
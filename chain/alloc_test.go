@@ -0,0 +1,136 @@
+package chain
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocWriter_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	alloc := map[types.Address]*GenesisAccount{
+		addr("0x1"): {
+			Balance: big.NewInt(100),
+		},
+		addr("0x2"): {
+			Balance: big.NewInt(0),
+			Nonce:   3,
+			Code:    []byte{0x60, 0x00},
+			Storage: map[types.Hash]types.Hash{
+				hash("0x1"): hash("0x5"),
+				hash("0x2"): hash("0x6"),
+			},
+		},
+	}
+
+	for _, format := range []AllocFormat{AllocFormatJSON, AllocFormatRLP} {
+		format := format
+
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			w, err := NewAllocWriter(&buf, format)
+			require.NoError(t, err)
+
+			for a, account := range alloc {
+				require.NoError(t, w.Write(a, account))
+			}
+
+			require.NoError(t, w.Close())
+
+			read, err := ReadAlloc(&buf)
+			require.NoError(t, err)
+			assert.Equal(t, alloc, read)
+		})
+	}
+
+	_, err := NewAllocWriter(&bytes.Buffer{}, "yaml")
+	assert.ErrorIs(t, err, errUnknownAllocFormat)
+}
+
+func TestReadAlloc_JSON(t *testing.T) {
+	t.Parallel()
+
+	// the alloc of the geth genesis file
+	genesis := `
+	{
+		"config": {"chainId": 1},
+		"alloc": {
+			"0000000000000000000000000000000000000001": {"balance": "1000"},
+			"0x0000000000000000000000000000000000000002": {
+				"balance": "0x10",
+				"nonce": "0x2",
+				"code": "0x6000",
+				"storage": {
+					"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000005"
+				}
+			}
+		}
+	}`
+
+	alloc, err := ReadAlloc(strings.NewReader(genesis))
+	require.NoError(t, err)
+	require.Len(t, alloc, 2)
+
+	assert.Equal(t, big.NewInt(1000), alloc[addr("0x1")].Balance)
+	assert.Equal(t, big.NewInt(16), alloc[addr("0x2")].Balance)
+	assert.Equal(t, uint64(2), alloc[addr("0x2")].Nonce)
+	assert.Equal(t, []byte{0x60, 0x00}, alloc[addr("0x2")].Code)
+	assert.Equal(t, hash("0x5"), alloc[addr("0x2")].Storage[hash("0x1")])
+
+	empty, err := ReadAlloc(strings.NewReader("  \n"))
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestReadAlloc_Invalid(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	w, err := NewAllocWriter(&buf, AllocFormatRLP)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(addr("0x1"), &GenesisAccount{Balance: big.NewInt(1)}))
+
+	record := append([]byte{}, buf.Bytes()...)
+
+	cases := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"invalid address", []byte(`{"0x1234": {"balance": "1"}}`), nil},
+		{
+			"duplicate address",
+			[]byte(`{"0x00000000000000000000000000000000000000ab": {}, "0x00000000000000000000000000000000000000AB": {}}`),
+			errDuplicateAlloc,
+		},
+		{"duplicate account", append(append([]byte{}, record...), record...), errDuplicateAlloc},
+		{"truncated", record[:len(record)-1], errInvalidAllocRecord},
+		{"not a list", []byte{0x80}, errInvalidAllocRecord},
+		{"missing fields", []byte{0xc1, 0x80}, errInvalidAllocRecord},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ReadAlloc(bytes.NewReader(c.data))
+			require.Error(t, err)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/admin/blockproduction"
+	"github.com/0xPolygon/polygon-edge/command/admin/compact"
+	"github.com/0xPolygon/polygon-edge/command/admin/droppeer"
+	"github.com/0xPolygon/polygon-edge/command/admin/flushaccount"
+	"github.com/0xPolygon/polygon-edge/command/admin/loglevel"
+	"github.com/0xPolygon/polygon-edge/command/admin/rebroadcasttx"
+	"github.com/0xPolygon/polygon-edge/command/admin/removetx"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	adminCmd := &cobra.Command{
+		Use: "admin",
+		Short: "Top level command for controlling the running node. Only accepts subcommands. " +
+			"The peers are banned with the peers ban command",
+	}
+
+	helper.RegisterGRPCAddressFlag(adminCmd)
+
+	registerSubcommands(adminCmd)
+
+	return adminCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// admin log-level
+		loglevel.GetCommand(),
+		// admin drop-peer
+		droppeer.GetCommand(),
+		// admin remove-tx
+		removetx.GetCommand(),
+		// admin rebroadcast-tx
+		rebroadcasttx.GetCommand(),
+		// admin flush-account
+		flushaccount.GetCommand(),
+		// admin block-production
+		blockproduction.GetCommand(),
+		// admin compact
+		compact.GetCommand(),
+	)
+}
@@ -0,0 +1,56 @@
+package blockproduction
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	blockProductionCmd := &cobra.Command{
+		Use: "block-production",
+		Short: "Pauses or resumes the block production of the validator. " +
+			"The paused node keeps syncing the blocks produced by the other validators",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(blockProductionCmd)
+
+	return blockProductionCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(
+		&params.pause,
+		pauseFlag,
+		false,
+		"pauses the block production",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.resume,
+		resumeFlag,
+		false,
+		"resumes the block production",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(pauseFlag, resumeFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.setBlockProduction(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
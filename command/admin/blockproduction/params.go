@@ -0,0 +1,60 @@
+package blockproduction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+var (
+	params = &blockProductionParams{}
+)
+
+var (
+	errInvalidAction = errors.New("exactly one of the pause and resume flags has to be set")
+)
+
+const (
+	pauseFlag  = "pause"
+	resumeFlag = "resume"
+)
+
+type blockProductionParams struct {
+	pause  bool
+	resume bool
+}
+
+func (p *blockProductionParams) validateFlags() error {
+	if p.pause == p.resume {
+		return errInvalidAction
+	}
+
+	return nil
+}
+
+func (p *blockProductionParams) setBlockProduction(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, err := systemClient.AdminSetBlockProduction(
+		context.Background(),
+		&proto.AdminSetBlockProductionRequest{
+			Paused: p.pause,
+		},
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *blockProductionParams) getResult() command.CommandResult {
+	return &BlockProductionResult{
+		Paused: p.pause,
+	}
+}
@@ -0,0 +1,29 @@
+package blockproduction
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type BlockProductionResult struct {
+	Paused bool `json:"paused"`
+}
+
+func (r *BlockProductionResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	state := "resumed"
+	if r.Paused {
+		state = "paused"
+	}
+
+	buffer.WriteString("\n[BLOCK PRODUCTION]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Block production|%s", state),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,50 @@
+package compact
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func GetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use: "compact",
+		Short: "Compacts the blockchain and the state databases of the node, " +
+			"which can take a while on the large databases",
+		Run: runCommand,
+	}
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	compacted, err := compact(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(&CompactResult{
+		Databases: compacted,
+	})
+}
+
+func compact(grpcAddress string) ([]string, error) {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := systemClient.AdminCompact(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Databases, nil
+}
@@ -0,0 +1,25 @@
+package compact
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type CompactResult struct {
+	Databases []string `json:"databases"`
+}
+
+func (r *CompactResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DATABASES COMPACTED]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Databases|%s", strings.Join(r.Databases, ", ")),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,43 @@
+package droppeer
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	dropPeerCmd := &cobra.Command{
+		Use: "drop-peer",
+		Short: "Disconnects the peer. The peer is free to connect again, " +
+			"use the peers ban command to keep it away",
+		Run: runCommand,
+	}
+
+	setFlags(dropPeerCmd)
+	helper.SetRequiredFlags(dropPeerCmd, params.getRequiredFlags())
+
+	return dropPeerCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.peerID,
+		peerIDFlag,
+		"",
+		"libp2p node ID of the peer to disconnect",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.dropPeer(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
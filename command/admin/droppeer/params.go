@@ -0,0 +1,51 @@
+package droppeer
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+var (
+	params = &dropPeerParams{}
+)
+
+const (
+	peerIDFlag = "peer-id"
+)
+
+type dropPeerParams struct {
+	peerID string
+}
+
+func (p *dropPeerParams) getRequiredFlags() []string {
+	return []string{
+		peerIDFlag,
+	}
+}
+
+func (p *dropPeerParams) dropPeer(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, err := systemClient.AdminDropPeer(
+		context.Background(),
+		&proto.AdminDropPeerRequest{
+			Id: p.peerID,
+		},
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *dropPeerParams) getResult() command.CommandResult {
+	return &DropPeerResult{
+		ID: p.peerID,
+	}
+}
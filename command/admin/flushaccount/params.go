@@ -0,0 +1,65 @@
+package flushaccount
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	params = &flushAccountParams{}
+)
+
+const (
+	addressFlag = "address"
+)
+
+type flushAccountParams struct {
+	address string
+
+	dropped []string
+}
+
+func (p *flushAccountParams) getRequiredFlags() []string {
+	return []string{
+		addressFlag,
+	}
+}
+
+func (p *flushAccountParams) validateFlags() error {
+	return types.IsValidAddress(p.address)
+}
+
+func (p *flushAccountParams) flushAccount(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	resp, err := systemClient.AdminFlushAccount(
+		context.Background(),
+		&proto.AdminFlushAccountRequest{
+			Address: p.address,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.dropped = resp.Hashes
+	if p.dropped == nil {
+		p.dropped = []string{}
+	}
+
+	return nil
+}
+
+func (p *flushAccountParams) getResult() command.CommandResult {
+	return &FlushAccountResult{
+		Address: p.address,
+		Dropped: p.dropped,
+	}
+}
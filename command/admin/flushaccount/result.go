@@ -0,0 +1,33 @@
+package flushaccount
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type FlushAccountResult struct {
+	Address string   `json:"address"`
+	Dropped []string `json:"dropped"`
+}
+
+func (r *FlushAccountResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[ACCOUNT FLUSHED]\n")
+
+	rows := []string{
+		fmt.Sprintf("Address|%s", r.Address),
+		fmt.Sprintf("Dropped transactions|%d", len(r.Dropped)),
+	}
+
+	for i, hash := range r.Dropped {
+		rows = append(rows, fmt.Sprintf("[%d]|%s", i, hash))
+	}
+
+	buffer.WriteString(helper.FormatKV(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
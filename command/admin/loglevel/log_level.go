@@ -0,0 +1,62 @@
+package loglevel
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	logLevelCmd := &cobra.Command{
+		Use: "log-level",
+		Short: "Changes the log level of the module, or the default log level if the module is not set. " +
+			"Lists the current log levels if the level is not set",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(logLevelCmd)
+
+	return logLevelCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.module,
+		moduleFlag,
+		"",
+		"name of the logger module, e.g. txpool or consensus.polybft. "+
+			"The level of the module applies to its sub-modules as well",
+	)
+
+	cmd.Flags().StringVar(
+		&params.level,
+		levelFlag,
+		"",
+		"the log level (trace, debug, info, warn or error)",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.reset,
+		resetFlag,
+		false,
+		"resets the module to the default log level",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.updateLogLevel(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,88 @@
+package loglevel
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	params = &logLevelParams{}
+)
+
+var (
+	errResetWithoutModule = errors.New("the module has to be set to reset its log level")
+	errResetWithLevel     = errors.New("the level can not be set along with the reset flag")
+)
+
+const (
+	moduleFlag = "module"
+	levelFlag  = "level"
+	resetFlag  = "reset"
+)
+
+type logLevelParams struct {
+	module string
+	level  string
+	reset  bool
+
+	levels *proto.AdminLogLevels
+}
+
+func (p *logLevelParams) validateFlags() error {
+	if !p.reset {
+		return nil
+	}
+
+	if p.module == "" {
+		return errResetWithoutModule
+	}
+
+	if p.level != "" {
+		return errResetWithLevel
+	}
+
+	return nil
+}
+
+func (p *logLevelParams) updateLogLevel(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	// without a level or a reset the current levels are only listed
+	if p.level == "" && !p.reset {
+		p.levels, err = systemClient.AdminLogLevels(context.Background(), &empty.Empty{})
+
+		return err
+	}
+
+	p.levels, err = systemClient.AdminSetLogLevel(
+		context.Background(),
+		&proto.AdminSetLogLevelRequest{
+			Module: p.module,
+			Level:  p.level,
+		},
+	)
+
+	return err
+}
+
+func (p *logLevelParams) getResult() command.CommandResult {
+	result := &LogLevelResult{
+		Default: p.levels.Default,
+		Modules: make(map[string]string, len(p.levels.Modules)),
+	}
+
+	for _, module := range p.levels.Modules {
+		result.Modules[module.Module] = module.Level
+	}
+
+	return result
+}
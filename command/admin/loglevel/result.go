@@ -0,0 +1,39 @@
+package loglevel
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type LogLevelResult struct {
+	Default string            `json:"default"`
+	Modules map[string]string `json:"modules"`
+}
+
+func (r *LogLevelResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	modules := make([]string, 0, len(r.Modules))
+	for module := range r.Modules {
+		modules = append(modules, module)
+	}
+
+	sort.Strings(modules)
+
+	rows := []string{
+		fmt.Sprintf("Default|%s", r.Default),
+	}
+
+	for _, module := range modules {
+		rows = append(rows, fmt.Sprintf("%s|%s", module, r.Modules[module]))
+	}
+
+	buffer.WriteString("\n[LOG LEVELS]\n")
+	buffer.WriteString(helper.FormatKV(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
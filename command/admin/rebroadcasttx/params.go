@@ -0,0 +1,51 @@
+package rebroadcasttx
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+var (
+	params = &rebroadcastTxParams{}
+)
+
+const (
+	hashFlag = "hash"
+)
+
+type rebroadcastTxParams struct {
+	hash string
+}
+
+func (p *rebroadcastTxParams) getRequiredFlags() []string {
+	return []string{
+		hashFlag,
+	}
+}
+
+func (p *rebroadcastTxParams) rebroadcastTx(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, err := systemClient.AdminRebroadcastTx(
+		context.Background(),
+		&proto.AdminTxRequest{
+			Hash: p.hash,
+		},
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *rebroadcastTxParams) getResult() command.CommandResult {
+	return &RebroadcastTxResult{
+		Hash: p.hash,
+	}
+}
@@ -0,0 +1,42 @@
+package rebroadcasttx
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	rebroadcastTxCmd := &cobra.Command{
+		Use:   "rebroadcast-tx",
+		Short: "Gossips the transaction from the transaction pool to the peers again",
+		Run:   runCommand,
+	}
+
+	setFlags(rebroadcastTxCmd)
+	helper.SetRequiredFlags(rebroadcastTxCmd, params.getRequiredFlags())
+
+	return rebroadcastTxCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.hash,
+		hashFlag,
+		"",
+		"hash of the transaction to rebroadcast",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.rebroadcastTx(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,24 @@
+package rebroadcasttx
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type RebroadcastTxResult struct {
+	Hash string `json:"hash"`
+}
+
+func (r *RebroadcastTxResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TRANSACTION REBROADCAST]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Hash|%s", r.Hash),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
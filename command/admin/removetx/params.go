@@ -0,0 +1,59 @@
+package removetx
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+var (
+	params = &removeTxParams{}
+)
+
+const (
+	hashFlag = "hash"
+)
+
+type removeTxParams struct {
+	hash string
+
+	removed []string
+}
+
+func (p *removeTxParams) getRequiredFlags() []string {
+	return []string{
+		hashFlag,
+	}
+}
+
+func (p *removeTxParams) removeTx(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	resp, err := systemClient.AdminRemoveTx(
+		context.Background(),
+		&proto.AdminTxRequest{
+			Hash: p.hash,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.removed = resp.Hashes
+	if p.removed == nil {
+		p.removed = []string{}
+	}
+
+	return nil
+}
+
+func (p *removeTxParams) getResult() command.CommandResult {
+	return &RemoveTxResult{
+		Removed: p.removed,
+	}
+}
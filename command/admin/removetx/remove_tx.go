@@ -0,0 +1,43 @@
+package removetx
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	removeTxCmd := &cobra.Command{
+		Use: "remove-tx",
+		Short: "Removes the transaction from the transaction pool. The following transactions " +
+			"of the sender are removed as well, as they can not be executed without it",
+		Run: runCommand,
+	}
+
+	setFlags(removeTxCmd)
+	helper.SetRequiredFlags(removeTxCmd, params.getRequiredFlags())
+
+	return removeTxCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.hash,
+		hashFlag,
+		"",
+		"hash of the transaction to remove",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.removeTx(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,28 @@
+package removetx
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type RemoveTxResult struct {
+	Removed []string `json:"removed"`
+}
+
+func (r *RemoveTxResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TRANSACTIONS REMOVED]\n")
+
+	rows := make([]string, len(r.Removed))
+	for i, hash := range r.Removed {
+		rows[i] = fmt.Sprintf("[%d]|%s", i, hash)
+	}
+
+	buffer.WriteString(helper.FormatKV(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -2,6 +2,7 @@ package backup
 
 import (
 	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/backup/verify"
 	"github.com/spf13/cobra"
 
 	"github.com/0xPolygon/polygon-edge/command/helper"
@@ -9,8 +10,9 @@ import (
 
 func GetCommand() *cobra.Command {
 	backupCmd := &cobra.Command{
-		Use:     "backup",
-		Short:   "Create blockchain backup file by fetching blockchain data from the running node",
+		Use: "backup",
+		Short: "Create blockchain backup file by fetching blockchain data from the running node, " +
+			"or by reading it from the data directory of a stopped node",
 		PreRunE: runPreRun,
 		Run:     runCommand,
 	}
@@ -20,6 +22,11 @@ func GetCommand() *cobra.Command {
 	setFlags(backupCmd)
 	helper.SetRequiredFlags(backupCmd, params.getRequiredFlags())
 
+	backupCmd.AddCommand(
+		// backup verify
+		verify.GetCommand(),
+	)
+
 	return backupCmd
 }
 
@@ -44,6 +51,30 @@ func setFlags(cmd *cobra.Command) {
 		"",
 		"the end height of the chain in backup",
 	)
+
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the stopped node to read the blockchain data from, "+
+			"instead of fetching it from the running node",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.compress,
+		compressFlag,
+		false,
+		"compress the backup with zstd",
+	)
+
+	cmd.Flags().StringVar(
+		&params.incremental,
+		incrementalFlag,
+		"",
+		"the path of the previous backup, the new backup contains only the blocks after it",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(incrementalFlag, fromFlag)
 }
 
 func runPreRun(_ *cobra.Command, _ []string) error {
@@ -2,18 +2,26 @@ package backup
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/0xPolygon/polygon-edge/archive"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/hashicorp/go-hclog"
+	"google.golang.org/grpc"
 )
 
 const (
-	outFlag  = "out"
-	fromFlag = "from"
-	toFlag   = "to"
+	outFlag         = "out"
+	fromFlag        = "from"
+	toFlag          = "to"
+	dataDirFlag     = "data-dir"
+	compressFlag    = "compress"
+	incrementalFlag = "incremental"
 )
 
 var (
@@ -34,6 +42,10 @@ type backupParams struct {
 	from uint64
 	to   *uint64
 
+	dataDir     string
+	compress    bool
+	incremental string
+
 	resFrom uint64
 	resTo   uint64
 }
@@ -69,24 +81,42 @@ func (p *backupParams) getRequiredFlags() []string {
 }
 
 func (p *backupParams) createBackup(grpcAddress string) error {
-	connection, err := helper.GetGRPCConnection(
-		grpcAddress,
-	)
-	if err != nil {
-		return err
+	opts := archive.BackupOptions{
+		Compress: p.compress,
 	}
 
-	// resFrom and resTo represents the range of blocks that can be included in the file
-	resFrom, resTo, err := archive.CreateBackup(
-		connection,
-		hclog.New(&hclog.LoggerOptions{
-			Name:  "backup",
-			Level: hclog.LevelFromString("INFO"),
-		}),
-		p.from,
-		p.to,
-		p.out,
+	if p.incremental != "" {
+		previous, err := archive.ReadBackupMetadata(p.incremental)
+		if err != nil {
+			return fmt.Errorf("failed to read the previous backup: %w", err)
+		}
+
+		opts.Previous = previous
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "backup",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	var (
+		resFrom, resTo uint64
+		err            error
 	)
+
+	// resFrom and resTo represents the range of blocks that can be included in the file
+	if p.dataDir != "" {
+		resFrom, resTo, err = p.createOfflineBackup(logger, opts)
+	} else {
+		var connection *grpc.ClientConn
+
+		if connection, err = helper.GetGRPCConnection(grpcAddress); err != nil {
+			return err
+		}
+
+		resFrom, resTo, err = archive.CreateBackup(connection, logger, p.from, p.to, p.out, opts)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -97,10 +127,28 @@ func (p *backupParams) createBackup(grpcAddress string) error {
 	return nil
 }
 
+// createOfflineBackup reads the blockchain data from the data directory of the stopped node
+func (p *backupParams) createOfflineBackup(logger hclog.Logger, opts archive.BackupOptions) (uint64, uint64, error) {
+	path := filepath.Join(p.dataDir, "blockchain")
+	if _, err := os.Stat(path); err != nil {
+		return 0, 0, fmt.Errorf("invalid data directory: %w", err)
+	}
+
+	db, err := leveldb.NewLevelDBStorage(path, logger)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to open the blockchain storage, is the node stopped? %w", err)
+	}
+
+	defer db.Close()
+
+	return archive.CreateOfflineBackup(db, logger, p.from, p.to, p.out, opts)
+}
+
 func (p *backupParams) getResult() command.CommandResult {
 	return &BackupResult{
-		From: p.resFrom,
-		To:   p.resTo,
-		Out:  p.out,
+		From:       p.resFrom,
+		To:         p.resTo,
+		Out:        p.out,
+		Compressed: p.compress,
 	}
 }
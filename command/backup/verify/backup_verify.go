@@ -0,0 +1,43 @@
+package verify
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	backupVerifyCmd := &cobra.Command{
+		Use: "verify",
+		Short: "Checks the integrity of the backup files without importing them. " +
+			"The incremental backups are given after the backups they continue",
+		Run: runCommand,
+	}
+
+	setFlags(backupVerifyCmd)
+	helper.SetRequiredFlags(backupVerifyCmd, params.getRequiredFlags())
+
+	return backupVerifyCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(
+		&params.files,
+		fileFlag,
+		[]string{},
+		"the path of the backup file, multiple files are verified as the chain of backups",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.verifyBackups(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
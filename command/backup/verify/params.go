@@ -0,0 +1,55 @@
+package verify
+
+import (
+	"github.com/0xPolygon/polygon-edge/archive"
+	"github.com/0xPolygon/polygon-edge/command"
+)
+
+const (
+	fileFlag = "file"
+)
+
+var (
+	params = &verifyParams{}
+)
+
+type verifyParams struct {
+	files []string
+
+	summaries []*archive.BackupSummary
+}
+
+func (p *verifyParams) getRequiredFlags() []string {
+	return []string{
+		fileFlag,
+	}
+}
+
+func (p *verifyParams) verifyBackups() error {
+	summaries, err := archive.VerifyBackups(p.files)
+	if err != nil {
+		return err
+	}
+
+	p.summaries = summaries
+
+	return nil
+}
+
+func (p *verifyParams) getResult() command.CommandResult {
+	result := &BackupVerifyResult{
+		Backups: make([]*BackupResult, 0, len(p.summaries)),
+	}
+
+	for _, summary := range p.summaries {
+		result.Backups = append(result.Backups, &BackupResult{
+			File:       summary.Path,
+			From:       summary.From,
+			To:         summary.To,
+			LatestHash: summary.LatestHash.String(),
+			Compressed: summary.Compressed,
+		})
+	}
+
+	return result
+}
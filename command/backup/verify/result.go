@@ -0,0 +1,44 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type BackupResult struct {
+	File       string `json:"file"`
+	From       uint64 `json:"from"`
+	To         uint64 `json:"to"`
+	LatestHash string `json:"latest_hash"`
+	Compressed bool   `json:"compressed"`
+}
+
+type BackupVerifyResult struct {
+	Backups []*BackupResult `json:"backups"`
+}
+
+func (r *BackupVerifyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[BACKUP VERIFY]\n")
+	buffer.WriteString("Verified backup files successfully:\n")
+
+	for i, backup := range r.Backups {
+		if i > 0 {
+			buffer.WriteString("\n")
+		}
+
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("File|%s", backup.File),
+			fmt.Sprintf("From|%d", backup.From),
+			fmt.Sprintf("To|%d", backup.To),
+			fmt.Sprintf("Latest hash|%s", backup.LatestHash),
+			fmt.Sprintf("Compressed|%t", backup.Compressed),
+		}))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
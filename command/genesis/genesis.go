@@ -63,6 +63,14 @@ func setFlags(cmd *cobra.Command) {
 		),
 	)
 
+	cmd.Flags().StringVar(
+		&params.allocFile,
+		allocFileFlag,
+		"",
+		"the JSON (geth style alloc) or RLP file of the genesis accounts with their balances, nonces, code and storage, "+
+			"as produced by the state dump command",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.blockGasLimit,
 		blockGasLimitFlag,
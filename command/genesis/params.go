@@ -29,6 +29,7 @@ const (
 	dirFlag                      = "dir"
 	nameFlag                     = "name"
 	premineFlag                  = "premine"
+	allocFileFlag                = "alloc-file"
 	chainIDFlag                  = "chain-id"
 	epochSizeFlag                = "epoch-size"
 	epochRewardFlag              = "epoch-reward"
@@ -78,6 +79,7 @@ var (
 	errInvalidGovernorAdmin     = errors.New("governor admin address must be defined")
 	errBaseFeeChangeDenomZero   = errors.New("base fee change denominator must be greater than 0")
 	errBlockTrackerPollInterval = errors.New("block tracker poll interval must be greater than 0")
+	errAllocFileCollision       = errors.New("account of the alloc file is already created by the genesis")
 )
 
 type genesisParams struct {
@@ -86,6 +88,7 @@ type genesisParams struct {
 	consensusRaw        string
 	validatorPrefixPath string
 	premine             []string
+	allocFile           string
 	bootnodes           []string
 	ibftValidators      validators.Validators
 
@@ -143,6 +146,9 @@ type genesisParams struct {
 
 	premineInfos []*premineInfo
 
+	// allocFileAccounts are the accounts read from the alloc file
+	allocFileAccounts map[types.Address]*chain.GenesisAccount
+
 	// rewards
 	rewardTokenCode string
 	rewardWallet    string
@@ -179,6 +185,10 @@ func (p *genesisParams) validateFlags() error {
 		return err
 	}
 
+	if err := p.readAllocFile(); err != nil {
+		return err
+	}
+
 	if p.baseFeeChangeDenom == 0 {
 		return errBaseFeeChangeDenomZero
 	}
@@ -476,7 +486,18 @@ func (p *genesisParams) initGenesisConfig() error {
 		chainConfig.Genesis.Alloc[staking.AddrStakingContract] = stakingAccount
 	}
 
+	if err := p.addAllocFileAccounts(chainConfig.Genesis.Alloc); err != nil {
+		return err
+	}
+
 	for _, premineInfo := range p.premineInfos {
+		// the premine sets only the balance of the account from the alloc file
+		if account, ok := p.allocFileAccounts[premineInfo.address]; ok {
+			account.Balance = premineInfo.amount
+
+			continue
+		}
+
 		chainConfig.Genesis.Alloc[premineInfo.address] = &chain.GenesisAccount{
 			Balance: premineInfo.amount,
 		}
@@ -546,6 +567,36 @@ func (p *genesisParams) parsePremineInfo() error {
 	return nil
 }
 
+// readAllocFile reads the accounts of the alloc file, if it is provided
+func (p *genesisParams) readAllocFile() error {
+	if p.allocFile == "" {
+		return nil
+	}
+
+	alloc, err := chain.ReadAllocFile(p.allocFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the alloc file %s: %w", p.allocFile, err)
+	}
+
+	p.allocFileAccounts = alloc
+
+	return nil
+}
+
+// addAllocFileAccounts adds the accounts of the alloc file to the genesis accounts,
+// the accounts created by the genesis itself can't be overridden by the alloc file
+func (p *genesisParams) addAllocFileAccounts(alloc map[types.Address]*chain.GenesisAccount) error {
+	for addr, account := range p.allocFileAccounts {
+		if _, ok := alloc[addr]; ok {
+			return fmt.Errorf("%w: %s", errAllocFileCollision, addr)
+		}
+
+		alloc[addr] = account
+	}
+
+	return nil
+}
+
 // validatePremineInfo validates whether reserve account (0x0 address) is premined
 func (p *genesisParams) validatePremineInfo() error {
 	for _, premineInfo := range p.premineInfos {
@@ -2,13 +2,18 @@ package genesis
 
 import (
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/common"
+	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -222,3 +227,55 @@ func Test_validateRewardWallet(t *testing.T) {
 		})
 	}
 }
+
+func Test_initGenesisConfig_AllocFile(t *testing.T) {
+	t.Parallel()
+
+	var (
+		contract = types.StringToAddress("0x1001")
+		account  = types.StringToAddress("0x1002")
+		premined = types.StringToAddress("0x1003")
+		path     = filepath.Join(t.TempDir(), "alloc.json")
+	)
+
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf(`{
+		"%s": {
+			"balance": "0x1",
+			"nonce": "0x2",
+			"code": "0x6000",
+			"storage": {"%s": "%s"}
+		},
+		"%s": {"balance": "100"}
+	}`, contract, types.StringToHash("0x1"), types.StringToHash("0x5"), account)), 0600))
+
+	p := &genesisParams{
+		consensus: server.DevConsensus,
+		allocFile: path,
+		premine:   []string{fmt.Sprintf("%s:%d", contract, 10), premined.String()},
+	}
+
+	require.NoError(t, p.parsePremineInfo())
+	require.NoError(t, p.readAllocFile())
+	require.NoError(t, p.initGenesisConfig())
+
+	alloc := p.genesisConfig.Genesis.Alloc
+	require.Len(t, alloc, 3)
+
+	// the premine overrides only the balance of the account from the alloc file
+	require.Equal(t, big.NewInt(10), alloc[contract].Balance)
+	require.Equal(t, uint64(2), alloc[contract].Nonce)
+	require.Equal(t, []byte{0x60, 0x00}, alloc[contract].Code)
+	require.Equal(t, types.StringToHash("0x5"), alloc[contract].Storage[types.StringToHash("0x1")])
+
+	require.Equal(t, big.NewInt(100), alloc[account].Balance)
+	require.Equal(t, command.DefaultPremineBalance, alloc[premined].Balance)
+
+	// the accounts created by the genesis are never overridden
+	err := p.addAllocFileAccounts(map[types.Address]*chain.GenesisAccount{
+		account: {Balance: big.NewInt(1)},
+	})
+	require.ErrorIs(t, err, errAllocFileCollision)
+
+	p.allocFile = filepath.Join(t.TempDir(), "missing.json")
+	require.Error(t, p.readAllocFile())
+}
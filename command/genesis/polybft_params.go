@@ -107,6 +107,12 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 				return errNoPremineAllowed
 			}
 		}
+
+		for a, account := range p.allocFileAccounts {
+			if a != types.ZeroAddress && account.Balance != nil && account.Balance.Sign() > 0 {
+				return errNoPremineAllowed
+			}
+		}
 	}
 
 	var (
@@ -264,8 +270,19 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 		return err
 	}
 
+	if err := p.addAllocFileAccounts(allocs); err != nil {
+		return err
+	}
+
 	// premine other accounts
 	for _, premine := range premineBalances {
+		// the premine sets only the balance of the account from the alloc file
+		if account, ok := p.allocFileAccounts[premine.address]; ok {
+			account.Balance = premine.amount
+
+			continue
+		}
+
 		// validators have already been premined, so no need to premine them again
 		if _, ok := allocs[premine.address]; ok {
 			continue
@@ -0,0 +1,104 @@
+package ban
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	params = &banParams{}
+)
+
+var (
+	errTargetRequired  = errors.New("ban target is required, unless the ban list is requested")
+	errInvalidDuration = errors.New("ban duration can not be negative")
+)
+
+const (
+	targetFlag   = "target"
+	durationFlag = "duration"
+	reasonFlag   = "reason"
+	listFlag     = "list"
+)
+
+type banParams struct {
+	target   string
+	duration time.Duration
+	reason   string
+	list     bool
+
+	bans []*proto.Ban
+}
+
+func (p *banParams) validateFlags() error {
+	if p.list {
+		return nil
+	}
+
+	if p.target == "" {
+		return errTargetRequired
+	}
+
+	if p.duration < 0 {
+		return errInvalidDuration
+	}
+
+	return nil
+}
+
+func (p *banParams) banPeer(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	if p.list {
+		resp, err := systemClient.PeersBanList(context.Background(), &empty.Empty{})
+		if err != nil {
+			return err
+		}
+
+		p.bans = resp.Bans
+
+		return nil
+	}
+
+	ban, err := systemClient.PeersBan(
+		context.Background(),
+		&proto.PeersBanRequest{
+			Target:   p.target,
+			Duration: uint64(p.duration / time.Second),
+			Reason:   p.reason,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.bans = []*proto.Ban{ban}
+
+	return nil
+}
+
+func (p *banParams) getResult() command.CommandResult {
+	result := &PeersBanResult{
+		Listed: p.list,
+		Bans:   make([]*BanResult, 0, len(p.bans)),
+	}
+
+	for _, ban := range p.bans {
+		result.Bans = append(result.Bans, &BanResult{
+			Target:    ban.Target,
+			Reason:    ban.Reason,
+			ExpiresAt: ban.ExpiresAt,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,70 @@
+package ban
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	peersBanCmd := &cobra.Command{
+		Use: "ban",
+		Short: "Bans a peer ID, IP address or CIDR range and disconnects the matching peers. " +
+			"Bans are kept over the restarts until they expire",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(peersBanCmd)
+
+	return peersBanCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.target,
+		targetFlag,
+		"",
+		"the peer ID, IP address or CIDR range to ban",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.duration,
+		durationFlag,
+		0,
+		"the duration of the ban, the ban is permanent if not set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.reason,
+		reasonFlag,
+		"",
+		"the reason of the ban",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.list,
+		listFlag,
+		false,
+		"list the active bans instead of adding a new one",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(listFlag, targetFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.banPeer(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,62 @@
+package ban
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type BanResult struct {
+	Target    string `json:"target"`
+	Reason    string `json:"reason,omitempty"`
+	ExpiresAt int64  `json:"expires_at,omitempty"`
+}
+
+func (r *BanResult) expiry() string {
+	if r.ExpiresAt == 0 {
+		return "never"
+	}
+
+	return time.Unix(r.ExpiresAt, 0).UTC().Format(time.RFC3339)
+}
+
+type PeersBanResult struct {
+	Listed bool         `json:"-"`
+	Bans   []*BanResult `json:"bans"`
+}
+
+func (r *PeersBanResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	if !r.Listed {
+		buffer.WriteString("\n[PEER BANNED]\n")
+	} else {
+		buffer.WriteString("\n[BANNED PEERS]\n")
+
+		if len(r.Bans) == 0 {
+			buffer.WriteString("No bans found\n")
+
+			return buffer.String()
+		}
+
+		buffer.WriteString(fmt.Sprintf("Number of bans: %d\n\n", len(r.Bans)))
+	}
+
+	for i, ban := range r.Bans {
+		if i > 0 {
+			buffer.WriteString("\n\n")
+		}
+
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Target|%s", ban.Target),
+			fmt.Sprintf("Reason|%s", ban.Reason),
+			fmt.Sprintf("Expires|%s", ban.expiry()),
+		}))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
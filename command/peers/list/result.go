@@ -3,19 +3,38 @@ package list
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 )
 
+type PeerSummary struct {
+	ID        string `json:"id"`
+	Direction string `json:"direction"`
+	// Latency is the ping round trip time in microseconds
+	Latency   int64   `json:"latency"`
+	HeadBlock *uint64 `json:"headBlock,omitempty"`
+	Score     float64 `json:"score"`
+}
+
 type PeersListResult struct {
-	Peers []string `json:"peers"`
+	Peers []PeerSummary `json:"peers"`
 }
 
 func newPeersListResult(peers []*proto.Peer) *PeersListResult {
-	resultPeers := make([]string, len(peers))
+	resultPeers := make([]PeerSummary, len(peers))
 	for i, p := range peers {
-		resultPeers[i] = p.Id
+		resultPeers[i] = PeerSummary{
+			ID:        p.Id,
+			Direction: p.Direction,
+			Latency:   p.Latency,
+			Score:     p.Score,
+		}
+
+		if p.Head != nil {
+			resultPeers[i].HeadBlock = &p.Head.Number
+		}
 	}
 
 	return &PeersListResult{
@@ -33,11 +52,19 @@ func (r *PeersListResult) GetOutput() string {
 	} else {
 		buffer.WriteString(fmt.Sprintf("Number of peers: %d\n\n", len(r.Peers)))
 
-		rows := make([]string, len(r.Peers))
+		rows := make([]string, len(r.Peers)+1)
+		rows[0] = "#|ID|DIRECTION|LATENCY|HEAD BLOCK|GOSSIP SCORE"
+
 		for i, p := range r.Peers {
-			rows[i] = fmt.Sprintf("[%d]|%s", i, p)
+			headBlock := "unknown"
+			if p.HeadBlock != nil {
+				headBlock = fmt.Sprintf("%d", *p.HeadBlock)
+			}
+
+			rows[i+1] = fmt.Sprintf("[%d]|%s|%s|%s|%s|%.2f",
+				i, p.ID, p.Direction, time.Duration(p.Latency)*time.Microsecond, headBlock, p.Score)
 		}
-		buffer.WriteString(helper.FormatKV(rows))
+		buffer.WriteString(helper.FormatList(rows))
 	}
 
 	buffer.WriteString("\n")
@@ -50,9 +50,5 @@ func (p *statusParams) initPeerInfo(grpcAddress string) error {
 }
 
 func (p *statusParams) getResult() command.CommandResult {
-	return &PeersStatusResult{
-		ID:        p.peerStatus.Id,
-		Protocols: p.peerStatus.Protocols,
-		Addresses: p.peerStatus.Addrs,
-	}
+	return newPeersStatusResult(p.peerStatus)
 }
@@ -3,26 +3,127 @@ package status
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
 )
 
+type ProtocolBandwidth struct {
+	Protocol string `json:"protocol"`
+	BytesIn  uint64 `json:"bytesIn"`
+	BytesOut uint64 `json:"bytesOut"`
+}
+
+type TopicMessages struct {
+	Topic string `json:"topic"`
+	Count uint64 `json:"count"`
+}
+
 type PeersStatusResult struct {
 	ID        string   `json:"id"`
 	Protocols []string `json:"protocols"`
 	Addresses []string `json:"addresses"`
+	Score     float64  `json:"score"`
+	Direction string   `json:"direction"`
+	// ConnectedAt is the unix time the connection was opened at
+	ConnectedAt int64 `json:"connectedAt"`
+	// Latency is the ping round trip time in microseconds
+	Latency        int64               `json:"latency"`
+	Distance       string              `json:"distance"`
+	HeadBlock      *uint64             `json:"headBlock,omitempty"`
+	Bandwidth      []ProtocolBandwidth `json:"bandwidth"`
+	GossipMessages []TopicMessages     `json:"gossipMessages"`
+}
+
+func newPeersStatusResult(peer *proto.Peer) *PeersStatusResult {
+	result := &PeersStatusResult{
+		ID:             peer.Id,
+		Protocols:      peer.Protocols,
+		Addresses:      peer.Addrs,
+		Score:          peer.Score,
+		Direction:      peer.Direction,
+		ConnectedAt:    peer.ConnectedAt,
+		Latency:        peer.Latency,
+		Distance:       peer.Distance,
+		Bandwidth:      make([]ProtocolBandwidth, len(peer.Bandwidth)),
+		GossipMessages: make([]TopicMessages, len(peer.GossipMessages)),
+	}
+
+	if peer.Head != nil {
+		result.HeadBlock = &peer.Head.Number
+	}
+
+	for i, bandwidth := range peer.Bandwidth {
+		result.Bandwidth[i] = ProtocolBandwidth{
+			Protocol: bandwidth.Protocol,
+			BytesIn:  bandwidth.BytesIn,
+			BytesOut: bandwidth.BytesOut,
+		}
+	}
+
+	for i, messages := range peer.GossipMessages {
+		result.GossipMessages[i] = TopicMessages{
+			Topic: messages.Topic,
+			Count: messages.Count,
+		}
+	}
+
+	return result
 }
 
 func (r *PeersStatusResult) GetOutput() string {
 	var buffer bytes.Buffer
 
+	headBlock := "unknown"
+	if r.HeadBlock != nil {
+		headBlock = fmt.Sprintf("%d", *r.HeadBlock)
+	}
+
+	connectedAt := time.Unix(r.ConnectedAt, 0).UTC()
+
 	buffer.WriteString("\n[PEER STATUS]\n")
 	buffer.WriteString(helper.FormatKV([]string{
 		fmt.Sprintf("ID|%s", r.ID),
 		fmt.Sprintf("Protocols|%s", r.Protocols),
 		fmt.Sprintf("Addresses|%s", r.Addresses),
+		fmt.Sprintf("Gossip Score|%.2f", r.Score),
+		fmt.Sprintf("Direction|%s", r.Direction),
+		fmt.Sprintf("Connected Since|%s (%s)",
+			connectedAt.Format(time.RFC3339), time.Since(connectedAt).Truncate(time.Second)),
+		fmt.Sprintf("Latency|%s", time.Duration(r.Latency)*time.Microsecond),
+		fmt.Sprintf("Kademlia Distance|%s", r.Distance),
+		fmt.Sprintf("Head Block|%s", headBlock),
 	}))
 	buffer.WriteString("\n")
 
+	bandwidth := []string{"No traffic recorded"}
+
+	if len(r.Bandwidth) > 0 {
+		bandwidth = []string{"PROTOCOL|BYTES IN|BYTES OUT"}
+
+		for _, b := range r.Bandwidth {
+			bandwidth = append(bandwidth, fmt.Sprintf("%s|%d|%d", b.Protocol, b.BytesIn, b.BytesOut))
+		}
+	}
+
+	buffer.WriteString("\n[BANDWIDTH]\n")
+	buffer.WriteString(helper.FormatList(bandwidth))
+	buffer.WriteString("\n")
+
+	gossipMessages := []string{"No gossip messages received"}
+
+	if len(r.GossipMessages) > 0 {
+		gossipMessages = []string{"TOPIC|MESSAGES RECEIVED"}
+
+		for _, m := range r.GossipMessages {
+			gossipMessages = append(gossipMessages, fmt.Sprintf("%s|%d", m.Topic, m.Count))
+		}
+	}
+
+	buffer.WriteString("\n[GOSSIP MESSAGES]\n")
+	buffer.WriteString(helper.FormatList(gossipMessages))
+	buffer.WriteString("\n")
+
 	return buffer.String()
 }
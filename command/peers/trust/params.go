@@ -0,0 +1,51 @@
+package trust
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+var (
+	params = &trustParams{}
+)
+
+const (
+	peerIDFlag = "peer-id"
+)
+
+type trustParams struct {
+	peerID string
+}
+
+func (p *trustParams) getRequiredFlags() []string {
+	return []string{
+		peerIDFlag,
+	}
+}
+
+func (p *trustParams) trustPeer(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, err := systemClient.PeersTrust(
+		context.Background(),
+		&proto.PeersTrustRequest{
+			Id: p.peerID,
+		},
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *trustParams) getResult() command.CommandResult {
+	return &PeersTrustResult{
+		ID: p.peerID,
+	}
+}
@@ -0,0 +1,43 @@
+package trust
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	peersTrustCmd := &cobra.Command{
+		Use: "trust",
+		Short: "Marks the peer as trusted, exempting it from the connection limits and the bans. " +
+			"Trusted peers are kept over the restarts",
+		Run: runCommand,
+	}
+
+	setFlags(peersTrustCmd)
+	helper.SetRequiredFlags(peersTrustCmd, params.getRequiredFlags())
+
+	return peersTrustCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.peerID,
+		peerIDFlag,
+		"",
+		"libp2p node ID of the peer to trust",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.trustPeer(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
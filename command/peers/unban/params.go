@@ -0,0 +1,51 @@
+package unban
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+var (
+	params = &unbanParams{}
+)
+
+const (
+	targetFlag = "target"
+)
+
+type unbanParams struct {
+	target string
+}
+
+func (p *unbanParams) getRequiredFlags() []string {
+	return []string{
+		targetFlag,
+	}
+}
+
+func (p *unbanParams) unbanPeer(grpcAddress string) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, err := systemClient.PeersUnban(
+		context.Background(),
+		&proto.PeersUnbanRequest{
+			Target: p.target,
+		},
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *unbanParams) getResult() command.CommandResult {
+	return &PeersUnbanResult{
+		Target: p.target,
+	}
+}
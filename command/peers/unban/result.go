@@ -0,0 +1,24 @@
+package unban
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type PeersUnbanResult struct {
+	Target string `json:"target"`
+}
+
+func (r *PeersUnbanResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PEER UNBANNED]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Target|%s", r.Target),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,480 @@
+package regenesis
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/archive"
+	leveldb2 "github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	polyCommon "github.com/0xPolygon/polygon-edge/consensus/polybft/common"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/server"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	sourceDataDirFlag  = "source-data-dir"
+	sourceChainFlag    = "source-chain"
+	chainFlag          = "chain"
+	blockFlag          = "block"
+	targetDataDirFlag  = "target-data-dir"
+	outFlag            = "out"
+	verifyAccountsFlag = "verify-accounts"
+
+	defaultVerifyAccounts = 100
+)
+
+var (
+	migration = &migrateParams{}
+)
+
+var (
+	errNotPolyBFTChain = errors.New("the new chain has to use the polybft consensus, " +
+		"only it starts from the state of the initial trie root")
+	errPolyBFTSource = errors.New("the source chain already uses the polybft consensus, " +
+		"its genesis contracts can't be initialized again in the new chain")
+)
+
+type migrateParams struct {
+	sourceDataDir  string
+	sourceChain    string
+	chain          string
+	block          uint64
+	latest         bool
+	targetDataDir  string
+	out            string
+	verifyAccounts uint64
+
+	sourceConfig *chain.Chain
+	newConfig    *chain.Chain
+
+	result *MigrateResult
+}
+
+/*
+./polygon-edge regenesis migrate --source-data-dir ./test-chain-1 --source-chain ./genesis-old.json \
+--chain ./genesis-polybft.json --target-data-dir ./new-chain-1 --out ./genesis.json
+*/
+func MigrateCmd() *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use: "migrate",
+		Short: "Migrates the state of the stopped node at the given block to the new polybft chain: " +
+			"copies the state trie, writes the new genesis starting from it and verifies a sample of the accounts " +
+			"of the genesis state against the old state",
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			migration.latest = !cmd.Flags().Changed(blockFlag)
+
+			return migration.validateFlags()
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			outputter := command.InitializeOutputter(cmd)
+			defer outputter.WriteOutput()
+
+			if err := migration.migrate(); err != nil {
+				outputter.SetError(err)
+
+				return
+			}
+
+			outputter.SetCommandResult(migration.result)
+		},
+	}
+
+	migrateCmd.Flags().StringVar(
+		&migration.sourceDataDir,
+		sourceDataDirFlag,
+		"",
+		"the data directory of the stopped node of the old chain",
+	)
+	migrateCmd.Flags().StringVar(
+		&migration.sourceChain,
+		sourceChainFlag,
+		"",
+		"the genesis file of the old chain, its chain ID and forks are carried over to the new chain",
+	)
+	migrateCmd.Flags().StringVar(
+		&migration.chain,
+		chainFlag,
+		"",
+		"the polybft genesis file of the new chain created by the genesis command, "+
+			"the validator set and the consensus configuration are taken from it",
+	)
+	migrateCmd.Flags().Uint64Var(
+		&migration.block,
+		blockFlag,
+		0,
+		"the block of the old chain the state is migrated at, the latest block if not set",
+	)
+	migrateCmd.Flags().StringVar(
+		&migration.targetDataDir,
+		targetDataDirFlag,
+		"",
+		"the data directory of the node of the new chain the state trie is copied to",
+	)
+	migrateCmd.Flags().StringVar(
+		&migration.out,
+		outFlag,
+		fmt.Sprintf("./%s", command.DefaultGenesisFileName),
+		"the path of the new genesis file",
+	)
+	migrateCmd.Flags().Uint64Var(
+		&migration.verifyAccounts,
+		verifyAccountsFlag,
+		defaultVerifyAccounts,
+		"the number of the accounts whose balances, nonces, code and storage are compared "+
+			"between the old and the new state",
+	)
+
+	helper.SetRequiredFlags(migrateCmd, []string{
+		sourceDataDirFlag,
+		sourceChainFlag,
+		chainFlag,
+		targetDataDirFlag,
+	})
+
+	return migrateCmd
+}
+
+func (p *migrateParams) validateFlags() error {
+	var err error
+
+	if p.sourceConfig, err = chain.ImportFromFile(p.sourceChain); err != nil {
+		return fmt.Errorf("failed to read the genesis of the old chain: %w", err)
+	}
+
+	if p.newConfig, err = chain.ImportFromFile(p.chain); err != nil {
+		return fmt.Errorf("failed to read the genesis of the new chain: %w", err)
+	}
+
+	if p.sourceConfig.Params.GetEngine() == string(server.PolyBFTConsensus) {
+		return errPolyBFTSource
+	}
+
+	if p.newConfig.Params.GetEngine() != string(server.PolyBFTConsensus) {
+		return errNotPolyBFTChain
+	}
+
+	for _, path := range []string{filepath.Join(p.sourceDataDir, "blockchain"), filepath.Join(p.sourceDataDir, "trie")} {
+		if _, err := os.Stat(path); err != nil {
+			return fmt.Errorf("invalid source data directory: %w", err)
+		}
+	}
+
+	// the migration never overwrites the existing data
+	for _, path := range []string{filepath.Join(p.targetDataDir, "trie"), p.out} {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists", path)
+		}
+	}
+
+	return nil
+}
+
+func (p *migrateParams) migrate() (err error) {
+	header, err := p.readHeader()
+	if err != nil {
+		return err
+	}
+
+	sourceDB, err := leveldb.OpenFile(filepath.Join(p.sourceDataDir, "trie"), &opt.Options{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to open the state storage of the old chain, is the node stopped? %w", err)
+	}
+
+	defer sourceDB.Close()
+
+	triePath := filepath.Join(p.targetDataDir, "trie")
+
+	targetStorage, err := itrie.NewLevelDBStorage(triePath, hclog.NewNullLogger())
+	if err != nil {
+		return fmt.Errorf("failed to create the state storage of the new chain: %w", err)
+	}
+
+	defer func() {
+		_ = targetStorage.Close()
+
+		// the partially migrated state is never left behind
+		if err != nil {
+			_ = os.RemoveAll(triePath)
+			_ = os.Remove(p.out)
+		}
+	}()
+
+	sourceStorage := itrie.NewKV(sourceDB)
+
+	if err := itrie.CopyTrie(header.StateRoot.Bytes(), sourceStorage, targetStorage, nil, false); err != nil {
+		return fmt.Errorf("copy trie error: %w", err)
+	}
+
+	checkedHash, err := itrie.HashChecker(header.StateRoot.Bytes(), targetStorage)
+	if err != nil {
+		return fmt.Errorf("copy trie error: %w", err)
+	}
+
+	if checkedHash != header.StateRoot {
+		return fmt.Errorf("incorrect trie root of the copied state %s, expected %s", checkedHash, header.StateRoot)
+	}
+
+	if err := p.writeGenesis(header.Number, header.StateRoot); err != nil {
+		return err
+	}
+
+	genesis, genesisRoot, err := writeGenesisState(p.out, header.StateRoot, targetStorage)
+	if err != nil {
+		return err
+	}
+
+	keys, err := itrie.AccountKeys(header.StateRoot, sourceStorage)
+	if err != nil {
+		return err
+	}
+
+	// the accounts changed by the alloc of the new genesis are always verified
+	sample := appendChangedKeys(sampleKeys(keys, p.verifyAccounts), keys, genesis.Genesis.Alloc)
+
+	slots, err := itrie.CompareGenesisAccounts(
+		sample,
+		header.StateRoot,
+		sourceStorage,
+		genesis.Genesis.Alloc,
+		genesisRoot,
+		targetStorage,
+	)
+	if err != nil {
+		return fmt.Errorf("verification of the genesis state failed: %w", err)
+	}
+
+	p.result = &MigrateResult{
+		Number:           header.Number,
+		Hash:             header.Hash.String(),
+		StateRoot:        header.StateRoot.String(),
+		Accounts:         len(keys),
+		VerifiedAccounts: len(sample),
+		VerifiedSlots:    slots,
+		Genesis:          p.out,
+		Trie:             triePath,
+		ChangedAccounts:  changedAccounts(p.newConfig.Genesis.Alloc, keys),
+	}
+
+	return nil
+}
+
+// readHeader returns the header of the block the state is migrated at
+func (p *migrateParams) readHeader() (*types.Header, error) {
+	db, err := leveldb2.NewLevelDBStorage(filepath.Join(p.sourceDataDir, "blockchain"), hclog.NewNullLogger())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the blockchain storage of the old chain, is the node stopped? %w", err)
+	}
+
+	defer db.Close()
+
+	blockchain := archive.NewStorageSnapshotChain(db)
+
+	head := blockchain.Header()
+	if head == nil {
+		return nil, errors.New("the old chain is empty")
+	}
+
+	if p.latest {
+		return head, nil
+	}
+
+	if p.block > head.Number {
+		return nil, fmt.Errorf("block %d is above the latest block %d", p.block, head.Number)
+	}
+
+	header, ok := blockchain.GetHeaderByNumber(p.block)
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", p.block)
+	}
+
+	return header, nil
+}
+
+// writeGenesis writes the genesis of the new chain starting from the given state root
+func (p *migrateParams) writeGenesis(number uint64, stateRoot types.Hash) error {
+	polyBFTConfig, err := polyCommon.GetPolyBFTConfig(p.newConfig.Params)
+	if err != nil {
+		return err
+	}
+
+	polyBFTConfig.InitialTrieRoot = stateRoot
+	p.newConfig.Params.Engine[string(server.PolyBFTConsensus)] = polyBFTConfig
+
+	p.newConfig.Params.ChainID = p.sourceConfig.Params.ChainID
+	p.newConfig.Params.Forks = migrateForks(p.sourceConfig.Params.Forks, p.newConfig.Params.Forks, number)
+
+	return helper.WriteGenesisConfigToDisk(p.newConfig, p.out)
+}
+
+// writeGenesisState writes the genesis state of the new chain on top of the migrated state the way its node does
+// on start, and returns the genesis of the new chain read by the node along with the root of the genesis state
+func writeGenesisState(
+	genesisPath string,
+	stateRoot types.Hash,
+	storage itrie.Storage,
+) (*chain.Chain, types.Hash, error) {
+	genesis, err := chain.ImportFromFile(genesisPath)
+	if err != nil {
+		return nil, types.ZeroHash, err
+	}
+
+	executor := server.NewGenesisExecutor(genesis, itrie.NewState(storage), hclog.NewNullLogger())
+
+	root, err := executor.WriteGenesis(genesis.Genesis.Alloc, stateRoot)
+	if err != nil {
+		return nil, types.ZeroHash, fmt.Errorf("failed to write the genesis state of the new chain: %w", err)
+	}
+
+	return genesis, root, nil
+}
+
+// migrateForks carries the forks of the old chain over to the new chain starting at the given block.
+// The forks active at the block are active from the new genesis, the later ones keep their distance from it.
+// The forks unknown to the old chain are taken from the new chain
+func migrateForks(source, target *chain.Forks, number uint64) *chain.Forks {
+	forks := chain.Forks{}
+
+	if target != nil {
+		for name, fork := range *target {
+			forks[name] = fork
+		}
+	}
+
+	if source == nil {
+		return &forks
+	}
+
+	for name, fork := range *source {
+		migrated, ok := forks[name]
+		if !ok {
+			migrated = fork
+		}
+
+		migrated.Block = 0
+		if !fork.Active(number) {
+			migrated.Block = fork.Block - number
+		}
+
+		forks[name] = migrated
+	}
+
+	return &forks
+}
+
+// sampleKeys returns the given number of the keys evenly spread over all of them
+func sampleKeys(keys []types.Hash, n uint64) []types.Hash {
+	if uint64(len(keys)) <= n {
+		return keys
+	}
+
+	sample := make([]types.Hash, 0, n)
+
+	for i := uint64(0); i < n; i++ {
+		sample = append(sample, keys[i*uint64(len(keys))/n])
+	}
+
+	return sample
+}
+
+// appendChangedKeys appends the keys of the accounts of the old state changed by the alloc to the sample
+func appendChangedKeys(
+	sample []types.Hash,
+	keys []types.Hash,
+	alloc map[types.Address]*chain.GenesisAccount,
+) []types.Hash {
+	existing := make(map[types.Hash]bool, len(keys))
+	for _, key := range keys {
+		existing[key] = false
+	}
+
+	for _, key := range sample {
+		existing[key] = true
+	}
+
+	for addr := range alloc {
+		key := types.BytesToHash(crypto.Keccak256(addr.Bytes()))
+		if sampled, ok := existing[key]; ok && !sampled {
+			existing[key] = true
+			sample = append(sample, key)
+		}
+	}
+
+	return sample
+}
+
+// changedAccounts returns the accounts of the old state the genesis of the new chain changes
+func changedAccounts(alloc map[types.Address]*chain.GenesisAccount, keys []types.Hash) []string {
+	existing := make(map[types.Hash]struct{}, len(keys))
+	for _, key := range keys {
+		existing[key] = struct{}{}
+	}
+
+	changed := []string{}
+
+	for addr := range alloc {
+		if _, ok := existing[types.BytesToHash(crypto.Keccak256(addr.Bytes()))]; ok {
+			changed = append(changed, addr.String())
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+type MigrateResult struct {
+	Number           uint64   `json:"number"`
+	Hash             string   `json:"hash"`
+	StateRoot        string   `json:"state_root"`
+	Accounts         int      `json:"accounts"`
+	VerifiedAccounts int      `json:"verified_accounts"`
+	VerifiedSlots    int      `json:"verified_slots"`
+	Genesis          string   `json:"genesis"`
+	Trie             string   `json:"trie"`
+	ChangedAccounts  []string `json:"changed_accounts"`
+}
+
+func (r *MigrateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[REGENESIS MIGRATE]\n")
+	buffer.WriteString("Migrated state successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Block|%d", r.Number),
+		fmt.Sprintf("Hash|%s", r.Hash),
+		fmt.Sprintf("State root|%s", r.StateRoot),
+		fmt.Sprintf("Accounts|%d", r.Accounts),
+		fmt.Sprintf("Verified accounts|%d", r.VerifiedAccounts),
+		fmt.Sprintf("Verified storage slots|%d", r.VerifiedSlots),
+		fmt.Sprintf("Genesis|%s", r.Genesis),
+		fmt.Sprintf("Trie|%s", r.Trie),
+	}))
+	buffer.WriteString("\n")
+
+	if len(r.ChangedAccounts) != 0 {
+		buffer.WriteString("\n[WARNING: ACCOUNTS CHANGED BY THE NEW GENESIS]\n")
+		buffer.WriteString("The genesis of the new chain adds balances to or overrides these accounts of the old state:\n")
+
+		for _, addr := range r.ChangedAccounts {
+			buffer.WriteString(addr)
+			buffer.WriteString("\n")
+		}
+	}
+
+	buffer.WriteString("\nCopy the trie directory to the data directories of the other validators of the new chain\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,99 @@
+package regenesis
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/forkmanager"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_migrateForks(t *testing.T) {
+	t.Parallel()
+
+	epochSize := uint64(10)
+
+	source := &chain.Forks{
+		chain.Homestead: chain.NewFork(0),
+		chain.London:    chain.NewFork(50),
+		chain.EIP150:    chain.NewFork(200),
+	}
+
+	target := &chain.Forks{
+		chain.Homestead:  chain.NewFork(0),
+		chain.EIP150:     {Block: 0, Params: &forkmanager.ForkParams{EpochSize: &epochSize}},
+		chain.Governance: chain.NewFork(0),
+	}
+
+	forks := migrateForks(source, target, 100)
+
+	require.Equal(t, &chain.Forks{
+		chain.Homestead: chain.NewFork(0),
+		// active at the migrated block
+		chain.London: chain.NewFork(0),
+		// the params of the new chain are kept
+		chain.EIP150: {Block: 100, Params: &forkmanager.ForkParams{EpochSize: &epochSize}},
+		// unknown to the old chain
+		chain.Governance: chain.NewFork(0),
+	}, forks)
+}
+
+func Test_sampleKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]types.Hash, 10)
+	for i := range keys {
+		keys[i] = types.BytesToHash(big.NewInt(int64(i)).Bytes())
+	}
+
+	require.Equal(t, keys, sampleKeys(keys, 20))
+	require.Empty(t, sampleKeys(keys, 0))
+	require.Equal(t, []types.Hash{keys[0], keys[2], keys[4], keys[6], keys[8]}, sampleKeys(keys, 5))
+	require.Equal(t, []types.Hash{keys[0], keys[3], keys[6]}, sampleKeys(keys, 3))
+}
+
+func Test_changedAccounts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		existing = types.StringToAddress("0x1")
+		added    = types.StringToAddress("0x2")
+	)
+
+	changed := changedAccounts(map[types.Address]*chain.GenesisAccount{
+		existing: {Balance: big.NewInt(1)},
+		added:    {Balance: big.NewInt(1)},
+	}, []types.Hash{types.BytesToHash(crypto.Keccak256(existing.Bytes()))})
+
+	require.Equal(t, []string{existing.String()}, changed)
+}
+
+func Test_appendChangedKeys(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sampled = types.StringToAddress("0x1")
+		changed = types.StringToAddress("0x2")
+		added   = types.StringToAddress("0x3")
+	)
+
+	key := func(addr types.Address) types.Hash {
+		return types.BytesToHash(crypto.Keccak256(addr.Bytes()))
+	}
+
+	sample := appendChangedKeys(
+		[]types.Hash{key(sampled)},
+		[]types.Hash{key(sampled), key(changed)},
+		map[types.Address]*chain.GenesisAccount{
+			sampled: {Balance: big.NewInt(1)},
+			changed: {Balance: big.NewInt(1)},
+			added:   {Balance: big.NewInt(1)},
+		},
+	)
+
+	// the sampled accounts are not repeated and the accounts missing in the old state are not verified
+	require.Equal(t, []types.Hash{key(sampled), key(changed)}, sample)
+}
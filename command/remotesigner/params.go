@@ -0,0 +1,74 @@
+package remotesigner
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+)
+
+const (
+	dataDirFlag            = "data-dir"
+	configFlag             = "config"
+	listenFlag             = "listen"
+	slashingProtectionFlag = "slashing-protection-db"
+	allowRawSigningFlag    = "allow-raw-ecdsa-signing"
+
+	defaultListenAddress = "127.0.0.1:9000"
+
+	// defaultSlashingProtectionFile is the slashing protection database file name in the data directory
+	defaultSlashingProtectionFile = "slashing-protection.json"
+)
+
+var (
+	params = &remoteSignerParams{}
+)
+
+var (
+	errInvalidConfig            = errors.New("invalid secrets configuration")
+	errInvalidParams            = errors.New("no config file or data directory passed in")
+	errUnsupportedType          = errors.New("unsupported secrets manager")
+	errNoSlashingProtectionPath = errors.New("slashing protection database path is required with the config file")
+)
+
+type remoteSignerParams struct {
+	dataDir                string
+	configPath             string
+	listenAddress          string
+	slashingProtectionPath string
+	allowRawSigning        bool
+}
+
+func (p *remoteSignerParams) validateFlags() error {
+	if p.dataDir == "" && p.configPath == "" {
+		return errInvalidParams
+	}
+
+	if p.slashingProtectionPath == "" {
+		if p.dataDir == "" {
+			return errNoSlashingProtectionPath
+		}
+
+		p.slashingProtectionPath = filepath.Join(p.dataDir, defaultSlashingProtectionFile)
+	}
+
+	return nil
+}
+
+func (p *remoteSignerParams) initSecretsManager() (secrets.SecretsManager, error) {
+	if p.configPath == "" {
+		return helper.SetupLocalSecretsManager(p.dataDir)
+	}
+
+	secretsConfig, err := secrets.ReadConfig(p.configPath)
+	if err != nil {
+		return nil, errInvalidConfig
+	}
+
+	if !secrets.SupportedServiceManager(secretsConfig.Type) {
+		return nil, errUnsupportedType
+	}
+
+	return helper.InitCloudSecretsManager(secretsConfig)
+}
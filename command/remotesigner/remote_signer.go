@@ -0,0 +1,152 @@
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/remotesigner"
+)
+
+func GetCommand() *cobra.Command {
+	remoteSignerCmd := &cobra.Command{
+		Use: "remote-signer",
+		Short: "Starts the remote signer holding the validator keys of the Secrets Manager, " +
+			"so the validator node signs without loading the keys. " +
+			"The signer refuses to sign conflicting consensus messages for the same height and round. " +
+			"It serves the ECDSA and polybft BLS keys only, the IBFT BLS keys must be held by the node",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(remoteSignerCmd)
+
+	return remoteSignerCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the directory for the Polygon Edge data if the local FS is used",
+	)
+
+	cmd.Flags().StringVar(
+		&params.configPath,
+		configFlag,
+		"",
+		"the path to the SecretsManager config file, "+
+			"if omitted, the local FS secrets manager is used",
+	)
+
+	cmd.Flags().StringVar(
+		&params.listenAddress,
+		listenFlag,
+		defaultListenAddress,
+		"the address the remote signer listens on",
+	)
+
+	cmd.Flags().StringVar(
+		&params.slashingProtectionPath,
+		slashingProtectionFlag,
+		"",
+		fmt.Sprintf(
+			"the path to the slashing protection database, defaults to %s in the data directory",
+			defaultSlashingProtectionFile,
+		),
+	)
+
+	cmd.Flags().BoolVar(
+		&params.allowRawSigning,
+		allowRawSigningFlag,
+		false,
+		"allow signing any digest with the ECDSA key, required by the polybft validators "+
+			"to send the bridge transactions. The digests are not checked by the slashing protection, "+
+			"so it must not be enabled for the IBFT validators, whose committed seals are ECDSA signatures",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(dataDirFlag, configFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := runRemoteSigner(); err != nil {
+		outputter.SetError(err)
+	}
+}
+
+func runRemoteSigner() error {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:   "polygon",
+		Level:  hclog.Info,
+		Output: os.Stderr,
+	})
+
+	secretsManager, err := params.initSecretsManager()
+	if err != nil {
+		return err
+	}
+
+	signer, err := remotesigner.NewServer(
+		logger,
+		secretsManager,
+		params.slashingProtectionPath,
+		params.allowRawSigning,
+	)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", params.listenAddress)
+	if err != nil {
+		return fmt.Errorf("unable to listen on %s: %w", params.listenAddress, err)
+	}
+
+	srv := &http.Server{
+		Handler:           signer,
+		ReadHeaderTimeout: 60 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- srv.Serve(listener)
+	}()
+
+	logger.Info(
+		"remote signer started",
+		"address", signer.Address(),
+		"listen", listener.Addr(),
+		"slashing_protection_db", params.slashingProtectionPath,
+	)
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+	case <-common.GetTerminationSignalCh():
+		logger.Info("stopping remote signer")
+
+		if err := srv.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
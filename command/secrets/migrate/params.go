@@ -0,0 +1,158 @@
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/encryptedlocal"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+)
+
+const (
+	dataDirFlag        = "data-dir"
+	passphraseFileFlag = "passphrase-file"
+	passphraseEnvFlag  = "passphrase-env"
+	keepPlaintextFlag  = "keep-plaintext"
+)
+
+var (
+	params = &migrateParams{}
+)
+
+var (
+	errInvalidParams     = errors.New("no data directory passed in")
+	errNoPlaintextSecret = errors.New("no plaintext secrets found in the data directory")
+)
+
+// migratedSecrets are the secrets of the local secrets manager which are migrated
+var migratedSecrets = []string{
+	secrets.ValidatorKey,
+	secrets.ValidatorBLSKey,
+	secrets.NetworkKey,
+}
+
+type migrateParams struct {
+	dataDir        string
+	passphraseFile string
+	passphraseEnv  string
+	keepPlaintext  bool
+
+	// scrypt parameters of the encrypted secrets, the standard ones are used if not set
+	scryptN int
+	scryptP int
+
+	migrated []string
+}
+
+func (mp *migrateParams) validateFlags() error {
+	if mp.dataDir == "" {
+		return errInvalidParams
+	}
+
+	return nil
+}
+
+// migrateSecrets encrypts the plaintext secrets of the data directory
+// and removes the plaintext ones, unless they should be kept
+func (mp *migrateParams) migrateSecrets() error {
+	plaintextManager, err := helper.SetupLocalSecretsManager(mp.dataDir)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(migratedSecrets))
+
+	for _, name := range migratedSecrets {
+		if plaintextManager.HasSecret(name) {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return errNoPlaintextSecret
+	}
+
+	passphrase, err := encryptedlocal.ReadPassphrase(map[string]interface{}{
+		secrets.PassphraseFile: mp.passphraseFile,
+		secrets.PassphraseEnv:  mp.passphraseEnv,
+	}, true)
+	if err != nil {
+		return err
+	}
+
+	scryptN, scryptP := mp.scryptN, mp.scryptP
+	if scryptN == 0 || scryptP == 0 {
+		scryptN, scryptP = encryptedlocal.StandardScryptN, encryptedlocal.StandardScryptP
+	}
+
+	encryptedManager, err := encryptedlocal.NewEncryptedLocalSecretsManager(
+		hclog.NewNullLogger(),
+		mp.dataDir,
+		passphrase,
+		scryptN,
+		scryptP,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if err := migrateSecret(name, plaintextManager, encryptedManager); err != nil {
+			return fmt.Errorf("unable to migrate secret %s, %w", name, err)
+		}
+
+		mp.migrated = append(mp.migrated, name)
+	}
+
+	if mp.keepPlaintext {
+		return nil
+	}
+
+	for _, name := range names {
+		if err := plaintextManager.RemoveSecret(name); err != nil {
+			return fmt.Errorf("unable to remove plaintext secret %s, %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// migrateSecret encrypts the plaintext secret, unless it is already encrypted,
+// and checks that the encrypted secret matches the plaintext one
+func migrateSecret(name string, plaintextManager, encryptedManager secrets.SecretsManager) error {
+	value, err := plaintextManager.GetSecret(name)
+	if err != nil {
+		return err
+	}
+
+	if !encryptedManager.HasSecret(name) {
+		if err := encryptedManager.SetSecret(name, value); err != nil {
+			return err
+		}
+	}
+
+	encrypted, err := encryptedManager.GetSecret(name)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(value, encrypted) {
+		return errors.New("encrypted secret does not match the plaintext one")
+	}
+
+	return nil
+}
+
+func (mp *migrateParams) getResult() command.CommandResult {
+	return &SecretsMigrateResult{
+		DataDir:           mp.dataDir,
+		Secrets:           mp.migrated,
+		PlaintextRemoved:  !mp.keepPlaintext,
+		SecretsConfigType: string(secrets.EncryptedLocal),
+	}
+}
@@ -0,0 +1,68 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/encryptedlocal"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+)
+
+func Test_migrateSecrets(t *testing.T) {
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("passphrase\n"), 0600))
+
+	newParams := func(dataDir string, keepPlaintext bool) *migrateParams {
+		return &migrateParams{
+			dataDir:        dataDir,
+			passphraseFile: passphraseFile,
+			keepPlaintext:  keepPlaintext,
+			scryptN:        encryptedlocal.LightScryptN,
+			scryptP:        encryptedlocal.LightScryptP,
+		}
+	}
+
+	t.Run("no plaintext secrets", func(t *testing.T) {
+		require.ErrorIs(t, newParams(t.TempDir(), false).migrateSecrets(), errNoPlaintextSecret)
+	})
+
+	for _, keepPlaintext := range []bool{false, true} {
+		dataDir := t.TempDir()
+
+		plaintextManager, err := helper.SetupLocalSecretsManager(dataDir)
+		require.NoError(t, err)
+
+		address, err := helper.InitECDSAValidatorKey(plaintextManager)
+		require.NoError(t, err)
+
+		_, err = helper.InitNetworkingPrivateKey(plaintextManager)
+		require.NoError(t, err)
+
+		params := newParams(dataDir, keepPlaintext)
+		require.NoError(t, params.migrateSecrets())
+		assert.Equal(t, []string{secrets.ValidatorKey, secrets.NetworkKey}, params.migrated)
+
+		plaintextManager, err = helper.SetupLocalSecretsManager(dataDir)
+		require.NoError(t, err)
+
+		assert.Equal(t, keepPlaintext, plaintextManager.HasSecret(secrets.ValidatorKey))
+		assert.Equal(t, keepPlaintext, plaintextManager.HasSecret(secrets.NetworkKey))
+
+		encryptedManager, err := encryptedlocal.NewEncryptedLocalSecretsManager(
+			hclog.NewNullLogger(), dataDir, "passphrase", encryptedlocal.LightScryptN, encryptedlocal.LightScryptP)
+		require.NoError(t, err)
+
+		encryptedAddress, err := helper.LoadValidatorAddress(encryptedManager)
+		require.NoError(t, err)
+		assert.Equal(t, address, encryptedAddress)
+
+		assert.True(t, encryptedManager.HasSecret(secrets.NetworkKey))
+		assert.False(t, encryptedManager.HasSecret(secrets.ValidatorBLSKey))
+	}
+}
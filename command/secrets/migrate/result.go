@@ -0,0 +1,36 @@
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type SecretsMigrateResult struct {
+	DataDir           string   `json:"data_dir"`
+	Secrets           []string `json:"secrets"`
+	PlaintextRemoved  bool     `json:"plaintext_removed"`
+	SecretsConfigType string   `json:"secrets_config_type"`
+}
+
+func (r *SecretsMigrateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := []string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("Encrypted secrets|%s", strings.Join(r.Secrets, ", ")),
+		fmt.Sprintf("Plaintext secrets removed|%t", r.PlaintextRemoved),
+	}
+
+	buffer.WriteString("\n[SECRETS MIGRATE]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf(
+		"\nUse a secrets config of the %q type to run the node with the encrypted secrets\n",
+		r.SecretsConfigType,
+	))
+
+	return buffer.String()
+}
@@ -0,0 +1,77 @@
+package migrate
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+func GetCommand() *cobra.Command {
+	secretsMigrateCmd := &cobra.Command{
+		Use: "migrate",
+		Short: "Encrypts the plaintext private keys of the local FS Secrets Manager " +
+			"with a passphrase, migrating them to the encrypted local Secrets Manager",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(secretsMigrateCmd)
+
+	return secretsMigrateCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the directory for the Polygon Edge data holding the plaintext secrets",
+	)
+
+	cmd.Flags().StringVar(
+		&params.passphraseFile,
+		passphraseFileFlag,
+		"",
+		"the path to the file holding the passphrase used to encrypt the secrets",
+	)
+
+	cmd.Flags().StringVar(
+		&params.passphraseEnv,
+		passphraseEnvFlag,
+		secrets.DefaultPassphraseEnv,
+		fmt.Sprintf(
+			"the environment variable holding the passphrase used to encrypt the secrets, "+
+				"used if the %s flag is omitted (the passphrase is prompted for if the variable is not set)",
+			passphraseFileFlag,
+		),
+	)
+
+	cmd.Flags().BoolVar(
+		&params.keepPlaintext,
+		keepPlaintextFlag,
+		false,
+		"the flag indicating whether the plaintext secrets are kept after the migration",
+	)
+
+	_ = cmd.MarkFlagRequired(dataDirFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.migrateSecrets(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
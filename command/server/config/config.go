@@ -7,6 +7,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/blocksink"
+	"github.com/0xPolygon/polygon-edge/health"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/hashicorp/hcl"
 	"gopkg.in/yaml.v3"
@@ -16,10 +20,13 @@ import (
 type Config struct {
 	GenesisPath              string     `json:"chain_config" yaml:"chain_config"`
 	SecretsConfigPath        string     `json:"secrets_config" yaml:"secrets_config"`
+	RemoteSignerURL          string     `json:"remote_signer_url" yaml:"remote_signer_url"`
 	DataDir                  string     `json:"data_dir" yaml:"data_dir"`
 	BlockGasTarget           string     `json:"block_gas_target" yaml:"block_gas_target"`
 	GRPCAddr                 string     `json:"grpc_addr" yaml:"grpc_addr"`
 	JSONRPCAddr              string     `json:"jsonrpc_addr" yaml:"jsonrpc_addr"`
+	JSONRPCIPCPath           string     `json:"json_rpc_ipc_path" yaml:"json_rpc_ipc_path"`
+	JSONRPCAdmin             bool       `json:"json_rpc_admin" yaml:"json_rpc_admin"`
 	Telemetry                *Telemetry `json:"telemetry" yaml:"telemetry"`
 	Network                  *Network   `json:"network" yaml:"network"`
 	ShouldSeal               bool       `json:"seal" yaml:"seal"`
@@ -30,8 +37,13 @@ type Config struct {
 	LogFilePath              string     `json:"log_to" yaml:"log_to"`
 	JSONRPCBatchRequestLimit uint64     `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
 	JSONRPCBlockRangeLimit   uint64     `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
+	LogIndex                 bool       `json:"log_index" yaml:"log_index"`
+	Preimages                bool       `json:"preimages" yaml:"preimages"`
+	JSONRPCIndexedRangeLimit uint64     `json:"json_rpc_indexed_block_range_limit" yaml:"json_rpc_indexed_block_range_limit"`
 	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
 	CorsAllowedOrigins       []string   `json:"cors_allowed_origins" yaml:"cors_allowed_origins"`
+	Light                    bool       `json:"light" yaml:"light"`
+	CheckpointSync           string     `json:"checkpoint_sync" yaml:"checkpoint_sync"`
 
 	Relayer                    bool          `json:"relayer" yaml:"relayer"`
 	NumBlockConfirmations      uint64        `json:"num_block_confirmations" yaml:"num_block_confirmations"`
@@ -39,6 +51,14 @@ type Config struct {
 
 	ConcurrentRequestsDebug uint64 `json:"concurrent_requests_debug" yaml:"concurrent_requests_debug"`
 	WebSocketReadLimit      uint64 `json:"web_socket_read_limit" yaml:"web_socket_read_limit"`
+
+	JSONRPCRateLimit *JSONRPCRateLimit `json:"json_rpc_rate_limit" yaml:"json_rpc_rate_limit"`
+
+	Health *Health `json:"health" yaml:"health"`
+
+	Tracing *Tracing `json:"tracing" yaml:"tracing"`
+
+	BlockSink *BlockSink `json:"block_sink" yaml:"block_sink"`
 }
 
 // Telemetry holds the config details for metric services.
@@ -48,13 +68,20 @@ type Telemetry struct {
 
 // Network defines the network configuration params
 type Network struct {
-	NoDiscover       bool   `json:"no_discover" yaml:"no_discover"`
-	Libp2pAddr       string `json:"libp2p_addr" yaml:"libp2p_addr"`
-	NatAddr          string `json:"nat_addr" yaml:"nat_addr"`
-	DNSAddr          string `json:"dns_addr" yaml:"dns_addr"`
-	MaxPeers         int64  `json:"max_peers,omitempty" yaml:"max_peers,omitempty"`
-	MaxOutboundPeers int64  `json:"max_outbound_peers,omitempty" yaml:"max_outbound_peers,omitempty"`
-	MaxInboundPeers  int64  `json:"max_inbound_peers,omitempty" yaml:"max_inbound_peers,omitempty"`
+	NoDiscover       bool     `json:"no_discover" yaml:"no_discover"`
+	Libp2pAddr       string   `json:"libp2p_addr" yaml:"libp2p_addr"`
+	Libp2pListen     []string `json:"libp2p_listen,omitempty" yaml:"libp2p_listen,omitempty"`
+	Libp2pQUIC       bool     `json:"libp2p_quic" yaml:"libp2p_quic"`
+	Libp2pSecurity   string   `json:"libp2p_security" yaml:"libp2p_security"`
+	NatAddr          string   `json:"nat_addr" yaml:"nat_addr"`
+	DNSAddr          string   `json:"dns_addr" yaml:"dns_addr"`
+	MaxPeers         int64    `json:"max_peers,omitempty" yaml:"max_peers,omitempty"`
+	MaxOutboundPeers int64    `json:"max_outbound_peers,omitempty" yaml:"max_outbound_peers,omitempty"`
+	MaxInboundPeers  int64    `json:"max_inbound_peers,omitempty" yaml:"max_inbound_peers,omitempty"`
+	StaticPeers      []string `json:"static_peers,omitempty" yaml:"static_peers,omitempty"`
+	TrustedPeers     []string `json:"trusted_peers,omitempty" yaml:"trusted_peers,omitempty"`
+	SentryNodes      []string `json:"sentry_nodes,omitempty" yaml:"sentry_nodes,omitempty"`
+	PrivatePeers     []string `json:"private_peers,omitempty" yaml:"private_peers,omitempty"`
 }
 
 // TxPool defines the TxPool configuration params
@@ -64,6 +91,41 @@ type TxPool struct {
 	MaxAccountEnqueued uint64 `json:"max_account_enqueued" yaml:"max_account_enqueued"`
 }
 
+// JSONRPCRateLimit defines the per-client JSON-RPC rate limiting params
+type JSONRPCRateLimit struct {
+	RequestsPerSecond uint64            `json:"requests_per_second" yaml:"requests_per_second"`
+	Burst             uint64            `json:"burst" yaml:"burst"`
+	APIKeyHeader      string            `json:"api_key_header" yaml:"api_key_header"`
+	APIKeys           []string          `json:"api_keys" yaml:"api_keys"`
+	MethodCosts       map[string]uint64 `json:"method_costs" yaml:"method_costs"`
+	LogsBlocksPerCost uint64            `json:"logs_blocks_per_cost" yaml:"logs_blocks_per_cost"`
+}
+
+// Health defines the thresholds of the health and the readiness checks
+type Health struct {
+	MaxSyncLag          uint64 `json:"max_sync_lag" yaml:"max_sync_lag"`
+	MinPeers            uint64 `json:"min_peers" yaml:"min_peers"`
+	MaxHeadAge          uint64 `json:"max_head_age" yaml:"max_head_age"`
+	ParticipationWindow uint64 `json:"participation_window" yaml:"participation_window"`
+	MinParticipation    uint64 `json:"min_participation" yaml:"min_participation"`
+}
+
+// Tracing defines the export of the OpenTelemetry spans
+type Tracing struct {
+	Exporter    string  `json:"exporter" yaml:"exporter"`
+	Endpoint    string  `json:"endpoint" yaml:"endpoint"`
+	TLS         bool    `json:"tls" yaml:"tls"`
+	SampleRatio float64 `json:"sample_ratio" yaml:"sample_ratio"`
+}
+
+// BlockSink defines the stream of the finalized blocks published to the external indexers
+type BlockSink struct {
+	Type       string `json:"type" yaml:"type"`
+	Target     string `json:"target" yaml:"target"`
+	ReplayFrom string `json:"replay_from" yaml:"replay_from"`
+	Traces     bool   `json:"traces" yaml:"traces"`
+}
+
 // Headers defines the HTTP response headers required to enable CORS.
 type Headers struct {
 	AccessControlAllowOrigins []string `json:"access_control_allow_origins" yaml:"access_control_allow_origins"`
@@ -81,6 +143,10 @@ const (
 	// requests with fromBlock/toBlock values (e.g. eth_getLogs)
 	DefaultJSONRPCBlockRangeLimit uint64 = 1000
 
+	// DefaultJSONRPCIndexedBlockRangeLimit maximum block range allowed for json_rpc
+	// requests with fromBlock/toBlock values (e.g. eth_getLogs), when the log index is enabled
+	DefaultJSONRPCIndexedBlockRangeLimit uint64 = 100000
+
 	// DefaultNumBlockConfirmations minimal number of child blocks required for the parent block to be considered final
 	// on ethereum epoch lasts for 32 blocks. more details: https://www.alchemy.com/overviews/ethereum-commitment-levels
 	DefaultNumBlockConfirmations uint64 = 64
@@ -93,6 +159,10 @@ const (
 	// the connection sends a close message to the peer and returns ErrReadLimit to the application.
 	DefaultWebSocketReadLimit uint64 = 8192
 
+	// DefaultJSONRPCLogsBlocksPerCost specifies the number of blocks queried by eth_getLogs
+	// which are charged as one additional rate limiting cost unit
+	DefaultJSONRPCLogsBlocksPerCost uint64 = 100
+
 	// DefaultRelayerTrackerPollInterval specifies time interval after which relayer node's event tracker
 	// polls child chain to get the latest block
 	DefaultRelayerTrackerPollInterval time.Duration = time.Second
@@ -115,6 +185,8 @@ func DefaultConfig() *Config {
 				defaultNetworkConfig.Addr.IP,
 				defaultNetworkConfig.Addr.Port,
 			),
+			Libp2pQUIC:     defaultNetworkConfig.QUIC,
+			Libp2pSecurity: defaultNetworkConfig.Security,
 		},
 		Telemetry:  &Telemetry{},
 		ShouldSeal: true,
@@ -131,11 +203,35 @@ func DefaultConfig() *Config {
 		LogFilePath:                "",
 		JSONRPCBatchRequestLimit:   DefaultJSONRPCBatchRequestLimit,
 		JSONRPCBlockRangeLimit:     DefaultJSONRPCBlockRangeLimit,
+		LogIndex:                   false,
+		Preimages:                  false,
+		JSONRPCIndexedRangeLimit:   DefaultJSONRPCIndexedBlockRangeLimit,
+		Light:                      false,
+		CheckpointSync:             "",
 		Relayer:                    false,
 		NumBlockConfirmations:      DefaultNumBlockConfirmations,
 		ConcurrentRequestsDebug:    DefaultConcurrentRequestsDebug,
 		WebSocketReadLimit:         DefaultWebSocketReadLimit,
 		RelayerTrackerPollInterval: DefaultRelayerTrackerPollInterval,
+		JSONRPCRateLimit: &JSONRPCRateLimit{
+			MethodCosts:       jsonrpc.DefaultMethodCosts(),
+			LogsBlocksPerCost: DefaultJSONRPCLogsBlocksPerCost,
+		},
+		Health: &Health{
+			MaxSyncLag:          health.DefaultMaxSyncLag,
+			MinPeers:            health.DefaultMinPeers,
+			MaxHeadAge:          health.DefaultMaxHeadAge,
+			ParticipationWindow: health.DefaultParticipationWindow,
+			MinParticipation:    health.DefaultMinParticipation,
+		},
+		Tracing: &Tracing{
+			Exporter:    tracing.ExporterNone,
+			Endpoint:    tracing.DefaultOTLPEndpoint,
+			SampleRatio: tracing.DefaultSampleRatio,
+		},
+		BlockSink: &BlockSink{
+			Type: string(blocksink.None),
+		},
 	}
 }
 
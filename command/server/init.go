@@ -11,15 +11,23 @@ import (
 	helperCommon "github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/network/common"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blocksink"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/multiformats/go-multiaddr"
 )
 
 var (
 	errDataDirectoryUndefined = errors.New("data directory not defined")
+	errLightModeUnsupported   = errors.New("light node can not run the relayer nor the dev mode")
+	errForkModeUnsupported    = errors.New("the chain can be forked in the dev mode with the dev consensus only")
+	errInvalidSampleRatio     = errors.New("the tracing sample ratio has to be between 0 and 1")
+	errBlockSinkUnsupported   = errors.New("light node can not publish the blocks to the block sink")
+	errAPIKeysUndefined       = errors.New("the json-rpc API key header requires the trusted API keys")
 )
 
 func (p *serverParams) initConfigFromFile() error {
@@ -58,13 +66,78 @@ func (p *serverParams) initRawParams() error {
 
 	p.relayer = p.rawConfig.Relayer
 
+	if p.rawConfig.Light && (p.relayer || p.isDevMode) {
+		return errLightModeUnsupported
+	}
+
+	if err := p.initCheckpoint(); err != nil {
+		return err
+	}
+
+	if err := p.initBlockSink(); err != nil {
+		return err
+	}
+
+	if p.forkURL != "" && (!p.isDevMode || !p.isDevConsensus()) {
+		return errForkModeUnsupported
+	}
+
 	if p.relayer && p.rawConfig.RelayerTrackerPollInterval == 0 {
 		return helper.ErrBlockTrackerPollInterval
 	}
 
+	if ratio := p.rawConfig.Tracing.SampleRatio; ratio < 0 || ratio > 1 {
+		return errInvalidSampleRatio
+	}
+
+	// the keys presented in the header are trusted only if they are known
+	rateLimit := p.rawConfig.JSONRPCRateLimit
+	if rateLimit != nil && rateLimit.APIKeyHeader != "" && len(rateLimit.APIKeys) == 0 {
+		return errAPIKeysUndefined
+	}
+
 	return p.initAddresses()
 }
 
+func (p *serverParams) initCheckpoint() error {
+	if p.rawConfig.CheckpointSync == "" {
+		return nil
+	}
+
+	checkpoint, err := blockchain.ParseCheckpoint(p.rawConfig.CheckpointSync)
+	if err != nil {
+		return err
+	}
+
+	p.checkpoint = checkpoint
+
+	return nil
+}
+
+func (p *serverParams) initBlockSink() error {
+	sink := p.rawConfig.BlockSink
+	if sink.Type == "" || sink.Type == string(blocksink.None) {
+		return nil
+	}
+
+	if p.rawConfig.Light {
+		return errBlockSinkUnsupported
+	}
+
+	if sink.ReplayFrom == "" {
+		return nil
+	}
+
+	replayFrom, err := helperCommon.ParseUint64orHex(&sink.ReplayFrom)
+	if err != nil {
+		return fmt.Errorf("invalid block sink replay height: %w", err)
+	}
+
+	p.blockSinkReplayFrom = &replayFrom
+
+	return nil
+}
+
 func (p *serverParams) initDataDirLocation() error {
 	if p.rawConfig.DataDir == "" {
 		return errDataDirectoryUndefined
@@ -142,6 +215,7 @@ func (p *serverParams) initDevConsensusConfig() {
 	p.genesisConfig.Params.Engine = map[string]interface{}{
 		string(server.DevConsensus): map[string]interface{}{
 			"interval": p.devInterval,
+			"onDemand": p.devOnDemand,
 		},
 	}
 }
@@ -212,6 +286,10 @@ func (p *serverParams) initAddresses() error {
 		return err
 	}
 
+	if err := p.initLibp2pListenAddresses(); err != nil {
+		return err
+	}
+
 	if err := p.initNATAddress(); err != nil {
 		return err
 	}
@@ -257,6 +335,28 @@ func (p *serverParams) initLibp2pAddress() error {
 	return nil
 }
 
+func (p *serverParams) initLibp2pListenAddresses() error {
+	// the config files written before the security option was added leave it empty
+	switch p.rawConfig.Network.Libp2pSecurity {
+	case "", network.SecurityNoise, network.SecurityTLS:
+	default:
+		return fmt.Errorf("%w: %s", network.ErrInvalidSecurity, p.rawConfig.Network.Libp2pSecurity)
+	}
+
+	p.libp2pListenAddrs = make([]multiaddr.Multiaddr, 0, len(p.rawConfig.Network.Libp2pListen))
+
+	for _, rawAddr := range p.rawConfig.Network.Libp2pListen {
+		addr, err := multiaddr.NewMultiaddr(rawAddr)
+		if err != nil {
+			return fmt.Errorf("invalid libp2p listen address %s: %w", rawAddr, err)
+		}
+
+		p.libp2pListenAddrs = append(p.libp2pListenAddrs, addr)
+	}
+
+	return nil
+}
+
 func (p *serverParams) initNATAddress() error {
 	if !p.isNATAddressSet() {
 		return nil
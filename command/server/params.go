@@ -4,8 +4,13 @@ import (
 	"errors"
 	"net"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blocksink"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/0xPolygon/polygon-edge/health"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
@@ -18,6 +23,9 @@ const (
 	genesisPathFlag              = "chain"
 	dataDirFlag                  = "data-dir"
 	libp2pAddressFlag            = "libp2p"
+	libp2pListenFlag             = "libp2p-listen"
+	libp2pQUICFlag               = "libp2p-quic"
+	libp2pSecurityFlag           = "libp2p-security"
 	prometheusAddressFlag        = "prometheus"
 	natFlag                      = "nat"
 	dnsFlag                      = "dns"
@@ -25,18 +33,33 @@ const (
 	maxPeersFlag                 = "max-peers"
 	maxInboundPeersFlag          = "max-inbound-peers"
 	maxOutboundPeersFlag         = "max-outbound-peers"
+	staticPeersFlag              = "static-peers"
+	trustedPeersFlag             = "trusted-peers"
+	sentryNodesFlag              = "sentry-nodes"
+	privatePeersFlag             = "private-peers"
 	priceLimitFlag               = "price-limit"
 	jsonRPCBatchRequestLimitFlag = "json-rpc-batch-request-limit"
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
+	jsonRPCIPCPathFlag           = "json-rpc-ipc-path"
+	jsonRPCAdminFlag             = "json-rpc-admin"
+	logIndexFlag                 = "log-index"
+	preimagesFlag                = "preimages"
+	jsonRPCIndexedRangeLimitFlag = "json-rpc-indexed-block-range-limit"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
 	blockGasTargetFlag           = "block-gas-target"
 	secretsConfigFlag            = "secrets-config"
+	remoteSignerURLFlag          = "remote-signer-url"
 	restoreFlag                  = "restore"
 	devIntervalFlag              = "dev-interval"
+	devOnDemandFlag              = "dev-on-demand"
+	forkURLFlag                  = "fork-url"
+	forkBlockFlag                = "fork-block"
 	devFlag                      = "dev"
 	corsOriginFlag               = "access-control-allow-origins"
 	logFileLocationFlag          = "log-to"
+	lightFlag                    = "light"
+	checkpointSyncFlag           = "checkpoint-sync"
 
 	relayerFlag               = "relayer"
 	numBlockConfirmationsFlag = "num-block-confirmations"
@@ -44,7 +67,29 @@ const (
 	concurrentRequestsDebugFlag = "concurrent-requests-debug"
 	webSocketReadLimitFlag      = "websocket-read-limit"
 
+	jsonRPCRateLimitFlag             = "json-rpc-rate-limit"
+	jsonRPCRateLimitBurstFlag        = "json-rpc-rate-limit-burst"
+	jsonRPCRateLimitAPIKeyHeaderFlag = "json-rpc-api-key-header"
+	jsonRPCRateLimitAPIKeysFlag      = "json-rpc-api-keys"
+	jsonRPCRateLimitLogsBlocksFlag   = "json-rpc-rate-limit-logs-blocks"
+
 	relayerTrackerPollIntervalFlag = "relayer-poll-interval"
+
+	healthMaxSyncLagFlag          = "health-max-sync-lag"
+	healthMinPeersFlag            = "health-min-peers"
+	healthMaxHeadAgeFlag          = "health-max-head-age"
+	healthParticipationWindowFlag = "health-participation-window"
+	healthMinParticipationFlag    = "health-min-participation"
+
+	tracingExporterFlag    = "tracing-exporter"
+	tracingEndpointFlag    = "tracing-endpoint"
+	tracingTLSFlag         = "tracing-tls"
+	tracingSampleRatioFlag = "tracing-sample-ratio"
+
+	blockSinkFlag           = "block-sink"
+	blockSinkTargetFlag     = "block-sink-target"
+	blockSinkReplayFromFlag = "block-sink-replay-from"
+	blockSinkTracesFlag     = "block-sink-traces"
 )
 
 // Flags that are deprecated, but need to be preserved for
@@ -63,6 +108,12 @@ var (
 			Telemetry: &config.Telemetry{},
 			Network:   &config.Network{},
 			TxPool:    &config.TxPool{},
+			JSONRPCRateLimit: &config.JSONRPCRateLimit{
+				MethodCosts: jsonrpc.DefaultMethodCosts(),
+			},
+			Health:    &config.Health{},
+			Tracing:   &config.Tracing{},
+			BlockSink: &config.BlockSink{},
 		},
 	}
 )
@@ -76,6 +127,7 @@ type serverParams struct {
 	configPath string
 
 	libp2pAddress     *net.TCPAddr
+	libp2pListenAddrs []multiaddr.Multiaddr
 	prometheusAddress *net.TCPAddr
 	natAddress        net.IP
 	dnsAddress        multiaddr.Multiaddr
@@ -84,7 +136,10 @@ type serverParams struct {
 
 	blockGasTarget uint64
 	devInterval    uint64
+	devOnDemand    bool
 	isDevMode      bool
+	forkURL        string
+	forkBlock      int64
 
 	ibftBaseTimeoutLegacy uint64
 
@@ -94,6 +149,10 @@ type serverParams struct {
 	logFileLocation string
 
 	relayer bool
+
+	checkpoint *blockchain.Checkpoint
+
+	blockSinkReplayFrom *uint64
 }
 
 func (p *serverParams) isMaxPeersSet() bool {
@@ -137,6 +196,17 @@ func (p *serverParams) getRestoreFilePath() *string {
 	return nil
 }
 
+// getForkBlock returns the number of the forked block, or nil if the latest block is forked
+func (p *serverParams) getForkBlock() *uint64 {
+	if p.forkBlock < 0 {
+		return nil
+	}
+
+	number := uint64(p.forkBlock)
+
+	return &number
+}
+
 func (p *serverParams) setRawGRPCAddress(grpcAddress string) {
 	p.rawConfig.GRPCAddr = grpcAddress
 }
@@ -154,11 +224,15 @@ func (p *serverParams) generateConfig() *server.Config {
 		Chain: p.genesisConfig,
 		JSONRPC: &server.JSONRPC{
 			JSONRPCAddr:              p.jsonRPCAddress,
+			IPCPath:                  p.rawConfig.JSONRPCIPCPath,
+			Admin:                    p.rawConfig.JSONRPCAdmin,
 			AccessControlAllowOrigin: p.rawConfig.CorsAllowedOrigins,
 			BatchLengthLimit:         p.rawConfig.JSONRPCBatchRequestLimit,
 			BlockRangeLimit:          p.rawConfig.JSONRPCBlockRangeLimit,
+			IndexedBlockRangeLimit:   p.rawConfig.JSONRPCIndexedRangeLimit,
 			ConcurrentRequestsDebug:  p.rawConfig.ConcurrentRequestsDebug,
 			WebSocketReadLimit:       p.rawConfig.WebSocketReadLimit,
+			RateLimit:                p.generateRateLimitConfig(),
 		},
 		GRPCAddr:   p.grpcAddress,
 		LibP2PAddr: p.libp2pAddress,
@@ -168,27 +242,80 @@ func (p *serverParams) generateConfig() *server.Config {
 		Network: &network.Config{
 			NoDiscover:       p.rawConfig.Network.NoDiscover,
 			Addr:             p.libp2pAddress,
+			ListenAddrs:      p.libp2pListenAddrs,
+			QUIC:             p.rawConfig.Network.Libp2pQUIC,
+			Security:         p.rawConfig.Network.Libp2pSecurity,
 			NatAddr:          p.natAddress,
 			DNS:              p.dnsAddress,
 			DataDir:          p.rawConfig.DataDir,
 			MaxPeers:         p.rawConfig.Network.MaxPeers,
 			MaxInboundPeers:  p.rawConfig.Network.MaxInboundPeers,
 			MaxOutboundPeers: p.rawConfig.Network.MaxOutboundPeers,
+			StaticPeers:      p.rawConfig.Network.StaticPeers,
+			TrustedPeers:     p.rawConfig.Network.TrustedPeers,
+			SentryNodes:      p.rawConfig.Network.SentryNodes,
+			PrivatePeers:     p.rawConfig.Network.PrivatePeers,
 			Chain:            p.genesisConfig,
 		},
 		DataDir:            p.rawConfig.DataDir,
+		LogIndex:           p.rawConfig.LogIndex,
+		Preimages:          p.rawConfig.Preimages,
 		Seal:               p.rawConfig.ShouldSeal,
 		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
 		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
 		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
 		SecretsManager:     p.secretsConfig,
+		RemoteSignerURL:    p.rawConfig.RemoteSignerURL,
 		RestoreFile:        p.getRestoreFilePath(),
 		LogLevel:           hclog.LevelFromString(p.rawConfig.LogLevel),
 		JSONLogFormat:      p.rawConfig.JSONLogFormat,
 		LogFilePath:        p.logFileLocation,
+		Light:              p.rawConfig.Light,
+		Checkpoint:         p.checkpoint,
+		ForkURL:            p.forkURL,
+		ForkBlock:          p.getForkBlock(),
 
 		Relayer:                    p.relayer,
 		NumBlockConfirmations:      p.rawConfig.NumBlockConfirmations,
 		RelayerTrackerPollInterval: p.rawConfig.RelayerTrackerPollInterval,
+
+		Health: &health.Config{
+			MaxSyncLag:          p.rawConfig.Health.MaxSyncLag,
+			MinPeers:            p.rawConfig.Health.MinPeers,
+			MaxHeadAge:          p.rawConfig.Health.MaxHeadAge,
+			ParticipationWindow: p.rawConfig.Health.ParticipationWindow,
+			MinParticipation:    p.rawConfig.Health.MinParticipation,
+		},
+
+		Tracing: &tracing.Config{
+			Exporter:    p.rawConfig.Tracing.Exporter,
+			Endpoint:    p.rawConfig.Tracing.Endpoint,
+			Insecure:    !p.rawConfig.Tracing.TLS,
+			SampleRatio: p.rawConfig.Tracing.SampleRatio,
+		},
+
+		BlockSink: &blocksink.Config{
+			Type:       blocksink.SinkType(p.rawConfig.BlockSink.Type),
+			Target:     p.rawConfig.BlockSink.Target,
+			ReplayFrom: p.blockSinkReplayFrom,
+			Traces:     p.rawConfig.BlockSink.Traces,
+		},
+	}
+}
+
+// generateRateLimitConfig returns the JSON-RPC rate limiting config, or nil if rate limiting is disabled
+func (p *serverParams) generateRateLimitConfig() *jsonrpc.RateLimitConfig {
+	rateLimit := p.rawConfig.JSONRPCRateLimit
+	if rateLimit == nil || rateLimit.RequestsPerSecond == 0 {
+		return nil
+	}
+
+	return &jsonrpc.RateLimitConfig{
+		RequestsPerSecond: rateLimit.RequestsPerSecond,
+		Burst:             rateLimit.Burst,
+		APIKeyHeader:      rateLimit.APIKeyHeader,
+		APIKeys:           rateLimit.APIKeys,
+		MethodCosts:       rateLimit.MethodCosts,
+		LogsBlocksPerCost: rateLimit.LogsBlocksPerCost,
 	}
 }
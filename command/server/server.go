@@ -3,10 +3,13 @@ package server
 import (
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/blocksink"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
 	"github.com/0xPolygon/polygon-edge/command/server/export"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/spf13/cobra"
 )
@@ -74,6 +77,31 @@ func setFlags(cmd *cobra.Command) {
 		"the address and port for the libp2p service",
 	)
 
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.Libp2pListen,
+		libp2pListenFlag,
+		[]string{},
+		"the additional multiaddrs the libp2p service listens on, e.g. /ip4/0.0.0.0/udp/1479/quic-v1",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Network.Libp2pQUIC,
+		libp2pQUICFlag,
+		defaultConfig.Network.Libp2pQUIC,
+		"listen on QUIC at the libp2p address port, besides TCP",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Network.Libp2pSecurity,
+		libp2pSecurityFlag,
+		defaultConfig.Network.Libp2pSecurity,
+		fmt.Sprintf(
+			"the preferred security protocol of the libp2p TCP connections (%s or %s)",
+			network.SecurityNoise,
+			network.SecurityTLS,
+		),
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.Telemetry.PrometheusAddr,
 		prometheusAddressFlag,
@@ -111,6 +139,15 @@ func setFlags(cmd *cobra.Command) {
 			"If omitted, the local FS secrets manager is used",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.RemoteSignerURL,
+		remoteSignerURLFlag,
+		"",
+		"the URL of the remote signer holding the validator keys. "+
+			"If set, the validator keys are not loaded from the SecretsManager. "+
+			"Not supported by the IBFT BLS validators",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.RestoreFile,
 		restoreFlag,
@@ -161,6 +198,37 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flag(maxOutboundPeersFlag).DefValue = fmt.Sprintf("%d", defaultConfig.Network.MaxOutboundPeers)
 	cmd.MarkFlagsMutuallyExclusive(maxPeersFlag, maxOutboundPeersFlag)
 
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.StaticPeers,
+		staticPeersFlag,
+		[]string{},
+		"the libp2p addresses of the peers which are always kept connected, "+
+			"regardless of the max peers limits",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.TrustedPeers,
+		trustedPeersFlag,
+		[]string{},
+		"the libp2p node IDs of the peers which are exempt from the max peers limits and can not be banned",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.SentryNodes,
+		sentryNodesFlag,
+		[]string{},
+		"the libp2p addresses of the sentry nodes. If set, the node runs as a private validator "+
+			"which connects to the sentry nodes only, with the peer discovery turned off",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.PrivatePeers,
+		privatePeersFlag,
+		[]string{},
+		"the libp2p node IDs of the private validators behind this sentry node, "+
+			"which are never advertised to the other peers",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.rawConfig.TxPool.PriceLimit,
 		priceLimitFlag,
@@ -207,6 +275,47 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.LogIndex,
+		logIndexFlag,
+		defaultConfig.LogIndex,
+		"maintain the index of log addresses and topics, used to speed up eth_getLogs "+
+			"(existing blocks are indexed in the background)",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Preimages,
+		preimagesFlag,
+		defaultConfig.Preimages,
+		"record the addresses and the storage keys of the state, used to dump the state "+
+			"(the chain has to be synced from the genesis with it)",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCIndexedRangeLimit,
+		jsonRPCIndexedRangeLimitFlag,
+		defaultConfig.JSONRPCIndexedRangeLimit,
+		"max block range to be considered when executing json-rpc requests "+
+			"that consider fromBlock/toBlock values (e.g. eth_getLogs) if the log index is enabled, "+
+			"value of 0 disables it",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCIPCPath,
+		jsonRPCIPCPathFlag,
+		defaultConfig.JSONRPCIPCPath,
+		"the path of the unix domain socket (named pipe on windows) serving json-rpc requests, "+
+			"if omitted json-rpc is not served over ipc",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCAdmin,
+		jsonRPCAdminFlag,
+		defaultConfig.JSONRPCAdmin,
+		"serve the admin_* json-rpc methods controlling the node at runtime, "+
+			"they should be enabled only if the json-rpc endpoint is not publicly reachable",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -214,6 +323,23 @@ func setFlags(cmd *cobra.Command) {
 		"write all logs to the file at specified location instead of writing them to console",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Light,
+		lightFlag,
+		defaultConfig.Light,
+		"run as a light node, which syncs and verifies the block headers only, "+
+			"and fetches the block bodies, receipts and state from the full peers on demand",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.CheckpointSync,
+		checkpointSyncFlag,
+		defaultConfig.CheckpointSync,
+		"the trusted block in the <number>:<hash> format the chain has to contain. "+
+			"The empty chain is started from it, if the consensus supports it (IBFT PoA), "+
+			"otherwise the peers whose chain does not contain it are not synced from",
+	)
+
 	cmd.Flags().BoolVar(
 		&params.rawConfig.Relayer,
 		relayerFlag,
@@ -242,6 +368,139 @@ func setFlags(cmd *cobra.Command) {
 		"maximum size in bytes for a message read from the peer by websocket",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCRateLimit.RequestsPerSecond,
+		jsonRPCRateLimitFlag,
+		defaultConfig.JSONRPCRateLimit.RequestsPerSecond,
+		"number of json-rpc request cost units refilled per second for each client, value of 0 disables rate limiting",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCRateLimit.Burst,
+		jsonRPCRateLimitBurstFlag,
+		defaultConfig.JSONRPCRateLimit.Burst,
+		"maximum number of json-rpc request cost units a client can spend at once "+
+			"(defaults to the json-rpc rate limit)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCRateLimit.APIKeyHeader,
+		jsonRPCRateLimitAPIKeyHeaderFlag,
+		defaultConfig.JSONRPCRateLimit.APIKeyHeader,
+		"HTTP header identifying json-rpc clients by API key for rate limiting. "+
+			"If omitted or missing in the request, clients are identified by IP address",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.JSONRPCRateLimit.APIKeys,
+		jsonRPCRateLimitAPIKeysFlag,
+		defaultConfig.JSONRPCRateLimit.APIKeys,
+		"API keys trusted to identify json-rpc clients for rate limiting. "+
+			"Clients presenting any other key are identified by IP address",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCRateLimit.LogsBlocksPerCost,
+		jsonRPCRateLimitLogsBlocksFlag,
+		defaultConfig.JSONRPCRateLimit.LogsBlocksPerCost,
+		"number of blocks queried by eth_getLogs charged as one additional rate limiting cost unit",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Health.MaxSyncLag,
+		healthMaxSyncLagFlag,
+		defaultConfig.Health.MaxSyncLag,
+		"number of blocks the node can be behind the sync target and still be ready",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Health.MinPeers,
+		healthMinPeersFlag,
+		defaultConfig.Health.MinPeers,
+		"minimal number of connected peers of the ready node",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Health.MaxHeadAge,
+		healthMaxHeadAgeFlag,
+		defaultConfig.Health.MaxHeadAge,
+		"maximal age of the head block in block times, the node with an older head is not ready. "+
+			"The liveness does not depend on it, so the halted chain does not restart its nodes. "+
+			"Value of 0 disables the check",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Health.ParticipationWindow,
+		healthParticipationWindowFlag,
+		defaultConfig.Health.ParticipationWindow,
+		"number of the latest blocks in which the participation of the validator is checked "+
+			"by the readiness check, value of 0 disables the check",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.Health.MinParticipation,
+		healthMinParticipationFlag,
+		defaultConfig.Health.MinParticipation,
+		"minimal number of blocks in the participation window the validator has to sign to be ready",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Tracing.Exporter,
+		tracingExporterFlag,
+		defaultConfig.Tracing.Exporter,
+		fmt.Sprintf("exporter of the OpenTelemetry spans (%s, %s)", tracing.ExporterNone, tracing.ExporterOTLP),
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Tracing.Endpoint,
+		tracingEndpointFlag,
+		defaultConfig.Tracing.Endpoint,
+		"address of the OpenTelemetry collector receiving the spans over OTLP/gRPC",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Tracing.TLS,
+		tracingTLSFlag,
+		defaultConfig.Tracing.TLS,
+		"connect to the OpenTelemetry collector over TLS",
+	)
+
+	cmd.Flags().Float64Var(
+		&params.rawConfig.Tracing.SampleRatio,
+		tracingSampleRatioFlag,
+		defaultConfig.Tracing.SampleRatio,
+		"ratio of the sampled traces, from 0 to 1",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.BlockSink.Type,
+		blockSinkFlag,
+		defaultConfig.BlockSink.Type,
+		fmt.Sprintf("sink the finalized blocks are published to (%s, %s, %s)",
+			blocksink.None, blocksink.Stdout, blocksink.File),
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.BlockSink.Target,
+		blockSinkTargetFlag,
+		defaultConfig.BlockSink.Target,
+		"target of the block sink, i.e. the path of the file the blocks are appended to",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.BlockSink.ReplayFrom,
+		blockSinkReplayFromFlag,
+		defaultConfig.BlockSink.ReplayFrom,
+		"block the sink is replayed from, otherwise it resumes after the last delivered block",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.BlockSink.Traces,
+		blockSinkTracesFlag,
+		defaultConfig.BlockSink.Traces,
+		"publish the call traces of the transactions to the block sink",
+	)
+
 	cmd.Flags().DurationVar(
 		&params.rawConfig.RelayerTrackerPollInterval,
 		relayerTrackerPollIntervalFlag,
@@ -286,6 +545,34 @@ func setDevFlags(cmd *cobra.Command) {
 	)
 
 	_ = cmd.Flags().MarkHidden(devIntervalFlag)
+
+	cmd.Flags().BoolVar(
+		&params.devOnDemand,
+		devOnDemandFlag,
+		false,
+		"should the dev consensus seal a block as soon as a transaction arrives, instead of every interval",
+	)
+
+	_ = cmd.Flags().MarkHidden(devOnDemandFlag)
+
+	cmd.Flags().StringVar(
+		&params.forkURL,
+		forkURLFlag,
+		"",
+		"the JSON-RPC endpoint of the chain the dev mode forks. "+
+			"The accounts and storage missing locally are read from the forked block",
+	)
+
+	_ = cmd.Flags().MarkHidden(forkURLFlag)
+
+	cmd.Flags().Int64Var(
+		&params.forkBlock,
+		forkBlockFlag,
+		-1,
+		"the number of the forked block, the latest one if negative",
+	)
+
+	_ = cmd.Flags().MarkHidden(forkBlockFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -0,0 +1,181 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/archive"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	outFlag     = "out"
+	numberFlag  = "number"
+	blocksFlag  = "blocks"
+	dataDirFlag = "data-dir"
+)
+
+var (
+	params = &exportParams{}
+)
+
+var (
+	errInvalidBlocks = errors.New("the state snapshot has to include at least one block")
+)
+
+type exportParams struct {
+	out     string
+	number  uint64
+	blocks  uint64
+	dataDir string
+
+	header *archive.SnapshotHeader
+}
+
+func (p *exportParams) validateFlags() error {
+	if p.blocks == 0 {
+		return errInvalidBlocks
+	}
+
+	return nil
+}
+
+func (p *exportParams) getRequiredFlags() []string {
+	return []string{
+		outFlag,
+	}
+}
+
+func (p *exportParams) exportSnapshot(grpcAddress string) error {
+	// always create new file, throw error if the file exists
+	out, err := os.OpenFile(p.out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriterSize(out, 1024*1024)
+
+	if p.dataDir != "" {
+		err = p.exportFromDataDir(writer)
+	} else {
+		err = p.exportFromNode(grpcAddress, writer)
+	}
+
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(p.out)
+
+		return err
+	}
+
+	if p.header == nil {
+		return p.readHeader()
+	}
+
+	return nil
+}
+
+// exportFromDataDir reads the state snapshot from the data directory of the stopped node
+func (p *exportParams) exportFromDataDir(out io.Writer) error {
+	logger := hclog.NewNullLogger()
+
+	for _, dir := range []string{"blockchain", "trie"} {
+		if _, err := os.Stat(filepath.Join(p.dataDir, dir)); err != nil {
+			return fmt.Errorf("invalid data directory: %w", err)
+		}
+	}
+
+	db, err := leveldb.NewLevelDBStorage(filepath.Join(p.dataDir, "blockchain"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to open the blockchain storage, is the node stopped? %w", err)
+	}
+
+	defer db.Close()
+
+	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(p.dataDir, "trie"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to open the state storage, is the node stopped? %w", err)
+	}
+
+	defer stateStorage.Close()
+
+	p.header, err = archive.ExportSnapshot(archive.NewStorageSnapshotChain(db), stateStorage, p.number, p.blocks, out)
+
+	return err
+}
+
+// exportFromNode fetches the state snapshot from the running node
+func (p *exportParams) exportFromNode(grpcAddress string, out io.Writer) error {
+	connection, err := helper.GetGRPCConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	defer connection.Close()
+
+	stream, err := proto.NewSystemClient(connection).ExportSnapshot(
+		context.Background(),
+		&proto.ExportSnapshotRequest{
+			Number: p.number,
+			Blocks: p.blocks,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := out.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// readHeader reads the header of the written state snapshot
+func (p *exportParams) readHeader() error {
+	in, err := os.Open(p.out)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close()
+
+	p.header, err = archive.ReadSnapshotHeader(in)
+
+	return err
+}
+
+func (p *exportParams) getResult() command.CommandResult {
+	return &SnapshotExportResult{
+		Out:       p.out,
+		Number:    p.header.Number,
+		Hash:      p.header.Hash.String(),
+		StateRoot: p.header.StateRoot.String(),
+	}
+}
@@ -0,0 +1,73 @@
+package export
+
+import (
+	"github.com/0xPolygon/polygon-edge/archive"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	snapshotExportCmd := &cobra.Command{
+		Use: "export",
+		Short: "Exports the state snapshot at the given block, together with the recent blocks. " +
+			"The snapshot is fetched from the running node, or read from the data directory of a stopped node",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	helper.RegisterGRPCAddressFlag(snapshotExportCmd)
+
+	setFlags(snapshotExportCmd)
+	helper.SetRequiredFlags(snapshotExportCmd, params.getRequiredFlags())
+
+	return snapshotExportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.out,
+		outFlag,
+		"",
+		"the export path for the state snapshot",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.number,
+		numberFlag,
+		0,
+		"the block the state snapshot is taken at, the latest block if not set",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.blocks,
+		blocksFlag,
+		archive.DefaultSnapshotBlocks,
+		"the number of the recent blocks included in the state snapshot",
+	)
+
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the stopped node to read the state snapshot from, "+
+			"instead of fetching it from the running node",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.exportSnapshot(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
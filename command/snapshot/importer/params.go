@@ -0,0 +1,74 @@
+package importer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/archive"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/command"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	fileFlag    = "file"
+	dataDirFlag = "data-dir"
+)
+
+var (
+	params = &importParams{}
+)
+
+type importParams struct {
+	file    string
+	dataDir string
+
+	header *archive.SnapshotHeader
+}
+
+func (p *importParams) getRequiredFlags() []string {
+	return []string{
+		fileFlag,
+		dataDirFlag,
+	}
+}
+
+func (p *importParams) importSnapshot() error {
+	in, err := os.Open(p.file)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close()
+
+	logger := hclog.NewNullLogger()
+
+	db, err := leveldb.NewLevelDBStorage(filepath.Join(p.dataDir, "blockchain"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to open the blockchain storage, is the node stopped? %w", err)
+	}
+
+	defer db.Close()
+
+	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(p.dataDir, "trie"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to open the state storage, is the node stopped? %w", err)
+	}
+
+	defer stateStorage.Close()
+
+	p.header, err = archive.ImportSnapshot(in, db, stateStorage)
+
+	return err
+}
+
+func (p *importParams) getResult() command.CommandResult {
+	return &SnapshotImportResult{
+		Number:    p.header.Number,
+		Hash:      p.header.Hash.String(),
+		StateRoot: p.header.StateRoot.String(),
+		Genesis:   p.header.Genesis.String(),
+	}
+}
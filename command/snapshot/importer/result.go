@@ -0,0 +1,31 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type SnapshotImportResult struct {
+	Number    uint64 `json:"number"`
+	Hash      string `json:"hash"`
+	StateRoot string `json:"state_root"`
+	Genesis   string `json:"genesis"`
+}
+
+func (r *SnapshotImportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SNAPSHOT IMPORT]\n")
+	buffer.WriteString("Imported state snapshot successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Block|%d", r.Number),
+		fmt.Sprintf("Hash|%s", r.Hash),
+		fmt.Sprintf("State root|%s", r.StateRoot),
+		fmt.Sprintf("Genesis|%s", r.Genesis),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,51 @@
+package importer
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	snapshotImportCmd := &cobra.Command{
+		Use: "import",
+		Short: "Imports the state snapshot into the data directory of a new node, which starts at the snapshot block. " +
+			"The node has to be stopped and started with the genesis file of the snapshot chain. " +
+			"The data of the consensus engines kept outside of the chain (i.e. polybft) is not included",
+		Run: runCommand,
+	}
+
+	setFlags(snapshotImportCmd)
+	helper.SetRequiredFlags(snapshotImportCmd, params.getRequiredFlags())
+
+	return snapshotImportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.file,
+		fileFlag,
+		"",
+		"the path of the state snapshot",
+	)
+
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.importSnapshot(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,28 @@
+package snapshot
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/snapshot/export"
+	"github.com/0xPolygon/polygon-edge/command/snapshot/importer"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	snapshotCmd := &cobra.Command{
+		Use: "snapshot",
+		Short: "Top level command for the state snapshots, which bootstrap a node at the snapshot block " +
+			"without replaying the chain. Only accepts subcommands.",
+	}
+
+	registerSubcommands(snapshotCmd)
+
+	return snapshotCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// snapshot export
+		export.GetCommand(),
+		// snapshot import
+		importer.GetCommand(),
+	)
+}
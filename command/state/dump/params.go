@@ -0,0 +1,200 @@
+package dump
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/archive"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	outFlag     = "out"
+	numberFlag  = "number"
+	formatFlag  = "format"
+	dataDirFlag = "data-dir"
+)
+
+var (
+	params = &dumpParams{}
+)
+
+var (
+	errInvalidFormat = errors.New("the format of the state dump has to be json or rlp")
+	errNoSummary     = errors.New("the node did not report the summary of the state dump")
+)
+
+type dumpParams struct {
+	out     string
+	number  uint64
+	latest  bool
+	format  string
+	dataDir string
+
+	summary *proto.StateDumpSummary
+}
+
+func (p *dumpParams) validateFlags() error {
+	if f := chain.AllocFormat(p.format); f != chain.AllocFormatJSON && f != chain.AllocFormatRLP {
+		return errInvalidFormat
+	}
+
+	return nil
+}
+
+func (p *dumpParams) getRequiredFlags() []string {
+	return []string{
+		outFlag,
+	}
+}
+
+func (p *dumpParams) dumpState(grpcAddress string) error {
+	// always create new file, throw error if the file exists
+	out, err := os.OpenFile(p.out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriterSize(out, 1024*1024)
+
+	if p.dataDir != "" {
+		err = p.dumpFromDataDir(writer)
+	} else {
+		err = p.dumpFromNode(grpcAddress, writer)
+	}
+
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(p.out)
+
+		return err
+	}
+
+	return nil
+}
+
+// dumpFromDataDir reads the state from the data directory of the stopped node
+func (p *dumpParams) dumpFromDataDir(out io.Writer) error {
+	logger := hclog.NewNullLogger()
+
+	for _, dir := range []string{"blockchain", "trie"} {
+		if _, err := os.Stat(filepath.Join(p.dataDir, dir)); err != nil {
+			return fmt.Errorf("invalid data directory: %w", err)
+		}
+	}
+
+	db, err := leveldb.NewLevelDBStorage(filepath.Join(p.dataDir, "blockchain"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to open the blockchain storage, is the node stopped? %w", err)
+	}
+
+	defer db.Close()
+
+	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(p.dataDir, "trie"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to open the state storage, is the node stopped? %w", err)
+	}
+
+	defer stateStorage.Close()
+
+	var number *uint64
+	if !p.latest {
+		number = &p.number
+	}
+
+	dump, err := archive.DumpState(
+		archive.NewStorageSnapshotChain(db),
+		stateStorage,
+		number,
+		chain.AllocFormat(p.format),
+		out,
+	)
+	if err != nil {
+		return err
+	}
+
+	p.summary = &proto.StateDumpSummary{
+		Number:    dump.Number,
+		Hash:      dump.Hash.String(),
+		StateRoot: dump.StateRoot.String(),
+		Accounts:  dump.Accounts,
+	}
+
+	return nil
+}
+
+// dumpFromNode fetches the state dump from the running node
+func (p *dumpParams) dumpFromNode(grpcAddress string, out io.Writer) error {
+	connection, err := helper.GetGRPCConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	defer connection.Close()
+
+	stream, err := proto.NewSystemClient(connection).DumpState(
+		context.Background(),
+		&proto.DumpStateRequest{
+			Latest: p.latest,
+			Number: p.number,
+			Format: p.format,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if chunk.Summary != nil {
+			p.summary = chunk.Summary
+		}
+
+		if _, err := out.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+
+	if p.summary == nil {
+		return errNoSummary
+	}
+
+	return nil
+}
+
+func (p *dumpParams) getResult() command.CommandResult {
+	return &StateDumpResult{
+		Out:       p.out,
+		Format:    p.format,
+		Number:    p.summary.Number,
+		Hash:      p.summary.Hash,
+		StateRoot: p.summary.StateRoot,
+		Accounts:  p.summary.Accounts,
+	}
+}
@@ -0,0 +1,35 @@
+package dump
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type StateDumpResult struct {
+	Out       string `json:"out"`
+	Format    string `json:"format"`
+	Number    uint64 `json:"number"`
+	Hash      string `json:"hash"`
+	StateRoot string `json:"state_root"`
+	Accounts  uint64 `json:"accounts"`
+}
+
+func (r *StateDumpResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[STATE DUMP]\n")
+	buffer.WriteString("Dumped state successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("File|%s", r.Out),
+		fmt.Sprintf("Format|%s", r.Format),
+		fmt.Sprintf("Block|%d", r.Number),
+		fmt.Sprintf("Hash|%s", r.Hash),
+		fmt.Sprintf("State root|%s", r.StateRoot),
+		fmt.Sprintf("Accounts|%d", r.Accounts),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
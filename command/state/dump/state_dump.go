@@ -0,0 +1,77 @@
+package dump
+
+import (
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	stateDumpCmd := &cobra.Command{
+		Use: "dump",
+		Short: "Dumps the accounts of the state at the given block, with their balances, nonces, code and storage, " +
+			"in the alloc format accepted by the genesis command. The state is fetched from the running node, " +
+			"or read from the data directory of a stopped node. The node has to be synced from the genesis " +
+			"with the --preimages flag",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	helper.RegisterGRPCAddressFlag(stateDumpCmd)
+
+	setFlags(stateDumpCmd)
+	helper.SetRequiredFlags(stateDumpCmd, params.getRequiredFlags())
+
+	return stateDumpCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.out,
+		outFlag,
+		"",
+		"the path of the state dump",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.number,
+		numberFlag,
+		0,
+		"the block the state is dumped at, the latest block if not set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.format,
+		formatFlag,
+		string(chain.AllocFormatJSON),
+		"the format of the state dump (json or rlp)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the stopped node to read the state from, "+
+			"instead of fetching it from the running node",
+	)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.latest = !cmd.Flags().Changed(numberFlag)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.dumpState(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
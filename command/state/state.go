@@ -0,0 +1,24 @@
+package state
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/state/dump"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	stateCmd := &cobra.Command{
+		Use:   "state",
+		Short: "Top level command for inspecting the state of the chain. Only accepts subcommands.",
+	}
+
+	registerSubcommands(stateCmd)
+
+	return stateCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// state dump
+		dump.GetCommand(),
+	)
+}
@@ -8,11 +8,13 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/remotesigner"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/grpc"
 )
 
@@ -53,6 +55,38 @@ type Consensus interface {
 	Close() error
 }
 
+// SyncPeerHeadProvider is implemented by the consensus mechanisms syncing the blocks from the peers
+type SyncPeerHeadProvider interface {
+	// GetSyncPeerHead returns the latest block number reported by the peer, if known
+	GetSyncPeerHead(peerID peer.ID) (uint64, bool)
+}
+
+// BlockProductionController is implemented by the consensus mechanisms
+// whose block production can be paused by the operator
+type BlockProductionController interface {
+	// SetBlockProductionPaused pauses or resumes the block production of the node
+	SetBlockProductionPaused(paused bool)
+
+	// IsBlockProductionPaused returns true if the block production of the node is paused
+	IsBlockProductionPaused() bool
+}
+
+// ParticipationReporter is implemented by the consensus mechanisms
+// which can report the participation of the node in the block finalization
+type ParticipationReporter interface {
+	// ValidatorParticipation returns how many of the given number of the latest blocks
+	// were finalized while the node was a validator, and how many of them the node signed
+	ValidatorParticipation(blocks uint64) (validated uint64, signed uint64, err error)
+}
+
+// CheckpointBootstrapper is implemented by the consensus mechanisms
+// which can start the empty chain from the checkpoint instead of the genesis
+type CheckpointBootstrapper interface {
+	// CheckpointHeadersFrom returns the number of the first header the chain has to be started from,
+	// to verify the blocks after the checkpoint with the given number
+	CheckpointHeadersFrom(number uint64) (uint64, error)
+}
+
 // Config is the configuration for the consensus
 type Config struct {
 	// Logger to be used by the consensus
@@ -80,6 +114,9 @@ type Params struct {
 	SecretsManager secrets.SecretsManager
 	BlockTime      uint64
 
+	// RemoteSigner signs with the validator keys held outside of the node process, if set
+	RemoteSigner *remotesigner.Client
+
 	NumBlockConfirmations uint64
 }
 
@@ -0,0 +1,305 @@
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errTimestampTooLow = errors.New("timestamp has to be greater than the latest block timestamp")
+	errNotImpersonated = errors.New("account is not impersonated")
+	errTxNotSealed     = errors.New("transaction was not sealed")
+)
+
+// snapshot is the chain state the dev controls can revert to
+type snapshot struct {
+	number     uint64
+	timeOffset int64
+}
+
+// blockOverride is the state override applied at the end of the block with the given number
+type blockOverride struct {
+	number   uint64
+	override types.StateOverride
+}
+
+// Mine seals a new block with the pending transactions, at the given timestamp if it is set,
+// and returns its header
+func (d *Dev) Mine(timestamp *uint64) (*types.Header, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if timestamp != nil {
+		if err := d.setNextBlockTimestamp(*timestamp); err != nil {
+			return nil, err
+		}
+	}
+
+	return d.seal(nil)
+}
+
+// SetAutomine switches the on-demand mode, where a block is sealed as soon as a transaction arrives.
+// Otherwise the blocks are sealed every interval
+func (d *Dev) SetAutomine(enabled bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.onDemand = enabled
+}
+
+// SetBlockProductionPaused pauses or resumes sealing the blocks
+func (d *Dev) SetBlockProductionPaused(paused bool) {
+	if d.paused.Swap(paused) != paused {
+		d.logger.Info("block production changed", "paused", paused)
+	}
+}
+
+// IsBlockProductionPaused returns true if the blocks are not sealed
+func (d *Dev) IsBlockProductionPaused() bool {
+	return d.paused.Load()
+}
+
+func (d *Dev) isOnDemand() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.onDemand
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block.
+// The following blocks keep the distance from it
+func (d *Dev) SetNextBlockTimestamp(timestamp uint64) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.setNextBlockTimestamp(timestamp)
+}
+
+func (d *Dev) setNextBlockTimestamp(timestamp uint64) error {
+	if latest := d.blockchain.Header().Timestamp; timestamp <= latest {
+		return fmt.Errorf("%w: %d <= %d", errTimestampTooLow, timestamp, latest)
+	}
+
+	d.nextTimestamp = &timestamp
+
+	return nil
+}
+
+// IncreaseTime moves the clock of the following blocks forward
+// and returns the total time offset in seconds
+func (d *Dev) IncreaseTime(seconds uint64) int64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.timeOffset += int64(seconds)
+
+	return d.timeOffset
+}
+
+// blockTimestamp returns the timestamp of the next block, which is never lower than the parent's one.
+// The caller has to hold the lock
+func (d *Dev) blockTimestamp(parent *types.Header, now int64) uint64 {
+	if d.nextTimestamp != nil {
+		return *d.nextTimestamp
+	}
+
+	timestamp := now + d.timeOffset
+	if timestamp < int64(parent.Timestamp) {
+		return parent.Timestamp
+	}
+
+	return uint64(timestamp)
+}
+
+// Snapshot saves the current chain head and returns the id to revert to it
+func (d *Dev) Snapshot() uint64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.lastSnapshotID++
+	d.snapshots[d.lastSnapshotID] = snapshot{
+		number:     d.blockchain.Header().Number,
+		timeOffset: d.timeOffset,
+	}
+
+	return d.lastSnapshotID
+}
+
+// Revert moves the chain head back to the given snapshot and drops the pending transactions.
+// The snapshot and the ones taken after it can not be used again.
+// Returns false if the snapshot does not exist
+func (d *Dev) Revert(id uint64) (bool, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	snapshot, ok := d.snapshots[id]
+	if !ok {
+		return false, nil
+	}
+
+	if err := d.blockchain.RewindHead(snapshot.number); err != nil {
+		return false, err
+	}
+
+	for snapshotID := range d.snapshots {
+		if snapshotID >= id {
+			delete(d.snapshots, snapshotID)
+		}
+	}
+
+	d.timeOffset = snapshot.timeOffset
+	d.nextTimestamp = nil
+	d.impersonatedTxs = nil
+
+	d.txpool.Flush()
+
+	d.logger.Info("reverted to snapshot", "id", id, "number", snapshot.number)
+
+	return true, nil
+}
+
+// SetStateOverride changes the accounts' state. The state is derived from the block headers,
+// so the changes are sealed right away in a new block, after its transactions
+func (d *Dev) SetStateOverride(override types.StateOverride) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	_, err := d.seal(override)
+
+	return err
+}
+
+// applyStateOverride applies the state override of the block being sealed
+func (d *Dev) applyStateOverride(number uint64, transition *state.Transition) error {
+	if sealing := d.sealingOverride.Load(); sealing != nil && sealing.number == number {
+		return transition.WithStateOverride(sealing.override)
+	}
+
+	return nil
+}
+
+// ImpersonateAccount allows sending unsigned transactions on behalf of the account
+func (d *Dev) ImpersonateAccount(addr types.Address) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.impersonated[addr] = struct{}{}
+}
+
+// StopImpersonatingAccount stops the account impersonation
+func (d *Dev) StopImpersonatingAccount(addr types.Address) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	delete(d.impersonated, addr)
+}
+
+// IsImpersonated returns true if the account is impersonated
+func (d *Dev) IsImpersonated(addr types.Address) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	_, ok := d.impersonated[addr]
+
+	return ok
+}
+
+// PendingNonce returns the next nonce of the account, including its pending impersonated transactions
+func (d *Dev) PendingNonce(addr types.Address) uint64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	nonce := d.txpool.GetNonce(addr)
+
+	for _, tx := range d.impersonatedTxs {
+		if tx.From == addr && tx.Nonce >= nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+
+	return nonce
+}
+
+// AddImpersonatedTx adds the unsigned transaction of an impersonated account.
+// Such transactions bypass the pool and are sealed in the next block,
+// which happens right away in the on-demand mode
+func (d *Dev) AddImpersonatedTx(tx *types.Transaction) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if _, ok := d.impersonated[tx.From]; !ok {
+		return fmt.Errorf("%w: %s", errNotImpersonated, tx.From)
+	}
+
+	// the fake signature derived from the sender keeps the hashes
+	// of the same transactions sent by different accounts distinct
+	signature := new(big.Int).SetBytes(tx.From.Bytes())
+
+	tx.V = big.NewInt(0)
+	tx.R = signature
+	tx.S = new(big.Int).Set(signature)
+	tx.ComputeHash()
+
+	d.impersonatedTxs = append(d.impersonatedTxs, tx)
+
+	if !d.onDemand {
+		return nil
+	}
+
+	if _, err := d.seal(nil); err != nil {
+		return err
+	}
+
+	if _, ok := d.blockchain.ReadTxLookup(tx.Hash); !ok {
+		return fmt.Errorf("%w: %s, check the node logs", errTxNotSealed, tx.Hash)
+	}
+
+	return nil
+}
+
+// writeImpersonatedTransactions writes the pending impersonated transactions.
+// The ones failing are dropped. The caller has to hold the lock
+func (d *Dev) writeImpersonatedTransactions(gasLimit uint64, transition transitionInterface) []*types.Transaction {
+	var successful []*types.Transaction
+
+	for i, tx := range d.impersonatedTxs {
+		if tx.Gas > gasLimit {
+			d.logger.Warn("dropped impersonated transaction exceeding the block gas limit", "hash", tx.Hash)
+
+			continue
+		}
+
+		if err := transition.Write(tx); err != nil {
+			if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
+				// the rest goes to the next block
+				d.impersonatedTxs = d.impersonatedTxs[i:]
+
+				return successful
+			}
+
+			d.logger.Warn("dropped impersonated transaction", "hash", tx.Hash, "from", tx.From, "err", err)
+
+			continue
+		}
+
+		successful = append(successful, tx)
+	}
+
+	d.impersonatedTxs = nil
+
+	return successful
+}
+
+// seal writes a new block on top of the chain head and returns its header.
+// The caller has to hold the lock
+func (d *Dev) seal(override types.StateOverride) (*types.Header, error) {
+	if err := d.writeNewBlock(d.blockchain.Header(), override); err != nil {
+		return nil, err
+	}
+
+	return d.blockchain.Header(), nil
+}
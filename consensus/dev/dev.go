@@ -1,35 +1,63 @@
 package dev
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	devConsensus = "dev-consensus"
 )
 
+var spanTracer = tracing.Tracer("dev")
+
 // Dev consensus protocol seals any new transaction immediately
 type Dev struct {
 	logger hclog.Logger
 
-	notifyCh chan struct{}
-	closeCh  chan struct{}
+	closeCh chan struct{}
 
 	interval uint64
 	txpool   *txpool.TxPool
 
 	blockchain *blockchain.Blockchain
 	executor   *state.Executor
+
+	// lock serializes the sealing of the blocks and guards the dev controls below
+	lock sync.Mutex
+
+	onDemand bool // seal a block as soon as a transaction arrives, instead of every interval
+
+	nextTimestamp *uint64 // timestamp of the next block, if set explicitly
+	timeOffset    int64   // seconds added to the clock for the block timestamps
+
+	snapshots      map[uint64]snapshot
+	lastSnapshotID uint64
+
+	impersonated    map[types.Address]struct{}
+	impersonatedTxs []*types.Transaction // sent by the impersonated accounts, sealed in the next block
+
+	// the state override of the block being sealed, applied again when the block is verified
+	sealingOverride atomic.Pointer[blockOverride]
+
+	// paused stops sealing the blocks every interval or on demand, they can still be mined explicitly
+	paused atomic.Bool
 }
 
 // Factory implements the base factory method
@@ -39,12 +67,14 @@ func Factory(
 	logger := params.Logger.Named("dev")
 
 	d := &Dev{
-		logger:     logger,
-		notifyCh:   make(chan struct{}),
-		closeCh:    make(chan struct{}),
-		blockchain: params.Blockchain,
-		executor:   params.Executor,
-		txpool:     params.TxPool,
+		logger:       logger,
+		closeCh:      make(chan struct{}),
+		interval:     1,
+		blockchain:   params.Blockchain,
+		executor:     params.Executor,
+		txpool:       params.TxPool,
+		snapshots:    make(map[uint64]snapshot),
+		impersonated: make(map[types.Address]struct{}),
 	}
 
 	rawInterval, ok := params.Config.Config["interval"]
@@ -54,7 +84,19 @@ func Factory(
 			return nil, fmt.Errorf("interval expected int")
 		}
 
-		d.interval = interval
+		if interval != 0 {
+			d.interval = interval
+		}
+	}
+
+	rawOnDemand, ok := params.Config.Config["onDemand"]
+	if ok {
+		onDemand, ok := rawOnDemand.(bool)
+		if !ok {
+			return nil, fmt.Errorf("onDemand expected bool")
+		}
+
+		d.onDemand = onDemand
 	}
 
 	return d, nil
@@ -69,38 +111,54 @@ func (d *Dev) Initialize() error {
 
 // Start starts the consensus mechanism
 func (d *Dev) Start() error {
-	go d.run()
+	promotedCh, unsubscribe, err := d.txpool.TxPoolSubscribe(&proto.SubscribeRequest{
+		Types: []proto.EventType{proto.EventType_PROMOTED},
+	})
+	if err != nil {
+		return err
+	}
+
+	go d.run(promotedCh, unsubscribe)
 
 	return nil
 }
 
-func (d *Dev) nextNotify() chan struct{} {
-	if d.interval == 0 {
-		d.interval = 1
-	}
-
-	go func() {
-		<-time.After(time.Duration(d.interval) * time.Second)
-		d.notifyCh <- struct{}{}
-	}()
+func (d *Dev) run(promotedCh <-chan *proto.TxPoolEvent, unsubscribe func()) {
+	defer unsubscribe()
 
-	return d.notifyCh
-}
+	d.logger.Info("consensus started", "interval", d.interval, "on_demand", d.isOnDemand())
 
-func (d *Dev) run() {
-	d.logger.Info("consensus started")
+	ticker := time.NewTicker(time.Duration(d.interval) * time.Second)
+	defer ticker.Stop()
 
 	for {
-		// wait until there is a new txn
 		select {
-		case <-d.nextNotify():
+		case <-ticker.C:
+			if d.isOnDemand() {
+				continue
+			}
+		case _, ok := <-promotedCh:
+			if !ok {
+				// the pool is closed
+				promotedCh = nil
+
+				continue
+			}
+
+			// the transactions promoted together are sealed by the first notification
+			if !d.isOnDemand() || d.txpool.Length() == 0 {
+				continue
+			}
 		case <-d.closeCh:
 			return
 		}
 
+		if d.paused.Load() {
+			continue
+		}
+
 		// There are new transactions in the pool, try to seal them
-		header := d.blockchain.Header()
-		if err := d.writeNewBlock(header); err != nil {
+		if _, err := d.Mine(nil); err != nil {
 			d.logger.Error("failed to mine block", "err", err)
 		}
 	}
@@ -151,15 +209,24 @@ func (d *Dev) writeTransactions(gasLimit uint64, transition transitionInterface)
 }
 
 // writeNewBLock generates a new block based on transactions from the pool,
-// and writes them to the blockchain
-func (d *Dev) writeNewBlock(parent *types.Header) error {
+// and writes them to the blockchain. The state override, if set, is applied after the transactions.
+// The caller has to hold the lock
+func (d *Dev) writeNewBlock(parent *types.Header, override types.StateOverride) (err error) {
+	ctx, span := spanTracer.Start(context.Background(), "dev.write_block",
+		trace.WithAttributes(attribute.Int64("block.number", int64(parent.Number+1))))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	now := time.Now().UTC().Unix()
+
 	// Generate the base block
 	num := parent.Number
 	header := &types.Header{
 		ParentHash: parent.Hash,
 		Number:     num + 1,
 		GasLimit:   parent.GasLimit, // Inherit from parent for now, will need to adjust dynamically later.
-		Timestamp:  uint64(time.Now().UTC().Unix()),
+		Timestamp:  d.blockTimestamp(parent, now),
 	}
 
 	// calculate gas limit based on parent header
@@ -182,10 +249,20 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 		return err
 	}
 
-	txns := d.writeTransactions(gasLimit, transition)
+	txns := d.writeImpersonatedTransactions(gasLimit, transition)
+	txns = append(txns, d.writeTransactions(gasLimit, transition)...)
+
+	if override != nil {
+		d.sealingOverride.Store(&blockOverride{number: header.Number, override: override})
+		defer d.sealingOverride.Store(nil)
+
+		if err := d.applyStateOverride(header.Number, transition); err != nil {
+			return err
+		}
+	}
 
 	// Commit the changes
-	_, root, err := transition.Commit()
+	_, root, err := transition.CommitWithContext(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to commit the state changes: %w", err)
 	}
@@ -202,12 +279,12 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 		Receipts: transition.Receipts(),
 	})
 
-	if _, err := d.blockchain.VerifyFinalizedBlock(block); err != nil {
+	if _, err := d.blockchain.VerifyFinalizedBlockWithContext(ctx, block); err != nil {
 		return err
 	}
 
 	// Write the block to the blockchain
-	if err := d.blockchain.WriteBlock(block, devConsensus); err != nil {
+	if err := d.blockchain.WriteBlockWithContext(ctx, block, devConsensus); err != nil {
 		return err
 	}
 
@@ -215,6 +292,12 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 	// the old transactions are removed
 	d.txpool.ResetWithHeaders(block.Header)
 
+	if d.nextTimestamp != nil {
+		// the following blocks keep the distance from the explicitly set timestamp
+		d.timeOffset = int64(*d.nextTimestamp) - now
+		d.nextTimestamp = nil
+	}
+
 	return nil
 }
 
@@ -234,8 +317,8 @@ func (d *Dev) GetBlockCreator(header *types.Header) (types.Address, error) {
 }
 
 // PreCommitState a hook to be called before finalizing state transition on inserting block
-func (d *Dev) PreCommitState(_ *types.Block, _ *state.Transition) error {
-	return nil
+func (d *Dev) PreCommitState(block *types.Block, txn *state.Transition) error {
+	return d.applyStateOverride(block.Number(), txn)
 }
 
 // GetLatestChainConfig returns the latest chain configuration
@@ -0,0 +1,423 @@
+package dev
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 100
+
+type testTxPoolStore struct {
+	state state.State
+	*blockchain.Blockchain
+}
+
+func (s *testTxPoolStore) GetNonce(root types.Hash, addr types.Address) uint64 {
+	account := getTestAccount(s.state, root, addr)
+	if account == nil {
+		return 0
+	}
+
+	return account.Nonce
+}
+
+func (s *testTxPoolStore) GetBalance(root types.Hash, addr types.Address) (*big.Int, error) {
+	account := getTestAccount(s.state, root, addr)
+	if account == nil {
+		return big.NewInt(0), nil
+	}
+
+	return account.Balance, nil
+}
+
+func getTestAccount(st state.State, root types.Hash, addr types.Address) *state.Account {
+	snap, err := st.NewSnapshotAt(root)
+	if err != nil {
+		return nil
+	}
+
+	account, err := snap.GetAccount(addr)
+	if err != nil {
+		return nil
+	}
+
+	return account
+}
+
+// newTestDev creates the dev consensus sealing the blocks of a real chain,
+// which does not seal blocks by itself during the test
+func newTestDev(t *testing.T, alloc map[types.Address]*chain.GenesisAccount) *Dev {
+	t.Helper()
+
+	logger := hclog.NewNullLogger()
+	forks := &chain.Forks{
+		chain.Homestead:      chain.NewFork(0),
+		chain.EIP150:         chain.NewFork(0),
+		chain.EIP155:         chain.NewFork(0),
+		chain.EIP158:         chain.NewFork(0),
+		chain.Byzantium:      chain.NewFork(0),
+		chain.Constantinople: chain.NewFork(0),
+		chain.Petersburg:     chain.NewFork(0),
+		chain.Istanbul:       chain.NewFork(0),
+	}
+
+	config := &chain.Chain{
+		Genesis: &chain.Genesis{
+			GasLimit: 10_000_000,
+			Alloc:    alloc,
+		},
+		Params: &chain.Params{
+			ChainID:        testChainID,
+			Forks:          forks,
+			BlockGasTarget: 10_000_000,
+		},
+	}
+
+	st := itrie.NewState(itrie.NewMemoryStorage())
+	executor := state.NewExecutor(config.Params, st, logger)
+
+	root, err := executor.WriteGenesis(config.Genesis.Alloc, types.ZeroHash)
+	require.NoError(t, err)
+
+	config.Genesis.StateRoot = root
+
+	db, err := memory.NewMemoryStorage(nil)
+	require.NoError(t, err)
+
+	signer := crypto.NewEIP155Signer(testChainID, true)
+
+	bc, err := blockchain.NewBlockchain(logger, db, config, nil, executor, signer)
+	require.NoError(t, err)
+
+	executor.GetHash = bc.GetHashHelper
+
+	pool, err := txpool.NewTxPool(logger, forks, &testTxPoolStore{st, bc}, nil, nil, &txpool.Config{
+		MaxSlots:           4096,
+		MaxAccountEnqueued: 128,
+		ChainID:            big.NewInt(testChainID),
+	})
+	require.NoError(t, err)
+
+	pool.SetSigner(signer)
+
+	engine, err := Factory(&consensus.Params{
+		Config: &consensus.Config{
+			Config: map[string]interface{}{"interval": uint64(3600)},
+		},
+		TxPool:     pool,
+		Blockchain: bc,
+		Executor:   executor,
+		Logger:     logger,
+	})
+	require.NoError(t, err)
+
+	bc.SetConsensus(engine)
+	require.NoError(t, bc.ComputeGenesis())
+
+	d, ok := engine.(*Dev)
+	require.True(t, ok)
+
+	require.NoError(t, d.Initialize())
+
+	t.Cleanup(func() {
+		pool.Close()
+		bc.Close()
+	})
+
+	return d
+}
+
+func headAccount(d *Dev, addr types.Address) *state.Account {
+	return getTestAccount(d.executor.State(), d.blockchain.Header().StateRoot, addr)
+}
+
+func TestDev_Time(t *testing.T) {
+	t.Parallel()
+
+	d := newTestDev(t, nil)
+
+	header, err := d.Mine(nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), header.Number)
+
+	assert.ErrorIs(t, d.SetNextBlockTimestamp(header.Timestamp), errTimestampTooLow)
+
+	timestamp := uint64(time.Now().Unix()) + 1000
+	require.NoError(t, d.SetNextBlockTimestamp(timestamp))
+
+	header, err = d.Mine(nil)
+	require.NoError(t, err)
+	assert.Equal(t, timestamp, header.Timestamp)
+
+	// the following blocks keep the distance from the set timestamp
+	header, err = d.Mine(nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, header.Timestamp, timestamp)
+	assert.Less(t, header.Timestamp, timestamp+10)
+
+	offset := d.IncreaseTime(3600)
+	assert.GreaterOrEqual(t, offset, int64(3600+990))
+
+	header, err = d.Mine(nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, header.Timestamp, timestamp+3600)
+
+	_, err = d.Mine(&timestamp)
+	assert.ErrorIs(t, err, errTimestampTooLow)
+
+	next := header.Timestamp + 100
+
+	header, err = d.Mine(&next)
+	require.NoError(t, err)
+	assert.Equal(t, next, header.Timestamp)
+}
+
+func TestDev_SnapshotAndStateOverride(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr    = types.StringToAddress("0x1001")
+		slot    = types.StringToHash("0x1")
+		value   = types.StringToHash("0x2")
+		code    = []byte{0x60, 0x00}
+		nonce   = uint64(7)
+		balance = big.NewInt(100)
+	)
+
+	d := newTestDev(t, nil)
+
+	snapshotID := d.Snapshot()
+
+	require.NoError(t, d.SetStateOverride(types.StateOverride{
+		addr: {
+			Nonce:     &nonce,
+			Balance:   balance,
+			Code:      code,
+			StateDiff: map[types.Hash]types.Hash{slot: value},
+		},
+	}))
+
+	// the override is sealed right away
+	assert.Equal(t, uint64(1), d.blockchain.Header().Number)
+
+	account := headAccount(d, addr)
+	require.NotNil(t, account)
+	assert.Equal(t, balance, account.Balance)
+	assert.Equal(t, nonce, account.Nonce)
+	assert.Equal(t, types.BytesToHash(crypto.Keccak256(code)), types.BytesToHash(account.CodeHash))
+
+	snap, err := d.executor.StateAt(d.blockchain.Header().StateRoot)
+	require.NoError(t, err)
+
+	storedValue, err := snap.GetStorage(addr, account.Root, slot)
+	require.NoError(t, err)
+	assert.Equal(t, value, storedValue)
+
+	_, err = d.Mine(nil)
+	require.NoError(t, err)
+
+	reverted, err := d.Revert(snapshotID)
+	require.NoError(t, err)
+	assert.True(t, reverted)
+
+	assert.Equal(t, uint64(0), d.blockchain.Header().Number)
+	assert.Nil(t, headAccount(d, addr))
+
+	_, ok := d.blockchain.GetHeaderByNumber(1)
+	assert.False(t, ok)
+
+	// the snapshot can be used once
+	reverted, err = d.Revert(snapshotID)
+	require.NoError(t, err)
+	assert.False(t, reverted)
+
+	// the chain continues from the snapshot
+	header, err := d.Mine(nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), header.Number)
+}
+
+func TestDev_ImpersonatedTx(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender   = types.StringToAddress("0x1001")
+		other    = types.StringToAddress("0x1002")
+		receiver = types.StringToAddress("0x1003")
+	)
+
+	d := newTestDev(t, map[types.Address]*chain.GenesisAccount{
+		sender: {Balance: big.NewInt(1_000_000)},
+		other:  {Balance: big.NewInt(1_000_000)},
+	})
+
+	newTx := func(from types.Address) *types.Transaction {
+		return &types.Transaction{
+			From:     from,
+			To:       &receiver,
+			Value:    big.NewInt(10),
+			Gas:      21000,
+			GasPrice: big.NewInt(1),
+			Nonce:    d.PendingNonce(from),
+		}
+	}
+
+	assert.ErrorIs(t, d.AddImpersonatedTx(newTx(sender)), errNotImpersonated)
+
+	d.ImpersonateAccount(sender)
+	d.ImpersonateAccount(other)
+
+	// the transactions wait for the next block
+	senderTx, otherTx := newTx(sender), newTx(other)
+
+	require.NoError(t, d.AddImpersonatedTx(senderTx))
+	require.NoError(t, d.AddImpersonatedTx(otherTx))
+
+	assert.Equal(t, uint64(1), d.PendingNonce(sender))
+	assert.NotEqual(t, senderTx.Hash, otherTx.Hash)
+
+	_, err := d.Mine(nil)
+	require.NoError(t, err)
+
+	for _, tx := range []*types.Transaction{senderTx, otherTx} {
+		blockHash, ok := d.blockchain.ReadTxLookup(tx.Hash)
+		require.True(t, ok)
+		assert.Equal(t, d.blockchain.Header().Hash, blockHash)
+	}
+
+	assert.Equal(t, big.NewInt(20), headAccount(d, receiver).Balance)
+
+	// the transactions are sealed right away in the on-demand mode
+	d.SetAutomine(true)
+
+	tx := newTx(sender)
+	require.NoError(t, d.AddImpersonatedTx(tx))
+
+	assert.Equal(t, uint64(2), d.blockchain.Header().Number)
+	assert.Equal(t, uint64(2), headAccount(d, sender).Nonce)
+
+	d.StopImpersonatingAccount(sender)
+	assert.False(t, d.IsImpersonated(sender))
+}
+
+func TestDev_OnDemand(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	sender := crypto.PubKeyToAddress(&key.PublicKey)
+	receiver := types.StringToAddress("0x1002")
+
+	d := newTestDev(t, map[types.Address]*chain.GenesisAccount{
+		sender: {Balance: big.NewInt(1_000_000_000_000)},
+	})
+
+	d.SetAutomine(true)
+	d.txpool.Start()
+
+	sub := d.blockchain.SubscribeEvents()
+	defer sub.Close()
+
+	require.NoError(t, d.Start())
+
+	tx, err := crypto.NewEIP155Signer(testChainID, true).SignTx(&types.Transaction{
+		To:       &receiver,
+		Value:    big.NewInt(10),
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+	}, key)
+	require.NoError(t, err)
+
+	require.NoError(t, d.txpool.AddTx(tx))
+
+	select {
+	case evnt := <-sub.GetEventCh():
+		assert.Equal(t, uint64(1), evnt.Header().Number)
+	case <-time.After(5 * time.Second):
+		t.Fatal("block not sealed")
+	}
+
+	require.NoError(t, d.Close())
+
+	blockHash, ok := d.blockchain.ReadTxLookup(tx.Hash)
+	require.True(t, ok)
+	assert.Equal(t, d.blockchain.Header().Hash, blockHash)
+}
+
+func TestDev_PauseBlockProduction(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	sender := crypto.PubKeyToAddress(&key.PublicKey)
+	receiver := types.StringToAddress("0x1002")
+
+	d := newTestDev(t, map[types.Address]*chain.GenesisAccount{
+		sender: {Balance: big.NewInt(1_000_000_000_000)},
+	})
+
+	d.SetAutomine(true)
+	d.SetBlockProductionPaused(true)
+	assert.True(t, d.IsBlockProductionPaused())
+
+	d.txpool.Start()
+
+	sub := d.blockchain.SubscribeEvents()
+	defer sub.Close()
+
+	require.NoError(t, d.Start())
+
+	signer := crypto.NewEIP155Signer(testChainID, true)
+
+	sendTx := func(nonce uint64) {
+		tx, err := signer.SignTx(&types.Transaction{
+			To:       &receiver,
+			Nonce:    nonce,
+			Value:    big.NewInt(10),
+			Gas:      21000,
+			GasPrice: big.NewInt(1),
+		}, key)
+		require.NoError(t, err)
+
+		require.NoError(t, d.txpool.AddTx(tx))
+	}
+
+	sendTx(0)
+
+	select {
+	case <-sub.GetEventCh():
+		t.Fatal("block sealed while paused")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// the pending transactions are sealed once the production is resumed
+	d.SetBlockProductionPaused(false)
+	sendTx(1)
+
+	select {
+	case evnt := <-sub.GetEventCh():
+		assert.Equal(t, uint64(1), evnt.Header().Number)
+	case <-time.After(5 * time.Second):
+		t.Fatal("block not sealed")
+	}
+
+	require.NoError(t, d.Close())
+
+	assert.Equal(t, uint64(2), headAccount(d, sender).Nonce)
+}
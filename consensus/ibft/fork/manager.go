@@ -61,6 +61,7 @@ type ForkManager struct {
 	blockchain     store.HeaderGetter
 	executor       contract.Executor
 	secretsManager secrets.SecretsManager
+	remoteSigner   signer.RemoteSigner
 
 	// configuration
 	forks     IBFTForks
@@ -79,6 +80,7 @@ func NewForkManager(
 	blockchain store.HeaderGetter,
 	executor contract.Executor,
 	secretManager secrets.SecretsManager,
+	remoteSigner signer.RemoteSigner,
 	filePath string,
 	epochSize uint64,
 	ibftConfig map[string]interface{},
@@ -93,6 +95,7 @@ func NewForkManager(
 		blockchain:      blockchain,
 		executor:        executor,
 		secretsManager:  secretManager,
+		remoteSigner:    remoteSigner,
 		filePath:        filePath,
 		epochSize:       epochSize,
 		forks:           forks,
@@ -187,6 +190,16 @@ func (m *ForkManager) GetValidators(height uint64) (validators.Validators, error
 	)
 }
 
+// GetValidatorSourceType returns the type of the validator store used at specified height
+func (m *ForkManager) GetValidatorSourceType(height uint64) (store.SourceType, error) {
+	fork := m.forks.getFork(height)
+	if fork == nil {
+		return "", ErrForkNotFound
+	}
+
+	return ibftTypesToSourceType[fork.Type], nil
+}
+
 // GetHooks returns a hooks at specified height
 func (m *ForkManager) GetHooks(height uint64) HooksInterface {
 	hooks := &hook.Hooks{}
@@ -238,7 +251,7 @@ func (m *ForkManager) initializeKeyManager(valType validators.ValidatorType) err
 		return nil
 	}
 
-	keyManager, err := signer.NewKeyManagerFromType(m.secretsManager, valType)
+	keyManager, err := signer.NewKeyManagerFromType(m.secretsManager, m.remoteSigner, valType)
 	if err != nil {
 		return err
 	}
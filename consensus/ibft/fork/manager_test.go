@@ -76,6 +76,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			"",
 			0,
 			map[string]interface{}{},
@@ -105,6 +106,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			secretManager,
+			nil,
 			"",
 			epochSize,
 			map[string]interface{}{
@@ -153,6 +155,7 @@ func TestNewForkManager(t *testing.T) {
 			blockchain,
 			nil,
 			secretManager,
+			nil,
 			dirPath,
 			epochSize,
 			map[string]interface{}{
@@ -234,6 +237,7 @@ func TestNewForkManager(t *testing.T) {
 			blockchain,
 			nil,
 			secretManager,
+			nil,
 			dirPath,
 			epochSize,
 			map[string]interface{}{
@@ -279,6 +283,7 @@ func TestNewForkManager(t *testing.T) {
 			nil,
 			nil,
 			secretManager,
+			nil,
 			"",
 			epochSize,
 			map[string]interface{}{
@@ -712,6 +717,43 @@ func TestForkManagerGetValidators(t *testing.T) {
 	}
 }
 
+func TestForkManagerGetValidatorSourceType(t *testing.T) {
+	t.Parallel()
+
+	fm := &ForkManager{
+		forks: IBFTForks{
+			{
+				Type:          PoA,
+				ValidatorType: validators.ECDSAValidatorType,
+				From:          common.JSONNumber{Value: 10},
+				To:            &common.JSONNumber{Value: 19},
+			},
+			{
+				Type:          PoS,
+				ValidatorType: validators.ECDSAValidatorType,
+				From:          common.JSONNumber{Value: 20},
+			},
+		},
+	}
+
+	tests := []struct {
+		height       uint64
+		expectedType store.SourceType
+		expectedErr  error
+	}{
+		{height: 5, expectedType: "", expectedErr: ErrForkNotFound},
+		{height: 19, expectedType: store.Snapshot},
+		{height: 20, expectedType: store.Contract},
+	}
+
+	for _, test := range tests {
+		sourceType, err := fm.GetValidatorSourceType(test.height)
+
+		assert.Equal(t, test.expectedType, sourceType)
+		assert.Equal(t, test.expectedErr, err)
+	}
+}
+
 func TestForkManagerGetHooks(t *testing.T) {
 	t.Parallel()
 
@@ -18,8 +18,10 @@ import (
 	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/0xPolygon/polygon-edge/validators/store"
 	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/grpc"
 )
 
@@ -40,6 +42,7 @@ var (
 	ErrInvalidMixHash             = errors.New("invalid mixhash")
 	ErrInvalidSha3Uncles          = errors.New("invalid sha3 uncles")
 	ErrWrongDifficulty            = errors.New("wrong difficulty")
+	ErrCheckpointNotSnapshot      = errors.New("validators around the checkpoint are not taken from the snapshot")
 )
 
 type txPoolInterface interface {
@@ -59,6 +62,7 @@ type forkManagerInterface interface {
 	GetSigner(uint64) (signer.Signer, error)
 	GetValidatorStore(uint64) (fork.ValidatorStore, error)
 	GetValidators(uint64) (validators.Validators, error)
+	GetValidatorSourceType(uint64) (store.SourceType, error)
 	GetHooks(uint64) fork.HooksInterface
 }
 
@@ -124,11 +128,17 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 
 	logger := params.Logger.Named("ibft")
 
+	var remoteSigner signer.RemoteSigner
+	if params.RemoteSigner != nil {
+		remoteSigner = params.RemoteSigner
+	}
+
 	forkManager, err := fork.NewForkManager(
 		logger,
 		params.Blockchain,
 		params.Executor,
 		params.SecretsManager,
+		remoteSigner,
 		params.Config.Path,
 		epochSize,
 		params.Config.Config,
@@ -178,11 +188,6 @@ func (i *backendIBFT) Initialize() error {
 		proto.RegisterIbftOperatorServer(i.Grpc, i.operator)
 	}
 
-	// start the transport protocol
-	if err := i.setupTransport(); err != nil {
-		return err
-	}
-
 	// initialize fork manager
 	if err := i.forkManager.Initialize(); err != nil {
 		return err
@@ -203,6 +208,11 @@ func (i *backendIBFT) Initialize() error {
 	// Ensure consensus takes into account user configured block production time
 	i.consensus.ExtendRoundTimeout(i.blockTime)
 
+	// start the transport protocol, once the messages can be validated and handled
+	if err := i.setupTransport(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -250,6 +260,40 @@ func (i *backendIBFT) GetSyncProgression() *progress.Progression {
 	return i.syncer.GetSyncProgression()
 }
 
+// GetSyncPeerHead returns the latest block number reported by the peer, if known
+func (i *backendIBFT) GetSyncPeerHead(peerID peer.ID) (uint64, bool) {
+	status := i.syncer.PeerStatus(peerID)
+	if status == nil {
+		return 0, false
+	}
+
+	return status.Number, true
+}
+
+// CheckpointHeadersFrom returns the beginning of the epoch of the checkpoint with the given number.
+// The validator snapshot of the checkpoint is rebuilt from the headers since then,
+// so only the checkpoints whose validators are taken from the snapshot are supported
+func (i *backendIBFT) CheckpointHeadersFrom(number uint64) (uint64, error) {
+	from := (number / i.epochSize) * i.epochSize
+	if from == 0 {
+		// the genesis is known already
+		from = 1
+	}
+
+	for _, height := range []uint64{from, number, number + 1} {
+		sourceType, err := i.forkManager.GetValidatorSourceType(height)
+		if err != nil {
+			return 0, err
+		}
+
+		if sourceType != store.Snapshot {
+			return 0, fmt.Errorf("%w: %s at %d", ErrCheckpointNotSnapshot, sourceType, height)
+		}
+	}
+
+	return from, nil
+}
+
 func (i *backendIBFT) startConsensus() {
 	var (
 		newBlockSub   = i.blockchain.SubscribeEvents()
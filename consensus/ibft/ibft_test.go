@@ -0,0 +1,58 @@
+package ibft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/validators/store"
+	"github.com/stretchr/testify/assert"
+)
+
+// sourceTypeForkManager is the forkManagerInterface switching from PoA to PoS at the given height
+type sourceTypeForkManager struct {
+	forkManagerInterface
+
+	posFrom uint64
+}
+
+func (m *sourceTypeForkManager) GetValidatorSourceType(height uint64) (store.SourceType, error) {
+	if height >= m.posFrom {
+		return store.Contract, nil
+	}
+
+	return store.Snapshot, nil
+}
+
+func TestIBFTBackend_CheckpointHeadersFrom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		number       uint64
+		expectedFrom uint64
+		expectedErr  error
+	}{
+		{name: "first epoch", number: 5, expectedFrom: 1},
+		{name: "beginning of the epoch", number: 10, expectedFrom: 10},
+		{name: "later epoch", number: 25, expectedFrom: 20},
+		{name: "next block is PoS", number: 49, expectedErr: ErrCheckpointNotSnapshot},
+		{name: "PoS", number: 55, expectedErr: ErrCheckpointNotSnapshot},
+	}
+
+	backend := &backendIBFT{
+		epochSize:   10,
+		forkManager: &sourceTypeForkManager{posFrom: 50},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			from, err := backend.CheckpointHeadersFrom(test.number)
+
+			assert.ErrorIs(t, err, test.expectedErr)
+			assert.Equal(t, test.expectedFrom, from)
+		})
+	}
+}
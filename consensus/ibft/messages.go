@@ -67,7 +67,7 @@ func (i *backendIBFT) BuildPrepareMessage(proposalHash []byte, view *protoIBFT.V
 }
 
 func (i *backendIBFT) BuildCommitMessage(proposalHash []byte, view *protoIBFT.View) *protoIBFT.Message {
-	committedSeal, err := i.currentSigner.CreateCommittedSeal(proposalHash)
+	committedSeal, err := i.currentSigner.CreateCommittedSeal(proposalHash, view.Height, view.Round)
 	if err != nil {
 		i.logger.Error("Unable to build commit message, %v", err)
 
@@ -90,7 +90,7 @@ func TestSign_CommittedSeals(t *testing.T) {
 				),
 			)
 
-			seal, err := signer.CreateCommittedSeal(h.Hash.Bytes())
+			seal, err := signer.CreateCommittedSeal(h.Hash.Bytes(), h.Number, 0)
 
 			assert.NoError(t, err)
 
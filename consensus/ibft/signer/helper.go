@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
-	"github.com/0xPolygon/polygon-edge/helper/keccak"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/secrets/helper"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -20,6 +19,9 @@ const (
 	// legacy committed seals, so it needs to be preserved in order
 	// for new clients to read old committed seals
 	legacyCommitCode = 2
+
+	// headerHashFields is the number of the header fields the IBFT header hash is calculated of
+	headerHashFields = 13
 )
 
 // wrapCommitHash calculates digest for CommittedSeal
@@ -61,6 +63,11 @@ func getOrCreateBLSKey(manager secrets.SecretsManager) (*bls_sig.SecretKey, erro
 
 // calculateHeaderHash is hash calculation of header for IBFT
 func calculateHeaderHash(h *types.Header) types.Hash {
+	return types.BytesToHash(crypto.Keccak256(marshalHeaderForHash(h)))
+}
+
+// marshalHeaderForHash returns the RLP encoded header fields the IBFT header hash is calculated of
+func marshalHeaderForHash(h *types.Header) []byte {
 	arena := fastrlp.DefaultArenaPool.Get()
 	defer fastrlp.DefaultArenaPool.Put(arena)
 
@@ -79,9 +86,36 @@ func calculateHeaderHash(h *types.Header) types.Hash {
 	vv.Set(arena.NewUint(h.Timestamp))
 	vv.Set(arena.NewCopyBytes(h.ExtraData))
 
-	buf := keccak.Keccak256Rlp(nil, vv)
+	return vv.MarshalTo(nil)
+}
+
+// ProposerSealDigest returns the digest the proposer seal is signed over,
+// given the RLP encoded header fields the IBFT header hash is calculated of.
+// It fails if the data is not the encoded header fields, so the digest of the seal
+// can not be the digest of any other message signed by the validator
+func ProposerSealDigest(header []byte) ([]byte, error) {
+	p := &fastrlp.Parser{}
 
-	return types.BytesToHash(buf)
+	v, err := p.Parse(header)
+	if err != nil {
+		return nil, err
+	}
+
+	elems, err := v.GetElems()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(elems) != headerHashFields {
+		return nil, fmt.Errorf("expected %d header fields, got %d", headerHashFields, len(elems))
+	}
+
+	return crypto.Keccak256(crypto.Keccak256(header)), nil
+}
+
+// CommittedSealDigest returns the digest the committed seal of the given proposal hash is signed over
+func CommittedSealDigest(hash []byte) []byte {
+	return crypto.Keccak256(wrapCommitHash(hash))
 }
 
 // ecrecover recovers signer address from the given digest and signature
@@ -94,11 +128,24 @@ func ecrecover(sig, msg []byte) (types.Address, error) {
 	return crypto.PubKeyToAddress(pub), nil
 }
 
-// NewKeyManagerFromType creates KeyManager based on the given type
+// NewKeyManagerFromType creates KeyManager based on the given type.
+// If the remote signer is given, the KeyManager signs with the key it holds instead of the local one.
+// The remote signer supports the ECDSA validators only: it holds the BN254 BLS keys of polybft,
+// while the IBFT BLS validators sign with the BLS12-381 keys, so they keep signing with the local keys
 func NewKeyManagerFromType(
 	secretManager secrets.SecretsManager,
+	remoteSigner RemoteSigner,
 	validatorType validators.ValidatorType,
 ) (KeyManager, error) {
+	if remoteSigner != nil {
+		if validatorType != validators.ECDSAValidatorType {
+			return nil, fmt.Errorf("remote signer does not support %s validators, "+
+				"the IBFT BLS keys must be held by the node", validatorType)
+		}
+
+		return NewRemoteECDSAKeyManager(remoteSigner), nil
+	}
+
 	switch validatorType {
 	case validators.ECDSAValidatorType:
 		return NewECDSAKeyManager(secretManager)
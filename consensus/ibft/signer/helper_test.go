@@ -390,7 +390,7 @@ func TestNewKeyManagerFromType(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewKeyManagerFromType(test.mockSecretManager, test.validatorType)
+			res, err := NewKeyManagerFromType(test.mockSecretManager, nil, test.validatorType)
 
 			assert.Equal(t, test.expectedRes, res)
 
@@ -0,0 +1,88 @@
+package signer
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// errRemoteDigestSigning is returned if the digest is to be signed by the remote signer,
+// which signs the seals and messages only from the data they are made of
+var errRemoteDigestSigning = errors.New("remote signer does not sign digests")
+
+// RemoteSigner signs with the validator ECDSA key held outside of the node process
+type RemoteSigner interface {
+	// Address returns the address of the ECDSA key
+	Address() types.Address
+	// SignECDSAProposerSeal signs the proposer seal with the ECDSA key,
+	// given the RLP encoded header fields the IBFT header hash is calculated of
+	SignECDSAProposerSeal(header []byte) ([]byte, error)
+	// SignECDSACommittedSeal signs the committed seal of the proposal at the given height and round
+	// with the ECDSA key
+	SignECDSACommittedSeal(proposalHash []byte, height, round uint64) ([]byte, error)
+	// SignIBFTMessage signs the keccak256 hash of the marshaled IBFT message with the ECDSA key
+	SignIBFTMessage(payload []byte) ([]byte, error)
+}
+
+// ibftMessageSigner is implemented by the KeyManagers signing the marshaled IBFT messages
+// instead of their digests, so the message can be checked by the signer before signing
+type ibftMessageSigner interface {
+	SignIBFTMessagePayload(msg []byte) ([]byte, error)
+}
+
+// ibftSealSigner is implemented by the KeyManagers signing the seals from the data they are made of
+// instead of their digests, so the seals can be checked by the signer before signing
+type ibftSealSigner interface {
+	SignProposerSealPayload(header []byte) ([]byte, error)
+	SignCommittedSealOfView(proposalHash []byte, height, round uint64) ([]byte, error)
+}
+
+// RemoteECDSAKeyManager is the ECDSAKeyManager signing with the remote signer
+type RemoteECDSAKeyManager struct {
+	*ECDSAKeyManager
+
+	remote RemoteSigner
+}
+
+// NewRemoteECDSAKeyManager initializes the ECDSA KeyManager signing with the given remote signer
+func NewRemoteECDSAKeyManager(remote RemoteSigner) KeyManager {
+	return &RemoteECDSAKeyManager{
+		ECDSAKeyManager: &ECDSAKeyManager{
+			address: remote.Address(),
+		},
+		remote: remote,
+	}
+}
+
+// SignProposerSeal fails, as the remote signer signs the proposer seal from the header only
+func (s *RemoteECDSAKeyManager) SignProposerSeal(message []byte) ([]byte, error) {
+	return nil, errRemoteDigestSigning
+}
+
+// SignCommittedSeal fails, as the remote signer signs the committed seal from its view only
+func (s *RemoteECDSAKeyManager) SignCommittedSeal(message []byte) ([]byte, error) {
+	return nil, errRemoteDigestSigning
+}
+
+// SignIBFTMessage fails, as the remote signer signs the IBFT message from its payload only
+func (s *RemoteECDSAKeyManager) SignIBFTMessage(digest []byte) ([]byte, error) {
+	return nil, errRemoteDigestSigning
+}
+
+// SignIBFTMessagePayload signs the marshaled IBFT message by the remote ECDSA key,
+// which lets the remote signer apply its slashing protection to the message
+func (s *RemoteECDSAKeyManager) SignIBFTMessagePayload(msg []byte) ([]byte, error) {
+	return s.remote.SignIBFTMessage(msg)
+}
+
+// SignProposerSealPayload signs the proposer seal of the RLP encoded header fields by the remote ECDSA key,
+// which lets the remote signer check the seal is not the digest of another message
+func (s *RemoteECDSAKeyManager) SignProposerSealPayload(header []byte) ([]byte, error) {
+	return s.remote.SignECDSAProposerSeal(header)
+}
+
+// SignCommittedSealOfView signs the committed seal of the proposal by the remote ECDSA key,
+// which lets the remote signer apply its slashing protection to the seal
+func (s *RemoteECDSAKeyManager) SignCommittedSealOfView(proposalHash []byte, height, round uint64) ([]byte, error) {
+	return s.remote.SignECDSACommittedSeal(proposalHash, height, round)
+}
@@ -0,0 +1,129 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+)
+
+// testRemoteSigner is the RemoteSigner signing with the key in memory
+type testRemoteSigner struct {
+	key *ecdsa.PrivateKey
+
+	// payloads holds the IBFT messages signed by the signer
+	payloads [][]byte
+
+	// views holds the views of the committed seals signed by the signer
+	views [][2]uint64
+}
+
+func (s *testRemoteSigner) Address() types.Address {
+	return crypto.PubKeyToAddress(&s.key.PublicKey)
+}
+
+func (s *testRemoteSigner) SignECDSAProposerSeal(header []byte) ([]byte, error) {
+	digest, err := ProposerSealDigest(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Sign(s.key, digest)
+}
+
+func (s *testRemoteSigner) SignECDSACommittedSeal(proposalHash []byte, height, round uint64) ([]byte, error) {
+	s.views = append(s.views, [2]uint64{height, round})
+
+	return crypto.Sign(s.key, CommittedSealDigest(proposalHash))
+}
+
+func (s *testRemoteSigner) SignIBFTMessage(payload []byte) ([]byte, error) {
+	s.payloads = append(s.payloads, payload)
+
+	return crypto.Sign(s.key, crypto.Keccak256(payload))
+}
+
+func newTestRemoteECDSAKeyManager(t *testing.T) (KeyManager, *testRemoteSigner) {
+	t.Helper()
+
+	testKey, _ := newTestECDSAKey(t)
+	remote := &testRemoteSigner{key: testKey}
+
+	return NewRemoteECDSAKeyManager(remote), remote
+}
+
+func TestRemoteECDSAKeyManager(t *testing.T) {
+	t.Parallel()
+
+	keyManager, remote := newTestRemoteECDSAKeyManager(t)
+	localKeyManager := NewECDSAKeyManagerFromKey(remote.key)
+
+	assert.Equal(t, validators.ECDSAValidatorType, keyManager.Type())
+	assert.Equal(t, localKeyManager.Address(), keyManager.Address())
+
+	// the remote key manager does not sign the digests
+	hash := crypto.Keccak256([]byte("hash"))
+
+	_, err := keyManager.SignProposerSeal(hash)
+	require.ErrorIs(t, err, errRemoteDigestSigning)
+
+	_, err = keyManager.SignCommittedSeal(hash)
+	require.ErrorIs(t, err, errRemoteDigestSigning)
+
+	_, err = keyManager.SignIBFTMessage(hash)
+	require.ErrorIs(t, err, errRemoteDigestSigning)
+
+	// the signer passes the seal data to the remote signer, which signs the same as the local key
+	signer := newTestSingleKeyManagerSigner(keyManager)
+	localSigner := newTestSingleKeyManagerSigner(localKeyManager)
+	validatorSet := validators.NewECDSAValidatorSet(validators.NewECDSAValidator(keyManager.Address()))
+
+	header := &types.Header{Number: 10}
+	signer.InitIBFTExtra(header, validatorSet, nil)
+
+	localHeader := header.Copy()
+
+	header, err = signer.WriteProposerSeal(header)
+	require.NoError(t, err)
+
+	localHeader, err = localSigner.WriteProposerSeal(localHeader)
+	require.NoError(t, err)
+
+	assert.Equal(t, localHeader.ExtraData, header.ExtraData)
+
+	committedSeal, err := signer.CreateCommittedSeal(hash, 10, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][2]uint64{{10, 2}}, remote.views)
+
+	require.NoError(t, signer.VerifyCommittedSeal(validatorSet, keyManager.Address(), committedSeal, hash))
+
+	// the signer passes the IBFT message itself to the remote signer
+	msg := []byte("message")
+
+	sig, err := signer.SignIBFTMessage(msg)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{msg}, remote.payloads)
+
+	recovered, err := signer.EcrecoverFromIBFTMessage(sig, msg)
+	require.NoError(t, err)
+	assert.Equal(t, keyManager.Address(), recovered)
+}
+
+func TestNewKeyManagerFromType_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	testKey, _ := newTestECDSAKey(t)
+	remote := &testRemoteSigner{key: testKey}
+
+	keyManager, err := NewKeyManagerFromType(nil, remote, validators.ECDSAValidatorType)
+	require.NoError(t, err)
+	assert.IsType(t, &RemoteECDSAKeyManager{}, keyManager)
+
+	_, err = NewKeyManagerFromType(nil, remote, validators.BLSValidatorType)
+	require.Error(t, err)
+}
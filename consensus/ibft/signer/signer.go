@@ -38,7 +38,7 @@ type Signer interface {
 	EcrecoverFromHeader(*types.Header) (types.Address, error)
 
 	// CommittedSeal
-	CreateCommittedSeal([]byte) ([]byte, error)
+	CreateCommittedSeal(hash []byte, height, round uint64) ([]byte, error)
 	VerifyCommittedSeal(validators.Validators, types.Address, []byte, []byte) error
 
 	// CommittedSeals
@@ -139,14 +139,20 @@ func (s *SignerImpl) GetIBFTExtra(header *types.Header) (*IstanbulExtra, error)
 
 // WriteProposerSeal signs and set ProposerSeal into IBFT Extra of the header
 func (s *SignerImpl) WriteProposerSeal(header *types.Header) (*types.Header, error) {
-	hash, err := s.CalculateHeaderHash(header)
+	filteredHeader, err := s.FilterHeaderForHash(header)
 	if err != nil {
 		return nil, err
 	}
 
-	seal, err := s.keyManager.SignProposerSeal(
-		crypto.Keccak256(hash.Bytes()),
-	)
+	var seal []byte
+
+	if signer, ok := s.keyManager.(ibftSealSigner); ok {
+		seal, err = signer.SignProposerSealPayload(marshalHeaderForHash(filteredHeader))
+	} else {
+		hash := calculateHeaderHash(filteredHeader)
+		seal, err = s.keyManager.SignProposerSeal(crypto.Keccak256(hash.Bytes()))
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -169,16 +175,18 @@ func (s *SignerImpl) EcrecoverFromHeader(header *types.Header) (types.Address, e
 	return s.keyManager.Ecrecover(extra.ProposerSeal, crypto.Keccak256(header.Hash.Bytes()))
 }
 
-// CreateCommittedSeal returns CommittedSeal from given hash
-func (s *SignerImpl) CreateCommittedSeal(hash []byte) ([]byte, error) {
+// CreateCommittedSeal returns CommittedSeal from given hash of the proposal at the given height and round
+func (s *SignerImpl) CreateCommittedSeal(hash []byte, height, round uint64) ([]byte, error) {
+	if signer, ok := s.keyManager.(ibftSealSigner); ok {
+		return signer.SignCommittedSealOfView(hash, height, round)
+	}
+
 	return s.keyManager.SignCommittedSeal(
 		// Of course, this keccaking of an extended array is not according to the IBFT 2.0 spec,
 		// but almost nothing in this legacy signing package is. This is kept
 		// in order to preserve the running chains that used these
 		// old (and very, very incorrect) signing schemes
-		crypto.Keccak256(
-			wrapCommitHash(hash[:]),
-		),
+		CommittedSealDigest(hash),
 	)
 }
 
@@ -300,6 +308,10 @@ func (s *SignerImpl) VerifyParentCommittedSeals(
 
 // SignIBFTMessage signs arbitrary message
 func (s *SignerImpl) SignIBFTMessage(msg []byte) ([]byte, error) {
+	if signer, ok := s.keyManager.(ibftMessageSigner); ok {
+		return signer.SignIBFTMessagePayload(msg)
+	}
+
 	return s.keyManager.SignIBFTMessage(crypto.Keccak256(msg))
 }
 
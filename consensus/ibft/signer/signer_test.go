@@ -697,7 +697,7 @@ func TestSignerCreateCommittedSeal(t *testing.T) {
 		},
 	)
 
-	res, err := signer.CreateCommittedSeal(hash)
+	res, err := signer.CreateCommittedSeal(hash, 1, 0)
 
 	assert.Equal(t, sig, res)
 	assert.NoError(t, err)
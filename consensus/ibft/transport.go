@@ -1,6 +1,8 @@
 package ibft
 
 import (
+	"bytes"
+
 	"github.com/0xPolygon/go-ibft/messages/proto"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -11,6 +13,49 @@ type transport interface {
 	Multicast(msg *proto.Message) error
 }
 
+// newIbftMessageValidator returns the topic validator rejecting the consensus messages which are malformed,
+// not signed by the sender or not sent by a validator of their height, before they are propagated.
+// The messages of the heights after the one following the local head are ignored instead,
+// as their validators are not known yet
+func newIbftMessageValidator(forkManager forkManagerInterface, headNumber func() uint64) network.TopicValidator {
+	return func(obj interface{}, _ peer.ID) network.ValidationResult {
+		msg, ok := obj.(*proto.Message)
+		if !ok || msg.View == nil || msg.Payload == nil {
+			return network.ValidationReject
+		}
+
+		if msg.View.Height > headNumber()+1 {
+			return network.ValidationIgnore
+		}
+
+		msgNoSig, err := msg.PayloadNoSig()
+		if err != nil {
+			return network.ValidationReject
+		}
+
+		signer, err := forkManager.GetSigner(msg.View.Height)
+		if err != nil {
+			return network.ValidationIgnore
+		}
+
+		sender, err := signer.EcrecoverFromIBFTMessage(msg.Signature, msgNoSig)
+		if err != nil || !bytes.Equal(msg.From, sender.Bytes()) {
+			return network.ValidationReject
+		}
+
+		validators, err := forkManager.GetValidators(msg.View.Height)
+		if err != nil {
+			return network.ValidationIgnore
+		}
+
+		if !validators.Includes(sender) {
+			return network.ValidationReject
+		}
+
+		return network.ValidationAccept
+	}
+}
+
 type gossipTransport struct {
 	topic *network.Topic
 }
@@ -28,7 +73,14 @@ func (i *backendIBFT) Multicast(msg *proto.Message) {
 // setupTransport sets up the gossip transport protocol
 func (i *backendIBFT) setupTransport() error {
 	// Define a new topic
-	topic, err := i.network.NewTopic(ibftProto, &proto.Message{})
+	topic, err := i.network.NewTopic(
+		ibftProto,
+		&proto.Message{},
+		network.WithTopicValidator(newIbftMessageValidator(i.forkManager, func() uint64 {
+			return i.blockchain.Header().Number
+		})),
+		network.WithTopicScoreParams(network.ValidatorTopicScoreParams()),
+	)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,129 @@
+package ibft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testForkManager is the forkManagerInterface with the same signer and validators at every known height.
+// Like the snapshot validator store, it returns the latest known validators for the later heights
+type testForkManager struct {
+	forkManagerInterface
+
+	signer     signer.Signer
+	validators validators.Validators
+
+	// minHeight is the first height the validators are known for
+	minHeight uint64
+}
+
+func (m *testForkManager) GetSigner(uint64) (signer.Signer, error) {
+	return m.signer, nil
+}
+
+func (m *testForkManager) GetValidators(height uint64) (validators.Validators, error) {
+	if height < m.minHeight {
+		return nil, errors.New("unknown height")
+	}
+
+	return m.validators, nil
+}
+
+// signTestMessage returns the PREPARE message of the height signed by the account
+func signTestMessage(t *testing.T, account *testerAccount, from types.Address, height uint64) *proto.Message {
+	t.Helper()
+
+	msg := &proto.Message{
+		View: &proto.View{Height: height, Round: 0},
+		From: from.Bytes(),
+		Type: proto.MessageType_PREPARE,
+		Payload: &proto.Message_PrepareData{
+			PrepareData: &proto.PrepareMessage{ProposalHash: types.StringToHash("1").Bytes()},
+		},
+	}
+
+	msgNoSig, err := msg.PayloadNoSig()
+	require.NoError(t, err)
+
+	msg.Signature, err = signer.NewSigner(signer.NewECDSAKeyManagerFromKey(account.priv), nil).SignIBFTMessage(msgNoSig)
+	require.NoError(t, err)
+
+	return msg
+}
+
+func TestTransport_IbftMessageValidator(t *testing.T) {
+	t.Parallel()
+
+	pool := newTesterAccountPool(t, 2)
+	validator, nonValidator := pool.accounts[0], pool.accounts[1]
+
+	validate := newIbftMessageValidator(&testForkManager{
+		signer: signer.NewSigner(signer.NewECDSAKeyManagerFromKey(validator.priv), nil),
+		validators: validators.NewECDSAValidatorSet(
+			validators.NewECDSAValidator(validator.Address()),
+		),
+		minHeight: 2,
+	}, func() uint64 {
+		return 9
+	})
+
+	tests := []struct {
+		name     string
+		msg      *proto.Message
+		expected network.ValidationResult
+	}{
+		{
+			name:     "validator message",
+			msg:      signTestMessage(t, validator, validator.Address(), 5),
+			expected: network.ValidationAccept,
+		},
+		{
+			name:     "non validator message",
+			msg:      signTestMessage(t, nonValidator, nonValidator.Address(), 5),
+			expected: network.ValidationReject,
+		},
+		{
+			name:     "message signed by another key than the sender",
+			msg:      signTestMessage(t, nonValidator, validator.Address(), 5),
+			expected: network.ValidationReject,
+		},
+		{
+			name:     "message without view",
+			msg:      &proto.Message{From: validator.Address().Bytes()},
+			expected: network.ValidationReject,
+		},
+		{
+			name:     "non validator message of the height following the head",
+			msg:      signTestMessage(t, nonValidator, nonValidator.Address(), 10),
+			expected: network.ValidationReject,
+		},
+		{
+			name:     "message of the future height from the validator not in the set yet",
+			msg:      signTestMessage(t, nonValidator, nonValidator.Address(), 11),
+			expected: network.ValidationIgnore,
+		},
+		{
+			name:     "message of the height the validators are not known for",
+			msg:      signTestMessage(t, nonValidator, nonValidator.Address(), 1),
+			expected: network.ValidationIgnore,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, validate(test.msg, ""))
+		})
+	}
+}
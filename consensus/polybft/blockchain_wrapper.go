@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -31,15 +32,16 @@ type blockchainBackend interface {
 	// CurrentHeader returns the header of blockchain block head
 	CurrentHeader() *types.Header
 
-	// CommitBlock commits a block to the chain.
-	CommitBlock(block *types.FullBlock) error
+	// CommitBlock commits a block to the chain, tracing the write within the given context.
+	CommitBlock(ctx context.Context, block *types.FullBlock) error
 
 	// NewBlockBuilder is a factory method that returns a block builder on top of 'parent'.
 	NewBlockBuilder(parent *types.Header, coinbase types.Address,
 		txPool txPoolInterface, blockTime time.Duration, logger hclog.Logger) (blockBuilder, error)
 
-	// ProcessBlock builds a final block from given 'block' on top of 'parent'.
-	ProcessBlock(parent *types.Header, block *types.Block) (*types.FullBlock, error)
+	// ProcessBlock builds a final block from given 'block' on top of 'parent',
+	// tracing the state commit within the given context.
+	ProcessBlock(ctx context.Context, parent *types.Header, block *types.Block) (*types.FullBlock, error)
 
 	// GetStateProviderForBlock returns a reference to make queries to the state at 'block'.
 	GetStateProviderForBlock(block *types.Header) (contract.Provider, error)
@@ -78,12 +80,16 @@ func (p *blockchainWrapper) CurrentHeader() *types.Header {
 }
 
 // CommitBlock commits a block to the chain
-func (p *blockchainWrapper) CommitBlock(block *types.FullBlock) error {
-	return p.blockchain.WriteFullBlock(block, consensusSource)
+func (p *blockchainWrapper) CommitBlock(ctx context.Context, block *types.FullBlock) error {
+	return p.blockchain.WriteFullBlockWithContext(ctx, block, consensusSource)
 }
 
 // ProcessBlock builds a final block from given 'block' on top of 'parent'
-func (p *blockchainWrapper) ProcessBlock(parent *types.Header, block *types.Block) (*types.FullBlock, error) {
+func (p *blockchainWrapper) ProcessBlock(
+	ctx context.Context,
+	parent *types.Header,
+	block *types.Block,
+) (*types.FullBlock, error) {
 	header := block.Header.Copy()
 	start := time.Now().UTC()
 
@@ -99,7 +105,7 @@ func (p *blockchainWrapper) ProcessBlock(parent *types.Header, block *types.Bloc
 		}
 	}
 
-	_, root, err := transition.Commit()
+	_, root, err := transition.CommitWithContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit the state changes: %w", err)
 	}
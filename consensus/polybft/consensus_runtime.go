@@ -2,6 +2,7 @@ package polybft
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -12,15 +13,17 @@ import (
 	"github.com/0xPolygon/go-ibft/messages"
 	"github.com/0xPolygon/go-ibft/messages/proto"
 	hcf "github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/common"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
-	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/slashing"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -101,6 +104,9 @@ type consensusRuntime struct {
 	// fsm instance which is created for each `runSequence`
 	fsm *fsm
 
+	// sequence traces the running sequence and the phases of its rounds
+	sequence sequenceTracer
+
 	// lock is a lock to access 'epoch' and `lastBuiltBlock`
 	lock sync.RWMutex
 
@@ -317,6 +323,14 @@ func (c *consensusRuntime) getGuardedData() (guardedDataDTO, error) {
 	}, nil
 }
 
+// getCurrentEpoch returns the metadata of the current epoch and the number of the last built block
+func (c *consensusRuntime) getCurrentEpoch() (*epochMetadata, uint64) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.epoch, c.lastBuiltBlock.Number
+}
+
 func (c *consensusRuntime) IsBridgeEnabled() bool {
 	// this is enough to check, because bridge config is not something
 	// that can be changed through governance
@@ -325,6 +339,12 @@ func (c *consensusRuntime) IsBridgeEnabled() bool {
 
 // OnBlockInserted is called whenever fsm or syncer inserts new block
 func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
+	c.onBlockInserted(context.Background(), fullBlock)
+}
+
+// onBlockInserted updates the runtime state once the block is inserted,
+// tracing the post block callbacks within the given context
+func (c *consensusRuntime) onBlockInserted(ctx context.Context, fullBlock *types.FullBlock) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -350,6 +370,12 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 		isEndOfEpoch = c.isFixedSizeOfEpochMet(fullBlock.Block.Header.Number, epoch)
 	)
 
+	ctx, span := spanTracer.Start(ctx, "polybft.post_block", trace.WithAttributes(
+		attribute.Int64("block.number", int64(fullBlock.Block.Number())),
+		attribute.Bool("epoch.ending", isEndOfEpoch),
+	))
+	defer span.End()
+
 	postBlock := &common.PostBlockRequest{
 		FullBlock:           fullBlock,
 		Epoch:               epoch.Number,
@@ -359,32 +385,32 @@ func (c *consensusRuntime) OnBlockInserted(fullBlock *types.FullBlock) {
 	}
 
 	// handle commitment and proofs creation
-	if err := c.stateSyncManager.PostBlock(postBlock); err != nil {
+	if err := tracePostBlock(ctx, "state_sync_manager", c.stateSyncManager, postBlock); err != nil {
 		c.logger.Error("post block callback failed in state sync manager", "err", err)
 	}
 
 	// handle exit events that happened in block
-	if err := c.checkpointManager.PostBlock(postBlock); err != nil {
+	if err := tracePostBlock(ctx, "checkpoint_manager", c.checkpointManager, postBlock); err != nil {
 		c.logger.Error("post block callback failed in checkpoint manager", "err", err)
 	}
 
 	// update proposer priorities
-	if err := c.proposerCalculator.PostBlock(postBlock); err != nil {
+	if err := tracePostBlock(ctx, "proposer_calculator", c.proposerCalculator, postBlock); err != nil {
 		c.logger.Error("could not update proposer calculator", "err", err)
 	}
 
 	// handle transfer events that happened in block
-	if err := c.stakeManager.PostBlock(postBlock); err != nil {
+	if err := tracePostBlock(ctx, "stake_manager", c.stakeManager, postBlock); err != nil {
 		c.logger.Error("post block callback failed in stake manager", "err", err)
 	}
 
 	// update double signing tracker internal state
-	if err := c.doubleSigningTracker.PostBlock(postBlock); err != nil {
+	if err := tracePostBlock(ctx, "double_signing_tracker", c.doubleSigningTracker, postBlock); err != nil {
 		c.logger.Error("post block callback failed in double signing tracker", "err", err)
 	}
 
 	// handle governance events that happened in block
-	if err := c.governanceManager.PostBlock(postBlock); err != nil {
+	if err := tracePostBlock(ctx, "governance_manager", c.governanceManager, postBlock); err != nil {
 		c.logger.Error("post block callback failed in governance manager", "err", err)
 	}
 
@@ -775,7 +801,10 @@ func (c *consensusRuntime) getSystemState(header *types.Header) (common.SystemSt
 }
 
 func (c *consensusRuntime) IsValidProposal(rawProposal []byte) bool {
-	if err := c.fsm.Validate(rawProposal); err != nil {
+	ctx, span := spanTracer.Start(c.sequence.context(), "polybft.validate_proposal")
+	err := c.fsm.Validate(ctx, rawProposal)
+
+	if tracing.End(span, err); err != nil {
 		c.logger.Error("failed to validate proposal", "error", err)
 
 		return false
@@ -876,7 +905,14 @@ func (c *consensusRuntime) BuildProposal(view *proto.View) []byte {
 		return nil
 	}
 
+	_, span := spanTracer.Start(c.sequence.context(), "polybft.build_proposal", trace.WithAttributes(
+		attribute.Int64("block.number", int64(view.Height)),
+		attribute.Int64("round", int64(view.Round)),
+	))
+
 	proposal, err := c.fsm.BuildProposal(view.Round)
+	tracing.End(span, err)
+
 	if err != nil {
 		c.logger.Error("unable to build proposal", "blockNumber", view, "error", err)
 
@@ -890,14 +926,20 @@ func (c *consensusRuntime) BuildProposal(view *proto.View) []byte {
 func (c *consensusRuntime) InsertProposal(proposal *proto.Proposal, committedSeals []*messages.CommittedSeal) {
 	fsm := c.fsm
 
-	fullBlock, err := fsm.Insert(proposal.RawProposal, committedSeals)
+	ctx, span := spanTracer.Start(c.sequence.context(), "polybft.insert_proposal", trace.WithAttributes(
+		attribute.Int64("round", int64(proposal.Round)),
+	))
+
+	fullBlock, err := fsm.Insert(ctx, proposal.RawProposal, committedSeals)
 	if err != nil {
+		tracing.End(span, err)
 		c.logger.Error("cannot insert proposal", "error", err)
 
 		return
 	}
 
-	c.OnBlockInserted(fullBlock)
+	c.onBlockInserted(ctx, fullBlock)
+	span.End()
 }
 
 // ID return ID (address actually) of the current node
@@ -983,6 +1025,8 @@ func (c *consensusRuntime) BuildPrePrepareMessage(
 
 // BuildPrepareMessage builds a PREPARE message based on the passed in proposal
 func (c *consensusRuntime) BuildPrepareMessage(proposalHash []byte, view *proto.View) *proto.Message {
+	c.sequence.startPhase("prepare", view)
+
 	msg := proto.Message{
 		View: view,
 		From: c.ID(),
@@ -1006,7 +1050,9 @@ func (c *consensusRuntime) BuildPrepareMessage(proposalHash []byte, view *proto.
 
 // BuildCommitMessage builds a COMMIT message based on the passed in proposal
 func (c *consensusRuntime) BuildCommitMessage(proposalHash []byte, view *proto.View) *proto.Message {
-	committedSeal, err := c.config.Key.SignWithDomain(proposalHash, bls.DomainCheckpointManager)
+	c.sequence.startPhase("commit", view)
+
+	committedSeal, err := c.config.Key.SignCommittedSeal(proposalHash, view)
 	if err != nil {
 		c.logger.Error("Cannot create committed seal message.", "error", err)
 
@@ -1041,6 +1087,8 @@ func (c *consensusRuntime) BuildRoundChangeMessage(
 	certificate *proto.PreparedCertificate,
 	view *proto.View,
 ) *proto.Message {
+	c.sequence.startPhase("round_change", view)
+
 	msg := proto.Message{
 		View: view,
 		From: c.ID(),
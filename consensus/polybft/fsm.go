@@ -2,6 +2,7 @@ package polybft
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -343,8 +344,8 @@ func (f *fsm) ValidateCommit(signer []byte, seal []byte, proposalHash []byte) er
 	return nil
 }
 
-// Validate validates a raw proposal (used if non-proposer)
-func (f *fsm) Validate(proposal []byte) error {
+// Validate validates a raw proposal (used if non-proposer), tracing its execution within the given context
+func (f *fsm) Validate(ctx context.Context, proposal []byte) error {
 	var block types.Block
 	if err := block.UnmarshalRLP(proposal); err != nil {
 		return fmt.Errorf("failed to validate, cannot decode block data. Error: %w", err)
@@ -423,7 +424,7 @@ func (f *fsm) Validate(proposal []byte) error {
 		f.logger.Trace("[FSM Validate]", "Block", block.Number(), "parent validators", validators)
 	}
 
-	stateBlock, err := f.backend.ProcessBlock(f.parent, &block)
+	stateBlock, err := f.backend.ProcessBlock(ctx, f.parent, &block)
 	if err != nil {
 		return err
 	}
@@ -563,8 +564,12 @@ func (f *fsm) VerifyStateTransactions(transactions []*types.Transaction) error {
 	return nil
 }
 
-// Insert inserts the sealed proposal
-func (f *fsm) Insert(proposal []byte, committedSeals []*messages.CommittedSeal) (*types.FullBlock, error) {
+// Insert inserts the sealed proposal, tracing the block write within the given context
+func (f *fsm) Insert(
+	ctx context.Context,
+	proposal []byte,
+	committedSeals []*messages.CommittedSeal,
+) (*types.FullBlock, error) {
 	newBlock := f.target
 
 	var proposedBlock types.Block
@@ -629,7 +634,7 @@ func (f *fsm) Insert(proposal []byte, committedSeals []*messages.CommittedSeal)
 	// Write extra data to header
 	newBlock.Block.Header.ExtraData = extra.MarshalRLPTo(nil)
 
-	if err := f.backend.CommitBlock(newBlock); err != nil {
+	if err := f.backend.CommitBlock(ctx, newBlock); err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -1036,7 +1037,7 @@ func TestFSM_Validate_ExitEventRootNotExpected(t *testing.T) {
 		forks:             &chain.Forks{chain.Governance: chain.NewFork(0)},
 	}
 
-	err = fsm.Validate(proposal)
+	err = fsm.Validate(context.Background(), proposal)
 	require.ErrorContains(t, err, "exit root hash not as expected")
 
 	polybftBackendMock.AssertExpectations(t)
@@ -1120,7 +1121,7 @@ func TestFSM_Validate_EpochEndingBlock_MismatchInDeltas(t *testing.T) {
 		forks:              &chain.Forks{chain.Governance: chain.NewFork(0)},
 	}
 
-	err = fsm.Validate(proposal)
+	err = fsm.Validate(context.Background(), proposal)
 	require.ErrorIs(t, err, errValidatorSetDeltaMismatch)
 
 	polybftBackendMock.AssertExpectations(t)
@@ -1195,7 +1196,7 @@ func TestFSM_Validate_EpochEndingBlock_UpdatingValidatorSetInNonEpochEndingBlock
 		forks:          &chain.Forks{chain.Governance: chain.NewFork(0)},
 	}
 
-	err = fsm.Validate(proposal)
+	err = fsm.Validate(context.Background(), proposal)
 	require.ErrorIs(t, err, errValidatorsUpdateInNonEpochEnding)
 
 	polybftBackendMock.AssertExpectations(t)
@@ -1236,7 +1237,7 @@ func TestFSM_Validate_IncorrectHeaderParentHash(t *testing.T) {
 	stateBlock.Block.Header.Hash = hash
 	proposal := stateBlock.Block.MarshalRLP()
 
-	err = fsm.Validate(proposal)
+	err = fsm.Validate(context.Background(), proposal)
 	require.ErrorContains(t, err, "incorrect header parent hash")
 }
 
@@ -1275,7 +1276,7 @@ func TestFSM_Validate_InvalidNumber(t *testing.T) {
 		stateBlock.Block.Header.Hash = proposalHash
 		proposal := stateBlock.Block.MarshalRLP()
 
-		err = fsm.Validate(proposal)
+		err = fsm.Validate(context.Background(), proposal)
 		require.ErrorContains(t, err, "invalid number")
 	}
 }
@@ -1317,7 +1318,7 @@ func TestFSM_Validate_TimestampOlder(t *testing.T) {
 		stateBlock.Block.Header.Hash = checkpointHash
 		proposal := stateBlock.Block.MarshalRLP()
 
-		err = fsm.Validate(proposal)
+		err = fsm.Validate(context.Background(), proposal)
 		assert.ErrorContains(t, err, "timestamp older than parent")
 	}
 }
@@ -1359,7 +1360,7 @@ func TestFSM_Validate_IncorrectMixHash(t *testing.T) {
 	_, err := new(CheckpointData).Hash(fsm.backend.GetChainID(), header.Number, header.Hash)
 	require.NoError(t, err)
 
-	err = fsm.Validate(rlpBlock)
+	err = fsm.Validate(context.Background(), rlpBlock)
 	assert.ErrorContains(t, err, "mix digest is not correct")
 }
 
@@ -1425,7 +1426,7 @@ func TestFSM_Insert_Good(t *testing.T) {
 
 		fsm, seals, builtBlock, chainMock := setupFn()
 		proposal := builtBlock.Block.MarshalRLP()
-		fullBlock, err := fsm.Insert(proposal, seals)
+		fullBlock, err := fsm.Insert(context.Background(), proposal, seals)
 
 		require.NoError(t, err)
 		require.Equal(t, parentBlockNumber+1, fullBlock.Block.Number())
@@ -1438,7 +1439,7 @@ func TestFSM_Insert_Good(t *testing.T) {
 		fsm, seals, builtBlock, _ := setupFn()
 		fsm.target = nil
 		proposal := builtBlock.Block.MarshalRLP()
-		_, err := fsm.Insert(proposal, seals)
+		_, err := fsm.Insert(context.Background(), proposal, seals)
 
 		require.ErrorIs(t, err, errProposalDontMatch)
 	})
@@ -1450,7 +1451,7 @@ func TestFSM_Insert_Good(t *testing.T) {
 		proposal := builtBlock.Block.MarshalRLP()
 		fsm.target = builtBlock
 		fsm.target.Block.Header.Hash = types.BytesToHash(generateRandomBytes(t))
-		_, err := fsm.Insert(proposal, seals)
+		_, err := fsm.Insert(context.Background(), proposal, seals)
 
 		require.ErrorIs(t, err, errProposalDontMatch)
 	})
@@ -1509,7 +1510,7 @@ func TestFSM_Insert_InvalidNode(t *testing.T) {
 
 	fsm.target = buildBlock
 
-	_, err = fsm.Insert(proposal, commitedSeals)
+	_, err = fsm.Insert(context.Background(), proposal, commitedSeals)
 	assert.ErrorContains(t, err, "invalid node id")
 }
 
@@ -1642,7 +1643,7 @@ func TestFSM_Validate_FailToVerifySignatures(t *testing.T) {
 	finalBlock.Header.Hash = checkpointHash
 	proposal := finalBlock.MarshalRLP()
 
-	assert.ErrorContains(t, fsm.Validate(proposal), "failed to verify signatures")
+	assert.ErrorContains(t, fsm.Validate(context.Background(), proposal), "failed to verify signatures")
 
 	polybftBackendMock.AssertExpectations(t)
 }
@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"context"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
@@ -11,6 +12,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/mock"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/contract"
@@ -28,7 +30,7 @@ func (m *blockchainMock) CurrentHeader() *types.Header {
 	return args.Get(0).(*types.Header) //nolint:forcetypeassert
 }
 
-func (m *blockchainMock) CommitBlock(block *types.FullBlock) error {
+func (m *blockchainMock) CommitBlock(_ context.Context, block *types.FullBlock) error {
 	args := m.Called(block)
 
 	return args.Error(0)
@@ -41,7 +43,11 @@ func (m *blockchainMock) NewBlockBuilder(parent *types.Header, coinbase types.Ad
 	return args.Get(0).(blockBuilder), args.Error(1) //nolint:forcetypeassert
 }
 
-func (m *blockchainMock) ProcessBlock(parent *types.Header, block *types.Block) (*types.FullBlock, error) {
+func (m *blockchainMock) ProcessBlock(
+	_ context.Context,
+	parent *types.Header,
+	block *types.Block,
+) (*types.FullBlock, error) {
 	args := m.Called(parent, block)
 
 	return args.Get(0).(*types.FullBlock), args.Error(1) //nolint:forcetypeassert
@@ -376,6 +382,16 @@ func (tp *syncerMock) Sync(func(*types.FullBlock) bool) error {
 	return args.Error(0)
 }
 
+func (tp *syncerMock) PeerStatus(peerID peer.ID) *syncer.NoForkPeer {
+	args := tp.Called(peerID)
+
+	if status, ok := args.Get(0).(*syncer.NoForkPeer); ok {
+		return status
+	}
+
+	return nil
+}
+
 func init() {
 	// setup custom hash header func
 	setupHeaderHashFunc()
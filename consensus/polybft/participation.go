@@ -0,0 +1,67 @@
+package polybft
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// ValidatorParticipation returns how many of the given number of the latest blocks
+// were finalized while the node was a validator, and how many of them the node signed
+func (p *Polybft) ValidatorParticipation(blocks uint64) (uint64, uint64, error) {
+	return validatorParticipation(p.blockchain, p, types.Address(p.key.Address()), blocks)
+}
+
+// validatorParticipation counts the latest blocks finalized by the validator sets containing the address,
+// and the ones signed by the address. The signatures of a block are the committed ones
+// along with the parent signatures included in its child block, which can contain the late signers
+func validatorParticipation(blockchain blockchainBackend, backend polybftBackend,
+	address types.Address, blocks uint64) (uint64, uint64, error) {
+	var (
+		validated, signed uint64
+		header            = blockchain.CurrentHeader()
+		childExtra        *Extra
+	)
+
+	for i := uint64(0); i < blocks && header.Number > 0; i++ {
+		extra, err := GetIbftExtra(header.ExtraData)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to get the extra data of block %d: %w", header.Number, err)
+		}
+
+		validators, err := backend.GetValidators(header.Number-1, nil)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to get the validators of block %d: %w", header.Number, err)
+		}
+
+		if index := validators.Index(address); index >= 0 {
+			validated++
+
+			if hasSigned(extra.Committed, uint64(index)) ||
+				(childExtra != nil && hasSigned(childExtra.Parent, uint64(index))) {
+				signed++
+			}
+		}
+
+		parent, ok := blockchain.GetHeaderByNumber(header.Number - 1)
+		if !ok {
+			return 0, 0, fmt.Errorf("header of block %d not found", header.Number-1)
+		}
+
+		header, childExtra = parent, extra
+	}
+
+	return validated, signed, nil
+}
+
+// hasSigned returns true if the signature contains the signature of the validator with the given index
+func hasSigned(signature *Signature, index uint64) bool {
+	if signature == nil {
+		return false
+	}
+
+	signers := bitmap.Bitmap(signature.Bitmap)
+
+	return signers.IsSet(index)
+}
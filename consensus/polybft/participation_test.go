@@ -0,0 +1,78 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorParticipation(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidatorsWithAliases(t, []string{"A", "B", "C", "D"})
+	accounts := validators.GetPublicIdentities()
+
+	signers := func(indexes ...uint64) *Signature {
+		var b bitmap.Bitmap
+		for _, index := range indexes {
+			b.Set(index)
+		}
+
+		return &Signature{Bitmap: b, AggregatedSignature: []byte{1}}
+	}
+
+	// the committed signatures of the blocks and the parent signatures included in them,
+	// validator A with the index 0 signs the blocks 1, 3 and 4, the last one only late
+	extras := []*Extra{
+		{Committed: signers(0, 1, 2)},
+		{Committed: signers(1, 2, 3), Parent: signers(0, 1, 2)},
+		{Committed: signers(0, 1, 2), Parent: signers(1, 2, 3)},
+		{Committed: signers(1, 2, 3), Parent: signers(0, 1, 2)},
+		{Committed: signers(1, 2, 3), Parent: signers(0, 1, 2, 3)},
+	}
+
+	headersMap := &testHeadersMap{}
+	headersMap.addHeader(&types.Header{Number: 0})
+
+	for i, extra := range extras {
+		extra.Validators = &validator.ValidatorSetDelta{}
+		extra.Checkpoint = &CheckpointData{}
+
+		headersMap.addHeader(&types.Header{
+			Number:    uint64(i + 1),
+			ExtraData: extra.MarshalRLPTo(nil),
+		})
+	}
+
+	blockchainMock := new(blockchainMock)
+	blockchainMock.On("CurrentHeader").Return(headersMap.getHeader(uint64(len(extras))))
+	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
+
+	backendMock := new(polybftBackendMock)
+	backendMock.On("GetValidators", mock.Anything, mock.Anything).Return(accounts)
+
+	tests := []struct {
+		name      string
+		address   types.Address
+		blocks    uint64
+		validated uint64
+		signed    uint64
+	}{
+		{"latest blocks", validators.GetValidator("A").Address(), 4, 4, 2},
+		{"all blocks", validators.GetValidator("A").Address(), 10, 5, 3},
+		{"all signed", validators.GetValidator("B").Address(), 10, 5, 5},
+		{"not a validator", types.StringToAddress("0x1"), 10, 0, 0},
+	}
+
+	for _, test := range tests {
+		validated, signed, err := validatorParticipation(blockchainMock, backendMock, test.address, test.blocks)
+		require.NoError(t, err, test.name)
+		assert.Equal(t, test.validated, validated, test.name)
+		assert.Equal(t, test.signed, signed, test.name)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
@@ -26,6 +27,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 const (
@@ -53,6 +55,7 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 	polybft := &Polybft{
 		config:          params,
 		closeCh:         make(chan struct{}),
+		pausedCh:        make(chan struct{}, 1),
 		logger:          logger,
 		txPool:          params.TxPool,
 		ibftMsgHandlers: []IBFTMessageHandler{},
@@ -93,6 +96,10 @@ type Polybft struct {
 	// runtime handles consensus runtime features like epoch, state and event management
 	runtime *consensusRuntime
 
+	// validationRuntime is the runtime the gossip messages are validated against, set once it is initialized.
+	// The topics are created before the runtime, so their validators may run before it is set
+	validationRuntime atomic.Pointer[consensusRuntime]
+
 	// block time duration
 	blockTime time.Duration
 
@@ -122,6 +129,12 @@ type Polybft struct {
 
 	// ibftMsgHandlers contains IBFT consensus messages handlers
 	ibftMsgHandlers []IBFTMessageHandler
+
+	// paused is set by the operator to stop the validator from running the consensus sequences
+	paused atomic.Bool
+
+	// pausedCh notifies the consensus protocol the block production was paused or resumed
+	pausedCh chan struct{}
 }
 
 func GenesisPostHookFactory(config *chain.Chain, engineName string) func(txn *state.Transition) error {
@@ -447,14 +460,17 @@ func ForkManagerFactory(forks *chain.Forks) error {
 func (p *Polybft) Initialize() error {
 	p.logger.Info("initializing polybft...")
 
-	// read account
-	account, err := wallet.NewAccountFromSecret(p.config.SecretsManager)
-	if err != nil {
-		return fmt.Errorf("failed to read account data. Error: %w", err)
-	}
+	// set key, signing with the remote signer if it is set
+	if p.config.RemoteSigner != nil {
+		p.key = wallet.NewKeyFromSigner(p.config.RemoteSigner)
+	} else {
+		account, err := wallet.NewAccountFromSecret(p.config.SecretsManager)
+		if err != nil {
+			return fmt.Errorf("failed to read account data. Error: %w", err)
+		}
 
-	// set key
-	p.key = wallet.NewKey(account)
+		p.key = wallet.NewKey(account)
+	}
 
 	// create and set syncer
 	p.syncer = syncer.NewSyncer(
@@ -471,7 +487,7 @@ func (p *Polybft) Initialize() error {
 	}
 
 	// create bridge and consensus topics
-	if err = p.createTopics(); err != nil {
+	if err := p.createTopics(); err != nil {
 		return fmt.Errorf("cannot create topics: %w", err)
 	}
 
@@ -481,7 +497,7 @@ func (p *Polybft) Initialize() error {
 	// initialize polybft consensus data directory
 	p.dataDir = filepath.Join(p.config.Config.Path, "polybft")
 	// create the data dir if not exists
-	if err = common.CreateDirSafe(p.dataDir, 0750); err != nil {
+	if err := common.CreateDirSafe(p.dataDir, 0750); err != nil {
 		return fmt.Errorf("failed to create data directory. Error: %w", err)
 	}
 
@@ -582,6 +598,7 @@ func (p *Polybft) initRuntime() error {
 	}
 
 	p.runtime = runtime
+	p.validationRuntime.Store(runtime)
 	// register double signing tracker as IBFT messages handler
 	p.ibftMsgHandlers = append(p.ibftMsgHandlers, runtime.doubleSigningTracker)
 
@@ -646,10 +663,17 @@ func (p *Polybft) startConsensusProtocol() {
 
 		p.txPool.SetSealing(isValidator) // update tx pool
 
-		if isValidator {
+		// the paused validator keeps following the chain, but does not take part in the consensus
+		isProducing := isValidator && !p.paused.Load()
+		sequenceCh = nil
+
+		if isProducing {
+			p.runtime.sequence.startSequence(latestHeader.Number + 1)
+
 			// initialize FSM as a stateless ibft backend via runtime as an adapter
 			err = p.runtime.FSM()
 			if err != nil {
+				p.runtime.sequence.endSequence()
 				p.logger.Error("failed to create fsm", "block number", latestHeader.Number, "error", err)
 
 				continue
@@ -662,23 +686,50 @@ func (p *Polybft) startConsensusProtocol() {
 
 		select {
 		case <-syncerBlockCh:
-			if isValidator {
+			if isProducing {
 				stopSequence()
 				p.logger.Info("canceled sequence", "sequence", latestHeader.Number+1)
 			}
+		case <-p.pausedCh:
+			if isProducing {
+				stopSequence()
+				p.logger.Info("canceled sequence, block production paused", "sequence", latestHeader.Number+1)
+			}
 		case <-sequenceCh:
 		case <-p.closeCh:
-			if isValidator {
+			if isProducing {
 				stopSequence()
 			}
 
+			p.runtime.sequence.endSequence()
+
 			return
 		}
 
+		p.runtime.sequence.endSequence()
 		p.logger.Debug("time to run the sequence", "seconds", time.Since(now))
 	}
 }
 
+// SetBlockProductionPaused pauses or resumes the participation of the validator in the consensus
+func (p *Polybft) SetBlockProductionPaused(paused bool) {
+	if p.paused.Swap(paused) == paused {
+		return
+	}
+
+	p.logger.Info("block production changed", "paused", paused)
+
+	select {
+	case p.pausedCh <- struct{}{}:
+	default:
+	}
+}
+
+// IsBlockProductionPaused returns true if the validator does not participate in the consensus
+func (p *Polybft) IsBlockProductionPaused() bool {
+	return p.paused.Load()
+}
+
 func (p *Polybft) waitForNPeers() bool {
 	for {
 		select {
@@ -714,6 +765,16 @@ func (p *Polybft) GetSyncProgression() *progress.Progression {
 	return p.syncer.GetSyncProgression()
 }
 
+// GetSyncPeerHead returns the latest block number reported by the peer, if known
+func (p *Polybft) GetSyncPeerHead(peerID peer.ID) (uint64, bool) {
+	status := p.syncer.PeerStatus(peerID)
+	if status == nil {
+		return 0, false
+	}
+
+	return status.Number, true
+}
+
 // VerifyHeader implements consensus.Engine and checks whether a header conforms to the consensus rules
 func (p *Polybft) VerifyHeader(header *types.Header) error {
 	// Short circuit if the header is known
@@ -252,6 +252,27 @@ func TestPolybft_GetSyncProgression(t *testing.T) {
 	assert.Equal(t, result, polybft.GetSyncProgression())
 }
 
+func TestPolybft_SetBlockProductionPaused(t *testing.T) {
+	t.Parallel()
+
+	polybft := &Polybft{
+		pausedCh: make(chan struct{}, 1),
+		logger:   hclog.NewNullLogger(),
+	}
+
+	polybft.SetBlockProductionPaused(true)
+	assert.True(t, polybft.IsBlockProductionPaused())
+
+	// the consensus protocol is notified once
+	polybft.SetBlockProductionPaused(true)
+	require.Len(t, polybft.pausedCh, 1)
+	<-polybft.pausedCh
+
+	polybft.SetBlockProductionPaused(false)
+	assert.False(t, polybft.IsBlockProductionPaused())
+	require.Len(t, polybft.pausedCh, 1)
+}
+
 func Test_Factory(t *testing.T) {
 	t.Parallel()
 
@@ -192,7 +192,8 @@ func (s *stateSyncManager) saveVote(msg *TransportMessage) error {
 		return nil
 	}
 
-	if err := s.verifyVoteSignature(valSet, types.StringToAddress(msg.From), msg.Signature, msg.Hash); err != nil {
+	err := verifyVoteSignature(valSet.Accounts(), types.StringToAddress(msg.From), msg.Signature, msg.Hash)
+	if err != nil {
 		return fmt.Errorf("error verifying vote signature: %w", err)
 	}
 
@@ -217,9 +218,9 @@ func (s *stateSyncManager) saveVote(msg *TransportMessage) error {
 }
 
 // Verifies signature of the message against the public key of the signer and checks if the signer is a validator
-func (s *stateSyncManager) verifyVoteSignature(valSet validator.ValidatorSet, signer types.Address, signature []byte,
+func verifyVoteSignature(validators validator.AccountSet, signer types.Address, signature []byte,
 	hash []byte) error {
-	validator := valSet.Accounts().GetValidatorMetadata(signer)
+	validator := validators.GetValidatorMetadata(signer)
 	if validator == nil {
 		return fmt.Errorf("unable to resolve validator %s", signer)
 	}
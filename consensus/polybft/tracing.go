@@ -0,0 +1,110 @@
+package polybft
+
+import (
+	"context"
+	"sync"
+
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/common"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+)
+
+var spanTracer = tracing.Tracer("polybft")
+
+// postBlockHandler is the component updating its state (and its bolt buckets) once the block is inserted
+type postBlockHandler interface {
+	PostBlock(req *common.PostBlockRequest) error
+}
+
+// sequenceTracer traces the consensus sequence of a single height, along with the phases
+// of its rounds. The zero value is ready to use
+type sequenceTracer struct {
+	lock sync.Mutex
+
+	sequenceCtx context.Context
+	sequence    trace.Span
+
+	phaseCtx context.Context
+	phase    trace.Span
+}
+
+// startSequence starts the span of the sequence for the given height, ending the previous one
+func (s *sequenceTracer) startSequence(height uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.endLocked()
+
+	s.sequenceCtx, s.sequence = spanTracer.Start(context.Background(), "polybft.sequence",
+		trace.WithAttributes(attribute.Int64("block.number", int64(height))))
+}
+
+// startPhase starts the span of the round phase (prepare, commit, round change)
+// within the running sequence, ending the previous phase
+func (s *sequenceTracer) startPhase(phase string, view *proto.View) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.sequence == nil {
+		return
+	}
+
+	if s.phase != nil {
+		s.phase.End()
+	}
+
+	s.phaseCtx, s.phase = spanTracer.Start(s.sequenceCtx, "polybft."+phase, trace.WithAttributes(
+		attribute.Int64("block.number", int64(view.GetHeight())),
+		attribute.Int64("round", int64(view.GetRound())),
+	))
+}
+
+// context returns the context of the current phase, or of the sequence if no phase is started yet
+func (s *sequenceTracer) context() context.Context {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch {
+	case s.phase != nil:
+		return s.phaseCtx
+	case s.sequence != nil:
+		return s.sequenceCtx
+	default:
+		return context.Background()
+	}
+}
+
+// endSequence ends the spans of the sequence and its current phase
+func (s *sequenceTracer) endSequence() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.endLocked()
+}
+
+func (s *sequenceTracer) endLocked() {
+	if s.phase != nil {
+		s.phase.End()
+	}
+
+	if s.sequence != nil {
+		s.sequence.End()
+	}
+
+	s.sequenceCtx, s.sequence = nil, nil
+	s.phaseCtx, s.phase = nil, nil
+}
+
+// tracePostBlock runs the post block callback of the handler within the span named after it
+func tracePostBlock(ctx context.Context, name string, handler postBlockHandler,
+	req *common.PostBlockRequest) error {
+	_, span := spanTracer.Start(ctx, "polybft.post_block."+name)
+	err := handler.PostBlock(req)
+
+	tracing.End(span, err)
+
+	return err
+}
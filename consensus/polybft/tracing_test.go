@@ -0,0 +1,51 @@
+package polybft
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSequenceTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	var tracer sequenceTracer
+
+	// nothing is traced outside of the sequence
+	tracer.startPhase("prepare", &proto.View{Height: 5})
+	assert.Equal(t, context.Background(), tracer.context())
+
+	tracer.startSequence(5)
+	sequenceCtx := tracer.context()
+
+	tracer.startPhase("prepare", &proto.View{Height: 5})
+	tracer.startPhase("commit", &proto.View{Height: 5})
+	assert.NotEqual(t, sequenceCtx, tracer.context())
+
+	tracer.endSequence()
+	tracer.endSequence()
+	assert.Equal(t, context.Background(), tracer.context())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	assert.Equal(t, "polybft.prepare", spans[0].Name())
+	assert.Equal(t, "polybft.commit", spans[1].Name())
+	assert.Equal(t, "polybft.sequence", spans[2].Name())
+
+	for _, span := range spans[:2] {
+		assert.Equal(t, spans[2].SpanContext().SpanID(), span.Parent().SpanID())
+	}
+}
@@ -1,10 +1,14 @@
 package polybft
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 
 	ibftProto "github.com/0xPolygon/go-ibft/messages/proto"
 	polybftProto "github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
@@ -55,13 +59,23 @@ func (p *Polybft) subscribeToIbftTopic() error {
 func (p *Polybft) createTopics() (err error) {
 	if p.genesisClientConfig.IsBridgeEnabled() {
 		// this is ok to ask, since bridge configuration will not be changed through governance
-		p.bridgeTopic, err = p.config.Network.NewTopic(bridgeProto, &polybftProto.TransportMessage{})
+		p.bridgeTopic, err = p.config.Network.NewTopic(
+			bridgeProto,
+			&polybftProto.TransportMessage{},
+			network.WithTopicValidator(p.validateBridgeMessage),
+			network.WithTopicScoreParams(network.ValidatorTopicScoreParams()),
+		)
 		if err != nil {
 			return fmt.Errorf("failed to create bridge topic: %w", err)
 		}
 	}
 
-	p.consensusTopic, err = p.config.Network.NewTopic(pbftProto, &ibftProto.Message{})
+	p.consensusTopic, err = p.config.Network.NewTopic(
+		pbftProto,
+		&ibftProto.Message{},
+		network.WithTopicValidator(p.validateIbftMessage),
+		network.WithTopicScoreParams(network.ValidatorTopicScoreParams()),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to create consensus topic: %w", err)
 	}
@@ -69,6 +83,84 @@ func (p *Polybft) createTopics() (err error) {
 	return nil
 }
 
+// validateIbftMessage rejects the consensus messages which are not sent by a validator of the current epoch,
+// if they are of its blocks. The messages of the other epochs are ignored if not sent by a validator
+// of the current one, since the validators of those epochs are not known
+func (p *Polybft) validateIbftMessage(obj interface{}, from peer.ID) network.ValidationResult {
+	if result := validateIbftMessage(obj, from); result != network.ValidationAccept {
+		return result
+	}
+
+	runtime := p.validationRuntime.Load()
+	if runtime == nil {
+		return network.ValidationIgnore
+	}
+
+	var (
+		msg, _              = obj.(*ibftProto.Message)
+		epoch, latestNumber = runtime.getCurrentEpoch()
+		height              = msg.View.Height
+	)
+
+	switch {
+	case epoch.Validators.ContainsAddress(types.BytesToAddress(msg.From)):
+		return network.ValidationAccept
+	case height >= epoch.FirstBlockInEpoch && height <= latestNumber+1:
+		return network.ValidationReject
+	default:
+		return network.ValidationIgnore
+	}
+}
+
+// validateBridgeMessage rejects the bridge votes which are malformed or not signed by a validator
+// of the current epoch. The votes of the other epochs are ignored, since they are not collected
+func (p *Polybft) validateBridgeMessage(obj interface{}, _ peer.ID) network.ValidationResult {
+	msg, ok := obj.(*polybftProto.TransportMessage)
+	if !ok {
+		return network.ValidationReject
+	}
+
+	var vote *TransportMessage
+	if err := json.Unmarshal(msg.Data, &vote); err != nil || vote == nil ||
+		len(vote.Hash) == 0 || len(vote.Signature) == 0 {
+		return network.ValidationReject
+	}
+
+	runtime := p.validationRuntime.Load()
+	if runtime == nil {
+		return network.ValidationIgnore
+	}
+
+	epoch, _ := runtime.getCurrentEpoch()
+	if vote.EpochNumber != epoch.Number {
+		return network.ValidationIgnore
+	}
+
+	if err := verifyVoteSignature(
+		epoch.Validators, types.StringToAddress(vote.From), vote.Signature, vote.Hash,
+	); err != nil {
+		return network.ValidationReject
+	}
+
+	return network.ValidationAccept
+}
+
+// validateIbftMessage rejects the consensus messages which are malformed or not signed by the sender,
+// before they are propagated
+func validateIbftMessage(obj interface{}, _ peer.ID) network.ValidationResult {
+	msg, ok := obj.(*ibftProto.Message)
+	if !ok || msg.View == nil || msg.Payload == nil {
+		return network.ValidationReject
+	}
+
+	signer, err := wallet.RecoverSignerFromIBFTMessage(msg)
+	if err != nil || !bytes.Equal(msg.From, signer.Bytes()) {
+		return network.ValidationReject
+	}
+
+	return network.ValidationAccept
+}
+
 // Multicast is implementation of core.Transport interface
 func (p *Polybft) Multicast(msg *ibftProto.Message) {
 	p.ibftMsgMulticast(msg)
@@ -0,0 +1,151 @@
+package polybft
+
+import (
+	"encoding/json"
+	"testing"
+
+	ibftProto "github.com/0xPolygon/go-ibft/messages/proto"
+	polybftProto "github.com/0xPolygon/polygon-edge/consensus/polybft/proto"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport_ValidateIbftMessage(t *testing.T) {
+	t.Parallel()
+
+	account, err := wallet.GenerateAccount()
+	require.NoError(t, err)
+
+	key := wallet.NewKey(account)
+
+	otherAccount, err := wallet.GenerateAccount()
+	require.NoError(t, err)
+
+	newMessage := func(from []byte) *ibftProto.Message {
+		return &ibftProto.Message{
+			View: &ibftProto.View{Height: 1, Round: 0},
+			From: from,
+			Type: ibftProto.MessageType_PREPARE,
+			Payload: &ibftProto.Message_PrepareData{
+				PrepareData: &ibftProto.PrepareMessage{
+					ProposalHash: types.StringToHash("0x1").Bytes(),
+				},
+			},
+		}
+	}
+
+	validMsg, err := key.SignIBFTMessage(newMessage(key.Address().Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, network.ValidationAccept, validateIbftMessage(validMsg, ""))
+
+	// signed by the key which is not the sender
+	spoofedMsg, err := key.SignIBFTMessage(newMessage(otherAccount.Ecdsa.Address().Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, network.ValidationReject, validateIbftMessage(spoofedMsg, ""))
+
+	// not signed at all
+	assert.Equal(t, network.ValidationReject, validateIbftMessage(newMessage(key.Address().Bytes()), ""))
+
+	// without the view
+	noViewMsg, err := key.SignIBFTMessage(&ibftProto.Message{From: key.Address().Bytes()})
+	require.NoError(t, err)
+	assert.Equal(t, network.ValidationReject, validateIbftMessage(noViewMsg, ""))
+}
+
+func TestTransport_ValidateIbftMessageSender(t *testing.T) {
+	t.Parallel()
+
+	vals := validator.NewTestValidatorsWithAliases(t, []string{"A", "B"})
+	nonValidator := validator.NewTestValidator(t, "non validator", 1)
+
+	p := &Polybft{}
+
+	newMessage := func(key *wallet.Key, height uint64) *ibftProto.Message {
+		msg, err := key.SignIBFTMessage(&ibftProto.Message{
+			View: &ibftProto.View{Height: height, Round: 0},
+			From: key.Address().Bytes(),
+			Type: ibftProto.MessageType_PREPARE,
+			Payload: &ibftProto.Message_PrepareData{
+				PrepareData: &ibftProto.PrepareMessage{
+					ProposalHash: types.StringToHash("0x1").Bytes(),
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		return msg
+	}
+
+	validatorKey := vals.GetValidator("A").Key()
+
+	// the messages are not validated against the validators until the runtime is set
+	assert.Equal(t, network.ValidationIgnore, p.validateIbftMessage(newMessage(validatorKey, 12), ""))
+
+	p.validationRuntime.Store(&consensusRuntime{
+		epoch: &epochMetadata{
+			Number:            2,
+			FirstBlockInEpoch: 11,
+			Validators:        vals.GetPublicIdentities(),
+		},
+		lastBuiltBlock: &types.Header{Number: 11},
+	})
+
+	assert.Equal(t, network.ValidationAccept, p.validateIbftMessage(newMessage(validatorKey, 12), ""))
+	assert.Equal(t, network.ValidationReject, p.validateIbftMessage(newMessage(nonValidator.Key(), 12), ""))
+
+	// the validators of the other epochs are not known
+	assert.Equal(t, network.ValidationIgnore, p.validateIbftMessage(newMessage(nonValidator.Key(), 10), ""))
+	assert.Equal(t, network.ValidationIgnore, p.validateIbftMessage(newMessage(nonValidator.Key(), 13), ""))
+}
+
+func TestTransport_ValidateBridgeMessage(t *testing.T) {
+	t.Parallel()
+
+	vals := validator.NewTestValidatorsWithAliases(t, []string{"A", "B"})
+	nonValidator := validator.NewTestValidator(t, "non validator", 1)
+
+	p := &Polybft{}
+	p.validationRuntime.Store(&consensusRuntime{
+		epoch: &epochMetadata{
+			Number:     2,
+			Validators: vals.GetPublicIdentities(),
+		},
+		lastBuiltBlock: &types.Header{Number: 11},
+	})
+
+	newMessage := func(signer *validator.TestValidator, from types.Address, epoch uint64) *polybftProto.TransportMessage {
+		vote, err := newMockMsg().sign(signer, bls.DomainStateReceiver)
+		require.NoError(t, err)
+
+		vote.From = from.String()
+		vote.EpochNumber = epoch
+
+		data, err := json.Marshal(vote)
+		require.NoError(t, err)
+
+		return &polybftProto.TransportMessage{Data: data}
+	}
+
+	validatorA := vals.GetValidator("A")
+
+	assert.Equal(t, network.ValidationAccept,
+		p.validateBridgeMessage(newMessage(validatorA, validatorA.Address(), 2), ""))
+
+	// signed by the non validator, or by another validator than the sender
+	assert.Equal(t, network.ValidationReject,
+		p.validateBridgeMessage(newMessage(nonValidator, nonValidator.Address(), 2), ""))
+	assert.Equal(t, network.ValidationReject,
+		p.validateBridgeMessage(newMessage(validatorA, vals.GetValidator("B").Address(), 2), ""))
+
+	// the votes of the other epochs are not collected
+	assert.Equal(t, network.ValidationIgnore,
+		p.validateBridgeMessage(newMessage(nonValidator, nonValidator.Address(), 1), ""))
+
+	assert.Equal(t, network.ValidationReject,
+		p.validateBridgeMessage(&polybftProto.TransportMessage{Data: []byte("vote")}, ""))
+}
@@ -12,24 +12,47 @@ import (
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
+// Signer signs the digests and the consensus messages with the validator ECDSA and BLS keys.
+// It allows the keys to be held outside of the node process (i.e. by a remote signer)
+type Signer interface {
+	// ECDSAAddress returns the address of the ECDSA key
+	ECDSAAddress() ethgo.Address
+	// SignECDSA signs the digest with the ECDSA key
+	SignECDSA(digest []byte) ([]byte, error)
+	// SignBLS signs the digest with the BLS key and the given domain, returning the marshaled signature
+	SignBLS(digest, domain []byte) ([]byte, error)
+	// SignBLSCommittedSeal signs the committed seal of the proposal at the given height and round
+	// with the BLS key, returning the marshaled signature
+	SignBLSCommittedSeal(proposalHash []byte, height, round uint64) ([]byte, error)
+	// SignIBFTMessage signs the keccak256 hash of the marshaled IBFT message with the ECDSA key
+	SignIBFTMessage(payload []byte) ([]byte, error)
+}
+
 type Key struct {
-	raw *Account
+	signer Signer
 }
 
 func NewKey(raw *Account) *Key {
 	return &Key{
-		raw: raw,
+		signer: &accountSigner{account: raw},
+	}
+}
+
+// NewKeyFromSigner creates the key signing with the given signer
+func NewKeyFromSigner(signer Signer) *Key {
+	return &Key{
+		signer: signer,
 	}
 }
 
 // String returns hex encoded ECDSA address
 func (k *Key) String() string {
-	return k.raw.Ecdsa.Address().String()
+	return k.signer.ECDSAAddress().String()
 }
 
 // Address returns ECDSA address
 func (k *Key) Address() ethgo.Address {
-	return k.raw.Ecdsa.Address()
+	return k.signer.ECDSAAddress()
 }
 
 // Sign signs the provided digest with BLS key
@@ -40,12 +63,12 @@ func (k *Key) Sign(digest []byte) ([]byte, error) {
 
 // SignWithDomain signs the provided digest with BLS key and provided domain
 func (k *Key) SignWithDomain(digest, domain []byte) ([]byte, error) {
-	signature, err := k.raw.Bls.Sign(digest, domain)
-	if err != nil {
-		return nil, err
-	}
+	return k.signer.SignBLS(digest, domain)
+}
 
-	return signature.Marshal()
+// SignCommittedSeal signs the hash of the proposal committed in the given view with BLS key
+func (k *Key) SignCommittedSeal(proposalHash []byte, view *ibftProto.View) ([]byte, error) {
+	return k.signer.SignBLSCommittedSeal(proposalHash, view.GetHeight(), view.GetRound())
 }
 
 // SignIBFTMessage signs the IBFT consensus message with ECDSA key
@@ -55,7 +78,7 @@ func (k *Key) SignIBFTMessage(msg *ibftProto.Message) (*ibftProto.Message, error
 		return nil, fmt.Errorf("cannot marshal message: %w", err)
 	}
 
-	if msg.Signature, err = k.raw.Ecdsa.Sign(crypto.Keccak256(msgRaw)); err != nil {
+	if msg.Signature, err = k.signer.SignIBFTMessage(msgRaw); err != nil {
 		return nil, fmt.Errorf("cannot create message signature: %w", err)
 	}
 
@@ -99,5 +122,35 @@ func NewEcdsaSigner(ecdsaKey *Key) *ECDSASigner {
 }
 
 func (k *ECDSASigner) Sign(b []byte) ([]byte, error) {
-	return k.raw.Ecdsa.Sign(b)
+	return k.signer.SignECDSA(b)
+}
+
+// accountSigner is the Signer using the keys of the account loaded into memory
+type accountSigner struct {
+	account *Account
+}
+
+func (s *accountSigner) ECDSAAddress() ethgo.Address {
+	return s.account.Ecdsa.Address()
+}
+
+func (s *accountSigner) SignECDSA(digest []byte) ([]byte, error) {
+	return s.account.Ecdsa.Sign(digest)
+}
+
+func (s *accountSigner) SignBLS(digest, domain []byte) ([]byte, error) {
+	signature, err := s.account.Bls.Sign(digest, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature.Marshal()
+}
+
+func (s *accountSigner) SignBLSCommittedSeal(proposalHash []byte, _, _ uint64) ([]byte, error) {
+	return s.SignBLS(proposalHash, bls.DomainCheckpointManager)
+}
+
+func (s *accountSigner) SignIBFTMessage(payload []byte) ([]byte, error) {
+	return s.account.Ecdsa.Sign(crypto.Keccak256(payload))
 }
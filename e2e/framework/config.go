@@ -63,6 +63,9 @@ type TestServerConfig struct {
 	IBFTBaseTimeout         uint64                   // Base Timeout in seconds for IBFT
 	PredeployParams         *PredeployParams
 	BurnContracts           map[uint64]types.Address
+	Libp2pQUIC              bool     // Flag specifying if libp2p listens on QUIC
+	Libp2pSecurity          string   // The preferred security protocol of libp2p TCP connections
+	Libp2pListen            []string // The additional libp2p listen multiaddrs
 }
 
 func (t *TestServerConfig) SetPredeployParams(params *PredeployParams) {
@@ -217,3 +220,18 @@ func (t *TestServerConfig) SetLogsDir(dir string) {
 func (t *TestServerConfig) SetName(name string) {
 	t.Name = name
 }
+
+// SetLibp2pQUIC sets if libp2p listens on QUIC, besides TCP
+func (t *TestServerConfig) SetLibp2pQUIC(quic bool) {
+	t.Libp2pQUIC = quic
+}
+
+// SetLibp2pSecurity sets the preferred security protocol of libp2p TCP connections
+func (t *TestServerConfig) SetLibp2pSecurity(security string) {
+	t.Libp2pSecurity = security
+}
+
+// SetLibp2pListen sets the additional libp2p listen multiaddrs, besides the base address
+func (t *TestServerConfig) SetLibp2pListen(addrs []string) {
+	t.Libp2pListen = addrs
+}
@@ -388,6 +388,10 @@ func ToLocalIPv4LibP2pAddr(port int, nodeID string) string {
 	return fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", port, nodeID)
 }
 
+func ToLocalIPv4LibP2pQUICAddr(port int, nodeID string) string {
+	return fmt.Sprintf("/ip4/127.0.0.1/udp/%d/quic-v1/p2p/%s", port, nodeID)
+}
+
 // ReservedPort keeps available port until use
 type ReservedPort struct {
 	port     int
@@ -74,6 +74,9 @@ func NewIBFTServersManager(
 		}
 
 		libp2pAddr := ToLocalIPv4LibP2pAddr(srv.Config.LibP2PPort, res.NodeID)
+		if srv.Config.Libp2pQUIC {
+			libp2pAddr = ToLocalIPv4LibP2pQUICAddr(srv.Config.LibP2PPort, res.NodeID)
+		}
 
 		srvs = append(srvs, srv)
 		bootnodes = append(bootnodes, libp2pAddr)
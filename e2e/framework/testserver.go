@@ -443,6 +443,18 @@ func (t *TestServer) Start(ctx context.Context) error {
 		args = append(args, "--price-limit", strconv.FormatUint(*t.Config.PriceLimit, 10))
 	}
 
+	if t.Config.Libp2pQUIC {
+		args = append(args, "--libp2p-quic")
+	}
+
+	if t.Config.Libp2pSecurity != "" {
+		args = append(args, "--libp2p-security", t.Config.Libp2pSecurity)
+	}
+
+	for _, addr := range t.Config.Libp2pListen {
+		args = append(args, "--libp2p-listen", addr)
+	}
+
 	if t.Config.ShowsLog || t.Config.SaveLogs {
 		args = append(args, "--log-level", "debug")
 	}
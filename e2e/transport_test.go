@@ -0,0 +1,102 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/e2e/framework"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransports makes sure the IBFT cluster connects and produces blocks
+// over the alternative libp2p transports and security protocols
+func TestTransports(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quic     bool
+		security string
+	}{
+		{
+			name:     "QUIC",
+			quic:     true,
+			security: network.SecurityNoise,
+		},
+		{
+			name:     "TCP with TLS",
+			quic:     false,
+			security: network.SecurityTLS,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ibftManager := framework.NewIBFTServersManager(t,
+				IBFTMinNodes,
+				IBFTDirPrefix,
+				func(i int, config *framework.TestServerConfig) {
+					config.SetLibp2pQUIC(tc.quic)
+					config.SetLibp2pSecurity(tc.security)
+				},
+			)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			// the servers are ready once they advance a block
+			ibftManager.StartServers(ctx)
+
+			for i := 0; i < IBFTMinNodes; i++ {
+				res, err := framework.WaitUntilPeerConnects(ctx, ibftManager.GetServer(i), IBFTMinNodes-1)
+				if !assert.NoError(t, err) {
+					continue
+				}
+
+				assert.Len(t, res.Peers, IBFTMinNodes-1)
+			}
+		})
+	}
+}
+
+// TestTransports_ListenAddresses makes sure the IBFT cluster connects
+// while the nodes listen on the additional libp2p addresses
+func TestTransports_ListenAddresses(t *testing.T) {
+	listenPorts := make([]int, IBFTMinNodes)
+
+	ibftManager := framework.NewIBFTServersManager(t,
+		IBFTMinNodes,
+		IBFTDirPrefix,
+		func(i int, config *framework.TestServerConfig) {
+			// the port is reserved until the server starts
+			port := framework.FindAvailablePort(config.LibP2PPort+1, config.LibP2PPort+1000)
+			require.NotNil(t, port)
+
+			listenPorts[i] = port.Port()
+
+			config.ReservedPorts = append(config.ReservedPorts, *port)
+			config.SetLibp2pListen([]string{fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", port.Port())})
+		},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	ibftManager.StartServers(ctx)
+
+	for i := 0; i < IBFTMinNodes; i++ {
+		res, err := framework.WaitUntilPeerConnects(ctx, ibftManager.GetServer(i), IBFTMinNodes-1)
+		if assert.NoError(t, err) {
+			assert.Len(t, res.Peers, IBFTMinNodes-1)
+		}
+
+		// the additional address is served by the node
+		conn, err := net.DialTimeout("tcp", fmt.Sprintf("127.0.0.1:%d", listenPorts[i]), time.Second)
+		if assert.NoError(t, err) {
+			conn.Close()
+		}
+	}
+}
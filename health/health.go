@@ -0,0 +1,272 @@
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// HealthPath is the path of the liveness endpoint
+	HealthPath = "/health"
+
+	// ReadyPath is the path of the readiness endpoint
+	ReadyPath = "/ready"
+)
+
+const (
+	// DefaultMaxSyncLag is the default number of blocks the ready node can be behind the sync target
+	DefaultMaxSyncLag uint64 = 10
+
+	// DefaultMinPeers is the default minimal number of the connected peers of the ready node
+	DefaultMinPeers uint64 = 1
+
+	// DefaultMaxHeadAge is the default maximal age of the head of the chain in the block times
+	DefaultMaxHeadAge uint64 = 10
+
+	// DefaultParticipationWindow is the default number of the latest blocks
+	// in which the participation of the validator is checked
+	DefaultParticipationWindow uint64 = 20
+
+	// DefaultMinParticipation is the default minimal number of the blocks
+	// in the participation window the validator has to sign
+	DefaultMinParticipation uint64 = 1
+)
+
+// Config holds the thresholds of the health checks
+type Config struct {
+	// MaxSyncLag is the number of blocks the node can be behind the sync target and still be ready
+	MaxSyncLag uint64
+
+	// MinPeers is the minimal number of the connected peers of the ready node
+	MinPeers uint64
+
+	// MaxHeadAge is the maximal age of the head of the chain in the block times,
+	// the head age is not checked if it is zero or the block time is not known
+	MaxHeadAge uint64
+
+	// BlockTime is the expected block time of the chain, zero if the blocks are not produced regularly
+	BlockTime time.Duration
+
+	// ParticipationWindow is the number of the latest blocks in which the participation
+	// of the validator is checked, the participation is not checked if it is zero
+	ParticipationWindow uint64
+
+	// MinParticipation is the minimal number of the blocks in the participation window
+	// the validator has to sign to be ready
+	MinParticipation uint64
+}
+
+// DefaultConfig returns the default thresholds of the health checks
+func DefaultConfig() *Config {
+	return &Config{
+		MaxSyncLag:          DefaultMaxSyncLag,
+		MinPeers:            DefaultMinPeers,
+		MaxHeadAge:          DefaultMaxHeadAge,
+		ParticipationWindow: DefaultParticipationWindow,
+		MinParticipation:    DefaultMinParticipation,
+	}
+}
+
+// Checker serves the health and the readiness checks of the node to the orchestrators.
+// The node is healthy as long as its chain can be read, and it is ready once it is synced,
+// connected to the peers and, if it is a validator, takes part in the block finalization.
+// The head age is checked by the readiness only, as the whole chain halting must not restart all of its nodes
+type Checker struct {
+	logger        hclog.Logger
+	config        *Config
+	backend       Backend
+	participation Participation // nil if the consensus does not report it
+
+	now func() time.Time
+}
+
+// NewChecker creates the health checker, the participation can be nil
+// if the consensus of the node does not report it
+func NewChecker(logger hclog.Logger, config *Config, backend Backend, participation Participation) *Checker {
+	return &Checker{
+		logger:        logger.Named("health"),
+		config:        config,
+		backend:       backend,
+		participation: participation,
+		now:           time.Now,
+	}
+}
+
+// Handlers returns the HTTP handlers of the health and the readiness endpoints by their paths
+func (c *Checker) Handlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		HealthPath: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			c.writeReport(w, c.Health())
+		}),
+		ReadyPath: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			c.writeReport(w, c.Ready())
+		}),
+	}
+}
+
+// Health runs the liveness checks of the node
+func (c *Checker) Health() *Report {
+	return newReport(c.checkChain())
+}
+
+// Ready runs the readiness checks of the node
+func (c *Checker) Ready() *Report {
+	return newReport(
+		c.checkSync(),
+		c.checkPeers(),
+		c.checkHeadAge(),
+		c.checkParticipation(),
+	)
+}
+
+// writeReport writes out the report, with the service unavailable status if any of its checks failed
+func (c *Checker) writeReport(w http.ResponseWriter, report *Report) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if !report.OK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		c.logger.Debug("failed to write the health report", "err", err)
+	}
+}
+
+// checkChain fails if the head of the chain can not be read back from the storage
+func (c *Checker) checkChain() *Check {
+	check := &Check{Name: "chain", OK: true}
+
+	header := c.backend.Header()
+	if header == nil {
+		check.OK = false
+		check.Message = "no head block"
+
+		return check
+	}
+
+	if _, ok := c.backend.GetHeaderByNumber(header.Number); !ok {
+		check.OK = false
+		check.Message = fmt.Sprintf("failed to read head block %d from the storage", header.Number)
+
+		return check
+	}
+
+	check.Message = fmt.Sprintf("head block %d is readable", header.Number)
+
+	return check
+}
+
+// checkSync fails if the node is syncing and too far behind the sync target
+func (c *Checker) checkSync() *Check {
+	check := &Check{Name: "sync", OK: true, Message: "synced"}
+
+	progression := c.backend.GetSyncProgression()
+	if progression == nil {
+		return check
+	}
+
+	var lag uint64
+	if progression.HighestBlock > progression.CurrentBlock {
+		lag = progression.HighestBlock - progression.CurrentBlock
+	}
+
+	check.OK = lag <= c.config.MaxSyncLag
+	check.Message = fmt.Sprintf("syncing block %d of %d, %d blocks behind",
+		progression.CurrentBlock, progression.HighestBlock, lag)
+
+	return check
+}
+
+// checkPeers fails if the node has fewer peers than required
+func (c *Checker) checkPeers() *Check {
+	peers := c.backend.GetPeers()
+
+	return &Check{
+		Name:    "peers",
+		OK:      uint64(peers) >= c.config.MinPeers,
+		Message: fmt.Sprintf("%d peers connected, %d required", peers, c.config.MinPeers),
+	}
+}
+
+// checkHeadAge fails if the head is older than the allowed number of the block times
+func (c *Checker) checkHeadAge() *Check {
+	check := &Check{Name: "head", OK: true}
+
+	header := c.backend.Header()
+	if header == nil {
+		check.OK = false
+		check.Message = "no head block"
+
+		return check
+	}
+
+	age := c.now().Sub(time.Unix(int64(header.Timestamp), 0)).Truncate(time.Second)
+	if age < 0 {
+		age = 0
+	}
+
+	if c.config.MaxHeadAge == 0 || c.config.BlockTime == 0 {
+		check.Message = fmt.Sprintf("head block %d is %s old", header.Number, age)
+
+		return check
+	}
+
+	maxAge := c.config.BlockTime * time.Duration(c.config.MaxHeadAge)
+
+	check.OK = age <= maxAge
+	check.Message = fmt.Sprintf("head block %d is %s old, %s allowed", header.Number, age, maxAge)
+
+	return check
+}
+
+// checkParticipation fails if the validator signed fewer of the latest blocks than required.
+// The nodes which were not validators in the participation window always pass it
+func (c *Checker) checkParticipation() *Check {
+	check := &Check{Name: "participation", OK: true}
+
+	if c.participation == nil || c.config.ParticipationWindow == 0 {
+		check.Message = "not checked"
+
+		return check
+	}
+
+	validated, signed, err := c.participation.ValidatorParticipation(c.config.ParticipationWindow)
+	if err != nil {
+		check.OK = false
+		check.Message = fmt.Sprintf("failed to get the participation: %v", err)
+
+		return check
+	}
+
+	if validated == 0 {
+		check.Message = fmt.Sprintf("not a validator in the latest %d blocks", c.config.ParticipationWindow)
+
+		return check
+	}
+
+	// the validators which joined recently can not sign more blocks than they validated
+	required := c.config.MinParticipation
+	if required > validated {
+		required = validated
+	}
+
+	check.OK = signed >= required
+	check.Message = fmt.Sprintf("signed %d of %d blocks as a validator, %d required", signed, validated, required)
+
+	return check
+}
+
+// newReport creates the report of the checks, which is ok if all the checks passed
+func newReport(checks ...*Check) *Report {
+	report := &Report{OK: true, Checks: checks}
+
+	for _, check := range checks {
+		report.OK = report.OK && check.OK
+	}
+
+	return report
+}
@@ -0,0 +1,219 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	now = time.Unix(1_700_000_000, 0)
+
+	errParticipation = errors.New("participation error")
+)
+
+type mockBackend struct {
+	header      *types.Header
+	progression *progress.Progression
+	peers       int
+
+	// unreadable makes the headers fail to be read from the storage
+	unreadable bool
+}
+
+func (m *mockBackend) Header() *types.Header {
+	return m.header
+}
+
+func (m *mockBackend) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	if m.unreadable || m.header == nil || number > m.header.Number {
+		return nil, false
+	}
+
+	return m.header, true
+}
+
+func (m *mockBackend) GetSyncProgression() *progress.Progression {
+	return m.progression
+}
+
+func (m *mockBackend) GetPeers() int {
+	return m.peers
+}
+
+type mockParticipation struct {
+	validated, signed uint64
+	err               error
+}
+
+func (m *mockParticipation) ValidatorParticipation(uint64) (uint64, uint64, error) {
+	return m.validated, m.signed, m.err
+}
+
+func newTestChecker(backend *mockBackend, participation Participation) *Checker {
+	config := DefaultConfig()
+	config.BlockTime = 2 * time.Second
+
+	checker := NewChecker(hclog.NewNullLogger(), config, backend, participation)
+	checker.now = func() time.Time {
+		return now
+	}
+
+	return checker
+}
+
+// headAt returns the head block produced the given time ago
+func headAt(age time.Duration) *types.Header {
+	return &types.Header{Number: 100, Timestamp: uint64(now.Add(-age).Unix())}
+}
+
+func failedChecks(report *Report) []string {
+	failed := []string{}
+
+	for _, check := range report.Checks {
+		if !check.OK {
+			failed = append(failed, check.Name)
+		}
+	}
+
+	return failed
+}
+
+func TestChecker_Ready(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		backend       *mockBackend
+		participation Participation
+		failed        []string
+	}{
+		{
+			name:    "ready",
+			backend: &mockBackend{header: headAt(time.Second), peers: 3},
+			failed:  []string{},
+		},
+		{
+			name: "syncing within the lag",
+			backend: &mockBackend{
+				header:      headAt(time.Second),
+				progression: &progress.Progression{CurrentBlock: 95, HighestBlock: 105},
+				peers:       3,
+			},
+			failed: []string{},
+		},
+		{
+			name: "syncing behind the lag",
+			backend: &mockBackend{
+				header:      headAt(time.Hour),
+				progression: &progress.Progression{CurrentBlock: 100, HighestBlock: 1000},
+				peers:       3,
+			},
+			failed: []string{"sync", "head"},
+		},
+		{
+			name:    "no peers",
+			backend: &mockBackend{header: headAt(time.Second)},
+			failed:  []string{"peers"},
+		},
+		{
+			name:    "old head",
+			backend: &mockBackend{header: headAt(21 * time.Second), peers: 3},
+			failed:  []string{"head"},
+		},
+		{
+			name:          "signing validator",
+			backend:       &mockBackend{header: headAt(time.Second), peers: 3},
+			participation: &mockParticipation{validated: 20, signed: 1},
+			failed:        []string{},
+		},
+		{
+			name:          "not a validator",
+			backend:       &mockBackend{header: headAt(time.Second), peers: 3},
+			participation: &mockParticipation{},
+			failed:        []string{},
+		},
+		{
+			name:          "validator not signing",
+			backend:       &mockBackend{header: headAt(time.Second), peers: 3},
+			participation: &mockParticipation{validated: 20},
+			failed:        []string{"participation"},
+		},
+		{
+			name:          "participation error",
+			backend:       &mockBackend{header: headAt(time.Second), peers: 3},
+			participation: &mockParticipation{err: errParticipation},
+			failed:        []string{"participation"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := newTestChecker(test.backend, test.participation).Ready()
+
+			assert.Equal(t, test.failed, failedChecks(report))
+			assert.Equal(t, len(test.failed) == 0, report.OK)
+		})
+	}
+}
+
+func TestChecker_Health(t *testing.T) {
+	t.Parallel()
+
+	// the stalled chain does not fail the liveness, only the readiness
+	backend := &mockBackend{header: headAt(time.Hour), peers: 3}
+
+	assert.True(t, newTestChecker(backend, nil).Health().OK)
+	assert.Equal(t, []string{"head"}, failedChecks(newTestChecker(backend, nil).Ready()))
+
+	// the chain can not be read
+	backend.unreadable = true
+
+	report := newTestChecker(backend, nil).Health()
+	assert.False(t, report.OK)
+	assert.Equal(t, []string{"chain"}, failedChecks(report))
+
+	// there is no head
+	assert.False(t, newTestChecker(&mockBackend{}, nil).Health().OK)
+}
+
+func TestChecker_Handlers(t *testing.T) {
+	t.Parallel()
+
+	checker := newTestChecker(&mockBackend{header: headAt(time.Second)}, nil)
+	handlers := checker.Handlers()
+
+	tests := []struct {
+		path   string
+		status int
+		ok     bool
+	}{
+		{HealthPath, http.StatusOK, true},
+		{ReadyPath, http.StatusServiceUnavailable, false},
+	}
+
+	for _, test := range tests {
+		recorder := httptest.NewRecorder()
+		handlers[test.path].ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, test.path, nil))
+
+		assert.Equal(t, test.status, recorder.Code, test.path)
+		assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"), test.path)
+
+		report := &Report{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), report), test.path)
+		assert.Equal(t, test.ok, report.OK, test.path)
+	}
+}
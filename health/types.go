@@ -0,0 +1,41 @@
+package health
+
+import (
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Backend provides the state of the node checked by the health checker
+type Backend interface {
+	// Header returns the current head of the chain
+	Header() *types.Header
+
+	// GetHeaderByNumber reads the canonical header with the given number from the storage
+	GetHeaderByNumber(number uint64) (*types.Header, bool)
+
+	// GetSyncProgression returns the progression of the ongoing sync, nil if the node is not syncing
+	GetSyncProgression() *progress.Progression
+
+	// GetPeers returns the number of the connected peers
+	GetPeers() int
+}
+
+// Participation is implemented by the consensus mechanisms reporting the participation of the validators
+type Participation interface {
+	// ValidatorParticipation returns how many of the given number of the latest blocks
+	// were finalized while the node was a validator, and how many of them the node signed
+	ValidatorParticipation(blocks uint64) (validated uint64, signed uint64, err error)
+}
+
+// Check is the result of a single health check
+type Check struct {
+	Name    string `json:"name"`
+	OK      bool   `json:"ok"`
+	Message string `json:"message"`
+}
+
+// Report is the result of the health or the readiness checks of the node
+type Report struct {
+	OK     bool     `json:"ok"`
+	Checks []*Check `json:"checks"`
+}
@@ -0,0 +1,148 @@
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+var errInvalidLevel = errors.New("invalid log level")
+
+// Levels holds the log levels of the logger modules, which can be changed at runtime.
+// The module of a named logger is its name without the root logger name,
+// e.g. "txpool" for the "polygon.txpool" logger. The level of a module applies
+// to its sub-modules as well, unless they have their own level set
+type Levels struct {
+	root   hclog.Logger
+	sink   hclog.SinkAdapter
+	prefix string
+
+	lock         sync.RWMutex
+	defaultLevel hclog.Level
+	modules      map[string]hclog.Level
+}
+
+// NewLogger creates the root logger with the given options,
+// whose log levels are controlled per module by the returned levels
+func NewLogger(opts *hclog.LoggerOptions) (hclog.Logger, *Levels) {
+	level := opts.Level
+	if level == hclog.NoLevel {
+		level = hclog.DefaultLevel
+	}
+
+	// the root logger only gates the entries by the lowest level of all the modules
+	// and passes them to the sink, which writes out the ones passing the level of their module
+	root := hclog.NewInterceptLogger(&hclog.LoggerOptions{
+		Name:   opts.Name,
+		Level:  level,
+		Output: io.Discard,
+		Exclude: func(hclog.Level, string, ...interface{}) bool {
+			return true
+		},
+	})
+
+	sinkOpts := *opts
+	sinkOpts.Level = hclog.Trace
+
+	levels := &Levels{
+		root:         root,
+		sink:         hclog.NewSinkAdapter(&sinkOpts),
+		prefix:       opts.Name + ".",
+		defaultLevel: level,
+		modules:      make(map[string]hclog.Level),
+	}
+
+	root.RegisterSink(levels)
+
+	return root, levels
+}
+
+// Accept implements the hclog.SinkAdapter interface
+func (l *Levels) Accept(name string, level hclog.Level, msg string, args ...interface{}) {
+	if level < l.moduleLevel(name) {
+		return
+	}
+
+	l.sink.Accept(name, level, msg, args...)
+}
+
+// moduleLevel returns the level of the logger with the given name
+func (l *Levels) moduleLevel(name string) hclog.Level {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	if len(l.modules) == 0 {
+		return l.defaultLevel
+	}
+
+	module := strings.TrimPrefix(name, l.prefix)
+
+	for {
+		if level, ok := l.modules[module]; ok {
+			return level
+		}
+
+		i := strings.LastIndexByte(module, '.')
+		if i < 0 {
+			return l.defaultLevel
+		}
+
+		module = module[:i]
+	}
+}
+
+// SetLevel sets the log level of the module, or the default level if the module is empty.
+// The empty level resets the module to the default level
+func (l *Levels) SetLevel(module string, rawLevel string) error {
+	var level hclog.Level
+
+	if rawLevel != "" {
+		if level = hclog.LevelFromString(rawLevel); level == hclog.NoLevel {
+			return fmt.Errorf("%w: %s", errInvalidLevel, rawLevel)
+		}
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	switch {
+	case module == "":
+		if level != hclog.NoLevel {
+			l.defaultLevel = level
+		}
+	case level == hclog.NoLevel:
+		delete(l.modules, module)
+	default:
+		l.modules[module] = level
+	}
+
+	// the entries of every module have to pass the root logger
+	lowest := l.defaultLevel
+
+	for _, level := range l.modules {
+		if level < lowest {
+			lowest = level
+		}
+	}
+
+	l.root.SetLevel(lowest)
+
+	return nil
+}
+
+// Get returns the default log level and the levels of the modules
+func (l *Levels) Get() (string, map[string]string) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	modules := make(map[string]string, len(l.modules))
+	for module, level := range l.modules {
+		modules[module] = level.String()
+	}
+
+	return l.defaultLevel.String(), modules
+}
@@ -0,0 +1,86 @@
+package logging
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevels(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	root, levels := NewLogger(&hclog.LoggerOptions{
+		Name:   "polygon",
+		Level:  hclog.Info,
+		Output: &out,
+	})
+
+	var (
+		txpool    = root.Named("txpool")
+		consensus = root.Named("consensus")
+		polybft   = consensus.Named("polybft")
+	)
+
+	// logs the given messages and returns the ones written out
+	logged := func(log func()) []string {
+		out.Reset()
+		log()
+
+		var messages []string
+
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			if line != "" {
+				fields := strings.Fields(line)
+				messages = append(messages, fields[len(fields)-1])
+			}
+		}
+
+		return messages
+	}
+
+	assert.Equal(t, []string{"txpool-info"}, logged(func() {
+		txpool.Debug("txpool-debug")
+		txpool.Info("txpool-info")
+	}))
+
+	require.NoError(t, levels.SetLevel("consensus", "debug"))
+	assert.True(t, txpool.IsDebug())
+
+	assert.Equal(t, []string{"consensus-debug", "polybft-debug"}, logged(func() {
+		txpool.Debug("txpool-debug")
+		consensus.Debug("consensus-debug")
+		polybft.Debug("polybft-debug")
+	}))
+
+	// the level of the sub-module takes precedence
+	require.NoError(t, levels.SetLevel("consensus.polybft", "error"))
+
+	assert.Equal(t, []string{"consensus-info", "polybft-error"}, logged(func() {
+		consensus.Info("consensus-info")
+		polybft.Warn("polybft-warn")
+		polybft.Error("polybft-error")
+	}))
+
+	defaultLevel, modules := levels.Get()
+	assert.Equal(t, "info", defaultLevel)
+	assert.Equal(t, map[string]string{"consensus": "debug", "consensus.polybft": "error"}, modules)
+
+	// the modules are reset to the default level
+	require.NoError(t, levels.SetLevel("consensus", ""))
+	require.NoError(t, levels.SetLevel("consensus.polybft", ""))
+	require.NoError(t, levels.SetLevel("", "warn"))
+	assert.False(t, txpool.IsInfo())
+
+	assert.Equal(t, []string{"polybft-warn"}, logged(func() {
+		consensus.Info("consensus-info")
+		polybft.Warn("polybft-warn")
+	}))
+
+	assert.ErrorIs(t, levels.SetLevel("txpool", "verbose"), errInvalidLevel)
+}
@@ -0,0 +1,114 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/0xPolygon/polygon-edge/versioning"
+)
+
+const (
+	// ExporterNone disables the export of the spans
+	ExporterNone = "none"
+
+	// ExporterOTLP exports the spans to the OpenTelemetry collector over OTLP/gRPC
+	ExporterOTLP = "otlp"
+
+	// DefaultOTLPEndpoint is the default address of the local OpenTelemetry collector
+	DefaultOTLPEndpoint = "127.0.0.1:4317"
+
+	// DefaultSampleRatio is the default ratio of the sampled traces
+	DefaultSampleRatio = 1.0
+
+	serviceName = "polygon-edge"
+)
+
+var errUnknownExporter = errors.New("unknown tracing exporter")
+
+// Config is the configuration of the span export
+type Config struct {
+	// Exporter is the name of the span exporter, none by default
+	Exporter string
+
+	// Endpoint is the address of the OpenTelemetry collector receiving the spans over OTLP/gRPC
+	Endpoint string
+
+	// Insecure disables the TLS of the connection to the collector
+	Insecure bool
+
+	// SampleRatio is the ratio of the root spans sampled, along with their children
+	SampleRatio float64
+
+	// NodeName identifies the node in the exported spans
+	NodeName string
+}
+
+// Tracer returns the tracer of the node module. The spans are dropped,
+// unless the tracer provider exporting them is set up
+func Tracer(module string) trace.Tracer {
+	return otel.Tracer(serviceName + "/" + module)
+}
+
+// Setup sets up the global tracer provider exporting the spans of the node,
+// and returns the function flushing the remaining spans on the shutdown
+func Setup(ctx context.Context, config *Config) (func(context.Context) error, error) {
+	switch config.Exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownExporter, config.Exporter)
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	// the exporter connects to the collector in the background, so the node starts without it
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the otlp exporter: %w", err)
+	}
+
+	attributes := []attribute.KeyValue{
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(versioning.Version),
+	}
+
+	if config.NodeName != "" {
+		attributes = append(attributes, semconv.ServiceInstanceID(config.NodeName))
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, attributes...)),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider.Shutdown, nil
+}
+
+// End ends the span, recording the error if it is not nil
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
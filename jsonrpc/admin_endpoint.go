@@ -0,0 +1,160 @@
+package jsonrpc
+
+import (
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// AdminStore provides the runtime controls of the node used by the admin endpoint
+type AdminStore interface {
+	// SetLogLevel sets the log level of the module, or the default level if the module is empty.
+	// The empty level resets the module to the default level
+	SetLogLevel(module string, level string) error
+
+	// LogLevels returns the default log level and the log levels of the modules
+	LogLevels() (string, map[string]string)
+
+	// DropPeer disconnects the peer
+	DropPeer(id string) error
+
+	// BanPeer bans the peer ID, IP address or CIDR range for the given duration, or permanently if it is zero
+	BanPeer(target string, duration time.Duration, reason string) error
+
+	// RemoveTx removes the transaction and the following transactions of its sender from the pool
+	RemoveTx(hash types.Hash) ([]types.Hash, error)
+
+	// RebroadcastTx gossips the pool transaction to the peers again
+	RebroadcastTx(hash types.Hash) error
+
+	// FlushAccount drops all the transactions of the account from the pool
+	FlushAccount(addr types.Address) []types.Hash
+
+	// SetBlockProductionPaused pauses or resumes the block production of the node
+	SetBlockProductionPaused(paused bool) error
+
+	// IsBlockProductionPaused returns true if the block production of the node is paused
+	IsBlockProductionPaused() bool
+
+	// CompactDatabases compacts the databases of the node and returns the names of the compacted ones
+	CompactDatabases() ([]string, error)
+}
+
+// Admin is the endpoint with the runtime controls of the node, enabled explicitly by the operator
+type Admin struct {
+	store AdminStore
+}
+
+// LogLevelsResponse is the response of the admin_logLevels request
+type LogLevelsResponse struct {
+	Default string            `json:"default"`
+	Modules map[string]string `json:"modules"`
+}
+
+// SetLogLevel sets the log level of the module, or the default level if the module is empty.
+// The empty level resets the module to the default level
+func (a *Admin) SetLogLevel(module string, level string) (interface{}, error) {
+	if err := a.store.SetLogLevel(module, level); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// LogLevels returns the default log level and the log levels of the modules
+func (a *Admin) LogLevels() (interface{}, error) {
+	defaultLevel, modules := a.store.LogLevels()
+
+	return &LogLevelsResponse{
+		Default: defaultLevel,
+		Modules: modules,
+	}, nil
+}
+
+// DropPeer disconnects the peer with the given ID
+func (a *Admin) DropPeer(id string) (interface{}, error) {
+	if err := a.store.DropPeer(id); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// BanPeer bans the peer ID, IP address or CIDR range for the given number of seconds,
+// or permanently if it is not set
+func (a *Admin) BanPeer(target string, seconds *argNumber, reason string) (interface{}, error) {
+	var duration time.Duration
+	if seconds != nil {
+		duration = time.Duration(*seconds) * time.Second
+	}
+
+	if err := a.store.BanPeer(target, duration, reason); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// RemoveTransaction removes the transaction from the pool along with the following transactions
+// of its sender and returns the hashes of the removed transactions
+func (a *Admin) RemoveTransaction(hash types.Hash) (interface{}, error) {
+	removed, err := a.store.RemoveTx(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return removed, nil
+}
+
+// RebroadcastTransaction gossips the pool transaction to the peers again
+func (a *Admin) RebroadcastTransaction(hash types.Hash) (interface{}, error) {
+	if err := a.store.RebroadcastTx(hash); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// FlushAccount drops all the transactions of the account from the pool
+// and returns the hashes of the dropped transactions
+func (a *Admin) FlushAccount(addr types.Address) (interface{}, error) {
+	dropped := a.store.FlushAccount(addr)
+	if dropped == nil {
+		dropped = []types.Hash{}
+	}
+
+	return dropped, nil
+}
+
+// PauseBlockProduction stops the node from producing blocks
+func (a *Admin) PauseBlockProduction() (interface{}, error) {
+	if err := a.store.SetBlockProductionPaused(true); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// ResumeBlockProduction resumes the block production of the node
+func (a *Admin) ResumeBlockProduction() (interface{}, error) {
+	if err := a.store.SetBlockProductionPaused(false); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// BlockProductionPaused returns true if the block production of the node is paused
+func (a *Admin) BlockProductionPaused() (interface{}, error) {
+	return a.store.IsBlockProductionPaused(), nil
+}
+
+// Compact compacts the databases of the node and returns the names of the compacted ones
+func (a *Admin) Compact() (interface{}, error) {
+	compacted, err := a.store.CompactDatabases()
+	if err != nil {
+		return nil, err
+	}
+
+	return compacted, nil
+}
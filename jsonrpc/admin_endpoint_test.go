@@ -0,0 +1,162 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errMockTxNotFound = errors.New("transaction not found")
+
+type mockAdminStore struct {
+	levels  map[string]string
+	dropped []string
+	banned  map[string]time.Duration
+	removed []types.Hash
+	flushed []types.Address
+	paused  bool
+}
+
+func newMockAdminStore() *mockAdminStore {
+	return &mockAdminStore{
+		levels: map[string]string{},
+		banned: map[string]time.Duration{},
+	}
+}
+
+func (m *mockAdminStore) SetLogLevel(module string, level string) error {
+	m.levels[module] = level
+
+	return nil
+}
+
+func (m *mockAdminStore) LogLevels() (string, map[string]string) {
+	return "info", m.levels
+}
+
+func (m *mockAdminStore) DropPeer(id string) error {
+	m.dropped = append(m.dropped, id)
+
+	return nil
+}
+
+func (m *mockAdminStore) BanPeer(target string, duration time.Duration, _ string) error {
+	m.banned[target] = duration
+
+	return nil
+}
+
+func (m *mockAdminStore) RemoveTx(hash types.Hash) ([]types.Hash, error) {
+	if hash == types.ZeroHash {
+		return nil, errMockTxNotFound
+	}
+
+	m.removed = append(m.removed, hash)
+
+	return []types.Hash{hash}, nil
+}
+
+func (m *mockAdminStore) RebroadcastTx(hash types.Hash) error {
+	if hash == types.ZeroHash {
+		return errMockTxNotFound
+	}
+
+	return nil
+}
+
+func (m *mockAdminStore) FlushAccount(addr types.Address) []types.Hash {
+	m.flushed = append(m.flushed, addr)
+
+	return nil
+}
+
+func (m *mockAdminStore) SetBlockProductionPaused(paused bool) error {
+	m.paused = paused
+
+	return nil
+}
+
+func (m *mockAdminStore) IsBlockProductionPaused() bool {
+	return m.paused
+}
+
+func (m *mockAdminStore) CompactDatabases() ([]string, error) {
+	return []string{"blockchain", "trie"}, nil
+}
+
+func newTestAdminDispatcher(t *testing.T, adminStore AdminStore) *Dispatcher {
+	t.Helper()
+
+	return newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{
+			jsonRPCBatchLengthLimit: 20,
+			blockRangeLimit:         1000,
+			adminStore:              adminStore,
+		},
+	)
+}
+
+func TestAdminEndpoint_NotRegistered(t *testing.T) {
+	t.Parallel()
+
+	resp := callDevMethod(t, newTestAdminDispatcher(t, nil), "admin_compact", "[]")
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, -32601, resp.Error.Code)
+}
+
+func TestAdminEndpoint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		store      = newMockAdminStore()
+		dispatcher = newTestAdminDispatcher(t, store)
+		hash       = types.StringToHash("0x1")
+		addr       = types.StringToAddress("0x2")
+	)
+
+	tests := []struct {
+		method string
+		params string
+		result string
+	}{
+		{"admin_setLogLevel", `["txpool", "debug"]`, "true"},
+		{"admin_logLevels", "[]", `{"default": "info", "modules": {"txpool": "debug"}}`},
+		{"admin_dropPeer", `["16Uiu2HAm"]`, "true"},
+		{"admin_banPeer", `["10.0.0.0/8", 60, "spam"]`, "true"},
+		{"admin_banPeer", `["10.0.0.1"]`, "true"},
+		{"admin_removeTransaction", fmt.Sprintf(`["%s"]`, hash), fmt.Sprintf(`["%s"]`, hash)},
+		{"admin_rebroadcastTransaction", fmt.Sprintf(`["%s"]`, hash), "true"},
+		{"admin_flushAccount", fmt.Sprintf(`["%s"]`, addr), "[]"},
+		{"admin_pauseBlockProduction", "[]", "true"},
+		{"admin_blockProductionPaused", "[]", "true"},
+		{"admin_resumeBlockProduction", "[]", "true"},
+		{"admin_blockProductionPaused", "[]", "false"},
+		{"admin_compact", "[]", `["blockchain", "trie"]`},
+	}
+
+	for _, test := range tests {
+		resp := callDevMethod(t, dispatcher, test.method, test.params)
+		require.Nil(t, resp.Error, test.method)
+		assert.JSONEq(t, test.result, string(resp.Result), test.method)
+	}
+
+	assert.Equal(t, []string{"16Uiu2HAm"}, store.dropped)
+	assert.Equal(t, map[string]time.Duration{"10.0.0.0/8": time.Minute, "10.0.0.1": 0}, store.banned)
+	assert.Equal(t, []types.Hash{hash}, store.removed)
+	assert.Equal(t, []types.Address{addr}, store.flushed)
+
+	// the store errors are returned
+	for _, method := range []string{"admin_removeTransaction", "admin_rebroadcastTransaction"} {
+		resp := callDevMethod(t, dispatcher, method, fmt.Sprintf(`["%s"]`, types.ZeroHash))
+		require.NotNil(t, resp.Error, method)
+		assert.Equal(t, errMockTxNotFound.Error(), resp.Error.Message, method)
+	}
+}
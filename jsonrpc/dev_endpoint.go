@@ -0,0 +1,196 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// DevStore provides the controls of the dev consensus used by the dev-only endpoints
+type DevStore interface {
+	// Mine seals a new block, at the given timestamp if it is set
+	Mine(timestamp *uint64) (*types.Header, error)
+
+	// SetAutomine switches the mode sealing a block as soon as a transaction arrives
+	SetAutomine(enabled bool)
+
+	// SetNextBlockTimestamp sets the timestamp of the next block
+	SetNextBlockTimestamp(timestamp uint64) error
+
+	// IncreaseTime moves the clock of the following blocks forward and returns the total time offset
+	IncreaseTime(seconds uint64) int64
+
+	// Snapshot saves the current chain head and returns the id to revert to it
+	Snapshot() uint64
+
+	// Revert moves the chain head back to the given snapshot, returns false if it does not exist
+	Revert(id uint64) (bool, error)
+
+	// SetStateOverride changes the accounts' state in a new block
+	SetStateOverride(override types.StateOverride) error
+
+	// ImpersonateAccount allows sending unsigned transactions on behalf of the account
+	ImpersonateAccount(addr types.Address)
+
+	// StopImpersonatingAccount stops the account impersonation
+	StopImpersonatingAccount(addr types.Address)
+
+	// IsImpersonated returns true if the account is impersonated
+	IsImpersonated(addr types.Address) bool
+
+	// PendingNonce returns the next nonce of the account, including its pending impersonated transactions
+	PendingNonce(addr types.Address) uint64
+
+	// AddImpersonatedTx adds the unsigned transaction of an impersonated account
+	AddImpersonatedTx(tx *types.Transaction) error
+}
+
+// argNumber is an unsigned number given either as a hex string or as a plain JSON number,
+// as the Hardhat and Anvil clients use both
+type argNumber uint64
+
+func (n *argNumber) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var value argUint64
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		*n = argNumber(value)
+
+		return nil
+	}
+
+	value, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*n = argNumber(value)
+
+	return nil
+}
+
+// Evm is the dev-only endpoint with the Hardhat compatible chain controls
+type Evm struct {
+	store DevStore
+}
+
+// Mine seals a new block, at the given timestamp if it is set
+func (e *Evm) Mine(timestamp *argNumber) (interface{}, error) {
+	var blockTimestamp *uint64
+
+	if timestamp != nil {
+		value := uint64(*timestamp)
+		blockTimestamp = &value
+	}
+
+	if _, err := e.store.Mine(blockTimestamp); err != nil {
+		return nil, err
+	}
+
+	return "0x0", nil
+}
+
+// SetAutomine switches the mode sealing a block as soon as a transaction arrives
+func (e *Evm) SetAutomine(enabled bool) (interface{}, error) {
+	e.store.SetAutomine(enabled)
+
+	return true, nil
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block
+func (e *Evm) SetNextBlockTimestamp(timestamp argNumber) (interface{}, error) {
+	if err := e.store.SetNextBlockTimestamp(uint64(timestamp)); err != nil {
+		return nil, err
+	}
+
+	return argUint64(timestamp), nil
+}
+
+// IncreaseTime moves the clock of the following blocks forward and returns the total time offset in seconds
+func (e *Evm) IncreaseTime(seconds argNumber) (interface{}, error) {
+	return e.store.IncreaseTime(uint64(seconds)), nil
+}
+
+// Snapshot saves the current chain head and returns the id to revert to it
+func (e *Evm) Snapshot() (interface{}, error) {
+	return argUint64(e.store.Snapshot()), nil
+}
+
+// Revert moves the chain head back to the given snapshot, returns false if it does not exist
+func (e *Evm) Revert(id argUint64) (interface{}, error) {
+	return e.store.Revert(uint64(id))
+}
+
+// Anvil is the dev-only endpoint with the Anvil compatible state controls.
+// The state changes are sealed right away in a new block
+type Anvil struct {
+	store DevStore
+}
+
+// Mine seals the given number of blocks (one by default), the given number of seconds apart
+func (a *Anvil) Mine(blocks *argNumber, interval *argNumber) (interface{}, error) {
+	count := uint64(1)
+	if blocks != nil {
+		count = uint64(*blocks)
+	}
+
+	for i := uint64(0); i < count; i++ {
+		if interval != nil && i > 0 {
+			a.store.IncreaseTime(uint64(*interval))
+		}
+
+		if _, err := a.store.Mine(nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// SetBalance sets the balance of the account
+func (a *Anvil) SetBalance(addr types.Address, balance argBig) (interface{}, error) {
+	return a.setState(addr, types.OverrideAccount{Balance: (*big.Int)(&balance)})
+}
+
+// SetNonce sets the nonce of the account
+func (a *Anvil) SetNonce(addr types.Address, nonce argNumber) (interface{}, error) {
+	value := uint64(nonce)
+
+	return a.setState(addr, types.OverrideAccount{Nonce: &value})
+}
+
+// SetCode sets the code of the account
+func (a *Anvil) SetCode(addr types.Address, code argBytes) (interface{}, error) {
+	return a.setState(addr, types.OverrideAccount{Code: code})
+}
+
+// SetStorageAt sets the value of the account's storage slot
+func (a *Anvil) SetStorageAt(addr types.Address, slot types.Hash, value types.Hash) (interface{}, error) {
+	return a.setState(addr, types.OverrideAccount{StateDiff: map[types.Hash]types.Hash{slot: value}})
+}
+
+// ImpersonateAccount allows sending eth_sendTransaction calls on behalf of the account
+func (a *Anvil) ImpersonateAccount(addr types.Address) (interface{}, error) {
+	a.store.ImpersonateAccount(addr)
+
+	return nil, nil
+}
+
+// StopImpersonatingAccount stops the account impersonation
+func (a *Anvil) StopImpersonatingAccount(addr types.Address) (interface{}, error) {
+	a.store.StopImpersonatingAccount(addr)
+
+	return nil, nil
+}
+
+func (a *Anvil) setState(addr types.Address, account types.OverrideAccount) (interface{}, error) {
+	if err := a.store.SetStateOverride(types.StateOverride{addr: account}); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
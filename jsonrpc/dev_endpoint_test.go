@@ -0,0 +1,255 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDevStore struct {
+	mined         []*uint64
+	automine      bool
+	nextTimestamp uint64
+	timeOffset    int64
+	snapshots     uint64
+	overrides     []types.StateOverride
+	impersonated  map[types.Address]bool
+	txs           []*types.Transaction
+}
+
+func newMockDevStore() *mockDevStore {
+	return &mockDevStore{impersonated: map[types.Address]bool{}}
+}
+
+func (m *mockDevStore) Mine(timestamp *uint64) (*types.Header, error) {
+	m.mined = append(m.mined, timestamp)
+
+	return &types.Header{Number: uint64(len(m.mined))}, nil
+}
+
+func (m *mockDevStore) SetAutomine(enabled bool) {
+	m.automine = enabled
+}
+
+func (m *mockDevStore) SetNextBlockTimestamp(timestamp uint64) error {
+	m.nextTimestamp = timestamp
+
+	return nil
+}
+
+func (m *mockDevStore) IncreaseTime(seconds uint64) int64 {
+	m.timeOffset += int64(seconds)
+
+	return m.timeOffset
+}
+
+func (m *mockDevStore) Snapshot() uint64 {
+	m.snapshots++
+
+	return m.snapshots
+}
+
+func (m *mockDevStore) Revert(id uint64) (bool, error) {
+	return id <= m.snapshots, nil
+}
+
+func (m *mockDevStore) SetStateOverride(override types.StateOverride) error {
+	m.overrides = append(m.overrides, override)
+
+	return nil
+}
+
+func (m *mockDevStore) ImpersonateAccount(addr types.Address) {
+	m.impersonated[addr] = true
+}
+
+func (m *mockDevStore) StopImpersonatingAccount(addr types.Address) {
+	delete(m.impersonated, addr)
+}
+
+func (m *mockDevStore) IsImpersonated(addr types.Address) bool {
+	return m.impersonated[addr]
+}
+
+func (m *mockDevStore) PendingNonce(types.Address) uint64 {
+	return uint64(len(m.txs))
+}
+
+func (m *mockDevStore) AddImpersonatedTx(tx *types.Transaction) error {
+	tx.ComputeHash()
+	m.txs = append(m.txs, tx)
+
+	return nil
+}
+
+type mockDevJSONRPCStore struct {
+	*mockStore
+}
+
+func (m *mockDevJSONRPCStore) GetBaseFee() uint64 {
+	return 0
+}
+
+func newTestDevDispatcher(t *testing.T, devStore DevStore) *Dispatcher {
+	t.Helper()
+
+	return newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		&mockDevJSONRPCStore{newMockStore()},
+		&dispatcherParams{
+			jsonRPCBatchLengthLimit: 20,
+			blockRangeLimit:         1000,
+			devStore:                devStore,
+		},
+	)
+}
+
+func callDevMethod(t *testing.T, dispatcher *Dispatcher, method string, params string) *SuccessResponse {
+	t.Helper()
+
+	data, err := dispatcher.Handle([]byte(fmt.Sprintf(
+		`{"jsonrpc": "2.0", "method": "%s", "params": %s, "id": 1}`, method, params,
+	)), "")
+	require.NoError(t, err)
+
+	resp := new(SuccessResponse)
+	require.NoError(t, json.Unmarshal(data, resp))
+
+	return resp
+}
+
+func TestDevEndpoints_NotRegistered(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newTestDevDispatcher(t, nil)
+
+	for _, method := range []string{"evm_mine", "anvil_setBalance"} {
+		resp := callDevMethod(t, dispatcher, method, "[]")
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, -32601, resp.Error.Code)
+	}
+}
+
+func TestEvmEndpoint(t *testing.T) {
+	t.Parallel()
+
+	store := newMockDevStore()
+	dispatcher := newTestDevDispatcher(t, store)
+
+	tests := []struct {
+		method string
+		params string
+		result string
+	}{
+		{"evm_mine", "[]", `"0x0"`},
+		{"evm_mine", "[1700000000]", `"0x0"`},
+		{"evm_setAutomine", "[true]", "true"},
+		{"evm_setNextBlockTimestamp", `["0x10"]`, `"0x10"`},
+		{"evm_increaseTime", "[3600]", "3600"},
+		{"evm_increaseTime", `["0xe10"]`, "7200"},
+		{"evm_snapshot", "[]", `"0x1"`},
+		{"evm_revert", `["0x1"]`, "true"},
+		{"evm_revert", `["0x2"]`, "false"},
+	}
+
+	for _, test := range tests {
+		resp := callDevMethod(t, dispatcher, test.method, test.params)
+		require.Nil(t, resp.Error, test.method)
+		assert.JSONEq(t, test.result, string(resp.Result), test.method)
+	}
+
+	timestamp := uint64(1700000000)
+
+	assert.Equal(t, []*uint64{nil, &timestamp}, store.mined)
+	assert.True(t, store.automine)
+	assert.Equal(t, uint64(0x10), store.nextTimestamp)
+}
+
+func TestAnvilEndpoint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		store      = newMockDevStore()
+		dispatcher = newTestDevDispatcher(t, store)
+		addr       = types.StringToAddress("0x1")
+		nonce      = uint64(5)
+	)
+
+	for _, call := range []struct {
+		method string
+		params string
+	}{
+		{"anvil_setBalance", fmt.Sprintf(`["%s", "0x64"]`, addr)},
+		{"anvil_setNonce", fmt.Sprintf(`["%s", 5]`, addr)},
+		{"anvil_setCode", fmt.Sprintf(`["%s", "0x6000"]`, addr)},
+		{"anvil_setStorageAt", fmt.Sprintf(`["%s", "0x1", "0x2"]`, addr)},
+	} {
+		resp := callDevMethod(t, dispatcher, call.method, call.params)
+		require.Nil(t, resp.Error, call.method)
+		assert.Equal(t, "true", string(resp.Result), call.method)
+	}
+
+	assert.Equal(t, []types.StateOverride{
+		{addr: {Balance: big.NewInt(100)}},
+		{addr: {Nonce: &nonce}},
+		{addr: {Code: []byte{0x60, 0x00}}},
+		{addr: {StateDiff: map[types.Hash]types.Hash{types.StringToHash("0x1"): types.StringToHash("0x2")}}},
+	}, store.overrides)
+
+	resp := callDevMethod(t, dispatcher, "anvil_mine", `["0x3", 10]`)
+	require.Nil(t, resp.Error)
+
+	assert.Len(t, store.mined, 3)
+	assert.Equal(t, int64(20), store.timeOffset)
+
+	resp = callDevMethod(t, dispatcher, "anvil_impersonateAccount", fmt.Sprintf(`["%s"]`, addr))
+	require.Nil(t, resp.Error)
+	assert.True(t, store.IsImpersonated(addr))
+
+	resp = callDevMethod(t, dispatcher, "anvil_stopImpersonatingAccount", fmt.Sprintf(`["%s"]`, addr))
+	require.Nil(t, resp.Error)
+	assert.False(t, store.IsImpersonated(addr))
+}
+
+func TestEth_SendTransaction_Impersonated(t *testing.T) {
+	t.Parallel()
+
+	var (
+		store      = newMockDevStore()
+		dispatcher = newTestDevDispatcher(t, store)
+		from       = types.StringToAddress("0x1")
+		to         = types.StringToAddress("0x2")
+		params     = fmt.Sprintf(`[{"from": "%s", "to": "%s", "gas": "0x5208", "gasPrice": "0x1", "value": "0x10"}]`,
+			from, to)
+	)
+
+	// not impersonated
+	resp := callDevMethod(t, dispatcher, "eth_sendTransaction", params)
+	require.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Message, "not supported")
+	assert.Empty(t, store.txs)
+
+	store.ImpersonateAccount(from)
+
+	for nonce := 0; nonce < 2; nonce++ {
+		resp = callDevMethod(t, dispatcher, "eth_sendTransaction", params)
+		require.Nil(t, resp.Error)
+
+		require.Len(t, store.txs, nonce+1)
+
+		tx := store.txs[nonce]
+
+		assert.Equal(t, fmt.Sprintf(`"%s"`, tx.Hash), string(resp.Result))
+		assert.Equal(t, from, tx.From)
+		assert.Equal(t, &to, tx.To)
+		assert.Equal(t, uint64(nonce), tx.Nonce)
+		assert.Equal(t, uint64(21000), tx.Gas)
+		assert.Equal(t, big.NewInt(0x10), tx.Value)
+	}
+}
@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,8 +15,14 @@ import (
 
 	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 )
 
+var spanTracer = tracing.Tracer("jsonrpc")
+
 type serviceData struct {
 	sv      reflect.Value
 	funcMap map[string]*funcData
@@ -39,6 +46,9 @@ type endpoints struct {
 	TxPool *TxPool
 	Bridge *Bridge
 	Debug  *Debug
+	Evm    *Evm
+	Anvil  *Anvil
+	Admin  *Admin
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -47,6 +57,7 @@ type Dispatcher struct {
 	logger        hclog.Logger
 	serviceMap    map[string]*serviceData
 	filterManager *FilterManager
+	rateLimiter   *RateLimiter
 	endpoints     endpoints
 
 	params *dispatcherParams
@@ -59,8 +70,17 @@ type dispatcherParams struct {
 	priceLimit              uint64
 	jsonRPCBatchLengthLimit uint64
 	blockRangeLimit         uint64
+	indexedBlockRangeLimit  uint64
 
 	concurrentRequestsDebug uint64
+
+	rateLimit *RateLimitConfig
+
+	// devStore enables the dev-only endpoints, nil if the node is not in the dev mode
+	devStore DevStore
+
+	// adminStore enables the admin endpoint, nil unless it is enabled by the operator
+	adminStore AdminStore
 }
 
 func (dp dispatcherParams) isExceedingBatchLengthLimit(value uint64) bool {
@@ -73,12 +93,13 @@ func newDispatcher(
 	params *dispatcherParams,
 ) (*Dispatcher, error) {
 	d := &Dispatcher{
-		logger: logger.Named("dispatcher"),
-		params: params,
+		logger:      logger.Named("dispatcher"),
+		params:      params,
+		rateLimiter: NewRateLimiter(params.rateLimit),
 	}
 
 	if store != nil {
-		d.filterManager = NewFilterManager(logger, store, params.blockRangeLimit)
+		d.filterManager = NewFilterManager(logger, store, params.blockRangeLimit, params.indexedBlockRangeLimit)
 		go d.filterManager.Run()
 	}
 
@@ -96,6 +117,7 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		d.params.chainID,
 		d.filterManager,
 		d.params.priceLimit,
+		d.params.devStore,
 	}
 	d.endpoints.Net = &Net{
 		store,
@@ -135,7 +157,36 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		return err
 	}
 
-	return d.registerService("debug", d.endpoints.Debug)
+	if err = d.registerService("debug", d.endpoints.Debug); err != nil {
+		return err
+	}
+
+	if d.params.adminStore != nil {
+		d.endpoints.Admin = &Admin{
+			d.params.adminStore,
+		}
+
+		if err = d.registerService("admin", d.endpoints.Admin); err != nil {
+			return err
+		}
+	}
+
+	if d.params.devStore == nil {
+		return nil
+	}
+
+	d.endpoints.Evm = &Evm{
+		d.params.devStore,
+	}
+	d.endpoints.Anvil = &Anvil{
+		d.params.devStore,
+	}
+
+	if err = d.registerService("evm", d.endpoints.Evm); err != nil {
+		return err
+	}
+
+	return d.registerService("anvil", d.endpoints.Anvil)
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
@@ -240,7 +291,8 @@ func (d *Dispatcher) RemoveFilterByWs(conn wsConn) {
 	d.filterManager.RemoveFilterByWs(conn)
 }
 
-func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
+// HandleWs handles the request received over the web socket connection of the given client
+func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn, client string) ([]byte, error) {
 	const (
 		openSquareBracket  byte = '['
 		closeSquareBracket byte = ']'
@@ -272,7 +324,7 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 		responses := make([][]byte, len(batchReq))
 
 		for i, req := range batchReq {
-			responses[i], err = d.handleSingleWs(req, conn).Bytes()
+			responses[i], err = d.handleSingleWs(req, conn, client).Bytes()
 			if err != nil {
 				return nil, err
 			}
@@ -294,10 +346,10 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 		return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 	}
 
-	return d.handleSingleWs(req, conn).Bytes()
+	return d.handleSingleWs(req, conn, client).Bytes()
 }
 
-func (d *Dispatcher) handleSingleWs(req Request, conn wsConn) Response {
+func (d *Dispatcher) handleSingleWs(req Request, conn wsConn, client string) Response {
 	id, err := formatID(req.ID)
 	if err != nil {
 		return NewRPCResponse(nil, "2.0", nil, err)
@@ -321,13 +373,15 @@ func (d *Dispatcher) handleSingleWs(req Request, conn wsConn) Response {
 		}
 	default:
 		// its a normal query that we handle with the dispatcher
-		response, err = d.handleReq(req)
+		response, err = d.handleReq(req, client)
 	}
 
 	return NewRPCResponse(id, "2.0", response, err)
 }
 
-func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
+// Handle handles the HTTP request of the given client.
+// An empty client identifier exempts the request from rate limiting
+func (d *Dispatcher) Handle(reqBody []byte, client string) ([]byte, error) {
 	x := bytes.TrimLeft(reqBody, " \t\r\n")
 	if len(x) == 0 {
 		return NewRPCResponse(nil, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
@@ -343,7 +397,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 			return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 		}
 
-		resp, err := d.handleReq(req)
+		resp, err := d.handleReq(req, client)
 
 		return NewRPCResponse(req.ID, "2.0", resp, err).Bytes()
 	}
@@ -372,7 +426,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 	responses := make([]Response, 0)
 
 	for _, req := range requests {
-		var response, err = d.handleReq(req)
+		var response, err = d.handleReq(req, client)
 		if err != nil {
 			errorResponse := NewRPCResponse(req.ID, "2.0", response, err)
 			responses = append(responses, errorResponse)
@@ -392,7 +446,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 	return respBytes, nil
 }
 
-func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
+func (d *Dispatcher) handleReq(req Request, client string) ([]byte, Error) {
 	d.logger.Debug("request", "method", req.Method, "id", req.ID)
 
 	service, fd, ferr := d.getFnHandler(req)
@@ -400,6 +454,26 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 		return nil, ferr
 	}
 
+	_, span := spanTracer.Start(context.Background(), req.Method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "jsonrpc"),
+			attribute.String("rpc.method", req.Method),
+		),
+	)
+
+	data, err := d.call(service, fd, req, client)
+	if err != nil {
+		tracing.End(span, err)
+	} else {
+		span.End()
+	}
+
+	return data, err
+}
+
+// call calls the endpoint function with the request params
+func (d *Dispatcher) call(service *serviceData, fd *funcData, req Request, client string) ([]byte, Error) {
 	inArgs := make([]reflect.Value, fd.inNum)
 	inArgs[0] = service.sv
 
@@ -417,6 +491,10 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 		}
 	}
 
+	if err := d.checkRateLimit(req.Method, client, inputs); err != nil {
+		return nil, err
+	}
+
 	var (
 		data []byte
 		err  error
@@ -433,6 +511,12 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 		metrics.IncrCounter([]string{jsonRPCMetric, req.Method + "_errors"}, 1)
 		d.logInternalError(req.Method, err)
 
+		// the params rejected by the endpoint are reported as such
+		var paramsErr *invalidParamsError
+		if errors.As(err, &paramsErr) {
+			return nil, paramsErr
+		}
+
 		if res := output[0].Interface(); res != nil {
 			data, ok = res.([]byte)
 
@@ -456,6 +540,57 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 	return data, nil
 }
 
+// checkRateLimit charges the cost of the request to the client
+// and returns an error if the client exceeded its rate limit
+func (d *Dispatcher) checkRateLimit(method, client string, inputs []interface{}) Error {
+	if d.rateLimiter == nil || client == "" {
+		return nil
+	}
+
+	cost := d.rateLimiter.MethodCost(method)
+
+	// eth_getLogs is weighted by the number of blocks it needs to go through
+	if method == "eth_getLogs" && len(inputs) == 1 {
+		if query, ok := inputs[0].(**LogQuery); ok && *query != nil {
+			cost += d.rateLimiter.LogsRangeCost(d.logsBlockRange(*query))
+		}
+	}
+
+	// the clients are not labeled, as their keys and addresses are neither bounded nor public
+	labels := []metrics.Label{
+		{Name: "method", Value: method},
+	}
+
+	if !d.rateLimiter.Allow(client, cost) {
+		metrics.IncrCounterWithLabels([]string{jsonRPCMetric, "rate_limited"}, 1, labels)
+
+		return NewRateLimitExceededError(method)
+	}
+
+	metrics.IncrCounterWithLabels([]string{jsonRPCMetric, "request_cost"}, float32(cost), labels)
+
+	return nil
+}
+
+// logsBlockRange returns the number of blocks the given log query spans
+func (d *Dispatcher) logsBlockRange(query *LogQuery) uint64 {
+	if query.BlockHash != nil || d.filterManager == nil {
+		return 0
+	}
+
+	from, err := GetNumericBlockNumber(query.fromBlock, d.filterManager.store)
+	if err != nil {
+		return 0
+	}
+
+	to, err := GetNumericBlockNumber(query.toBlock, d.filterManager.store)
+	if err != nil || to < from {
+		return 0
+	}
+
+	return to - from
+}
+
 func (d *Dispatcher) logInternalError(method string, err error) {
 	d.logger.Warn("failed to dispatch", "method", method, "err", err)
 }
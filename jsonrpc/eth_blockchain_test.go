@@ -13,6 +13,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEth_Block_GetBlockByNumber(t *testing.T) {
@@ -246,6 +247,218 @@ func TestEth_GetTransactionReceipt(t *testing.T) {
 	})
 }
 
+func TestEth_GetBlockReceipts(t *testing.T) {
+	t.Parallel()
+
+	store := newMockBlockStore()
+	eth := newTestEthEndpoint(store)
+	block := newTestBlock(1, hash4)
+	store.add(block)
+
+	txn0 := newTestTransaction(uint64(0), addr0)
+	txn1 := newTestTransaction(uint64(1), addr1)
+	block.Transactions = []*types.Transaction{txn0, txn1}
+
+	receipt0 := &types.Receipt{
+		Logs: []*types.Log{
+			{Topics: []types.Hash{hash1}},
+			{Topics: []types.Hash{hash2}},
+		},
+	}
+	receipt0.SetStatus(types.ReceiptSuccess)
+
+	receipt1 := &types.Receipt{
+		Logs: []*types.Log{
+			{Topics: []types.Hash{hash3}},
+		},
+	}
+	receipt1.SetStatus(types.ReceiptFailed)
+
+	store.receipts[hash4] = []*types.Receipt{receipt0, receipt1}
+
+	t.Run("returns all receipts by block number", func(t *testing.T) {
+		t.Parallel()
+
+		num := BlockNumber(1)
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockNumber: &num})
+		require.NoError(t, err)
+
+		//nolint:forcetypeassert
+		receipts := res.([]*receipt)
+		require.Len(t, receipts, 2)
+
+		assert.Equal(t, txn0.Hash, receipts[0].TxHash)
+		assert.Equal(t, uint64(types.ReceiptSuccess), uint64(receipts[0].Status))
+		assert.Len(t, receipts[0].Logs, 2)
+		assert.Equal(t, uint64(1), uint64(receipts[0].Logs[1].LogIndex))
+
+		assert.Equal(t, txn1.Hash, receipts[1].TxHash)
+		assert.Equal(t, uint64(1), uint64(receipts[1].TxIndex))
+		assert.Equal(t, uint64(types.ReceiptFailed), uint64(receipts[1].Status))
+		require.Len(t, receipts[1].Logs, 1)
+		assert.Equal(t, uint64(2), uint64(receipts[1].Logs[0].LogIndex))
+		assert.Equal(t, block.Hash(), receipts[1].Logs[0].BlockHash)
+	})
+
+	t.Run("returns all receipts by block hash", func(t *testing.T) {
+		t.Parallel()
+
+		hash := block.Hash()
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash})
+		require.NoError(t, err)
+
+		//nolint:forcetypeassert
+		assert.Len(t, res.([]*receipt), 2)
+	})
+
+	t.Run("returns error for unknown block", func(t *testing.T) {
+		t.Parallel()
+
+		hash := hash1
+
+		_, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash})
+		assert.Error(t, err)
+	})
+}
+
+func TestEth_SimulateV1(t *testing.T) {
+	t.Parallel()
+
+	newCall := func(to types.Address) *txnArgs {
+		return &txnArgs{
+			From:     &addr0,
+			To:       &to,
+			Gas:      argUintPtr(21000),
+			GasPrice: argBytesPtr([]byte{0x64}),
+		}
+	}
+
+	t.Run("returns error if there are no blocks to simulate", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.add(newTestBlock(100, hash1))
+		eth := newTestEthEndpoint(store)
+
+		_, err := eth.SimulateV1(&simulateArgs{}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrNoSimulatedBlocks)
+	})
+
+	t.Run("returns error if a block state call is null", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.add(newTestBlock(100, hash1))
+		eth := newTestEthEndpoint(store)
+
+		_, err := eth.SimulateV1(&simulateArgs{
+			BlockStateCalls: []*simulateBlockArgs{{}, nil},
+		}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrNullSimulatedBlock)
+	})
+
+	t.Run("returns error if block numbers are not increasing", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.add(newTestBlock(100, hash1))
+		eth := newTestEthEndpoint(store)
+
+		_, err := eth.SimulateV1(&simulateArgs{
+			BlockStateCalls: []*simulateBlockArgs{
+				{BlockOverrides: &blockOverride{Number: argUintPtr(100)}},
+			},
+		}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrSimulatedBlockNumber)
+	})
+
+	t.Run("returns error if there are too many blocks or calls to simulate", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.add(newTestBlock(100, hash1))
+		eth := newTestEthEndpoint(store)
+
+		_, err := eth.SimulateV1(&simulateArgs{
+			BlockStateCalls: make([]*simulateBlockArgs, maxSimulatedBlocks+1),
+		}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrTooManySimulatedBlocks)
+
+		calls := make([]*txnArgs, maxSimulatedCalls/2+1)
+
+		_, err = eth.SimulateV1(&simulateArgs{
+			BlockStateCalls: []*simulateBlockArgs{{Calls: calls}, {Calls: calls}},
+		}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrTooManySimulatedCalls)
+	})
+
+	t.Run("returns results of calls across simulated blocks", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.add(newTestBlock(100, hash1))
+		eth := newTestEthEndpoint(store)
+
+		res, err := eth.SimulateV1(&simulateArgs{
+			BlockStateCalls: []*simulateBlockArgs{
+				{
+					Calls: []*txnArgs{newCall(addr1), newCall(addr2)},
+				},
+				{
+					BlockOverrides: &blockOverride{
+						Number:  argUintPtr(110),
+						Time:    argUintPtr(5000),
+						BaseFee: argUintPtr(7),
+					},
+					Calls: []*txnArgs{newCall(addr1)},
+				},
+			},
+		}, BlockNumberOrHash{})
+		require.NoError(t, err)
+
+		//nolint:forcetypeassert
+		blocks := res.([]*simulatedBlock)
+		require.Len(t, blocks, 2)
+
+		assert.Equal(t, uint64(101), uint64(blocks[0].Number))
+		assert.Equal(t, uint64(42000), uint64(blocks[0].GasUsed))
+		require.Len(t, blocks[0].Calls, 2)
+		assert.Equal(t, uint64(types.ReceiptSuccess), uint64(blocks[0].Calls[1].Status))
+		require.Len(t, blocks[0].Calls[1].Logs, 1)
+		assert.Equal(t, addr2, blocks[0].Calls[1].Logs[0].Address)
+		assert.Equal(t, uint64(1), uint64(blocks[0].Calls[1].Logs[0].LogIndex))
+
+		assert.Equal(t, uint64(110), uint64(blocks[1].Number))
+		assert.Equal(t, uint64(5000), uint64(blocks[1].Timestamp))
+		assert.Equal(t, uint64(7), uint64(blocks[1].BaseFee))
+		assert.Len(t, blocks[1].Calls, 1)
+	})
+
+	t.Run("returns revert error of a reverted call", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.add(newTestBlock(100, hash1))
+		store.ethCallError = runtime.ErrExecutionReverted
+		eth := newTestEthEndpoint(store)
+
+		res, err := eth.SimulateV1(&simulateArgs{
+			BlockStateCalls: []*simulateBlockArgs{
+				{Calls: []*txnArgs{newCall(addr1)}},
+			},
+		}, BlockNumberOrHash{})
+		require.NoError(t, err)
+
+		//nolint:forcetypeassert
+		call := res.([]*simulatedBlock)[0].Calls[0]
+		assert.Equal(t, uint64(types.ReceiptFailed), uint64(call.Status))
+		require.NotNil(t, call.Error)
+		assert.Equal(t, 3, call.Error.Code)
+	})
+}
+
 func TestEth_Syncing(t *testing.T) {
 	store := newMockBlockStore()
 	eth := newTestEthEndpoint(store)
@@ -457,6 +670,11 @@ type mockBlockStore struct {
 	forksInTime     chain.ForksInTime
 	baseFee         uint64
 
+	// blocks up to logIndexHead are filtered by the log index, if enabled
+	logIndexEnabled bool
+	logIndexHead    uint64
+	indexedBlocks   []uint64
+
 	maxPriorityFeePerGasFn func() (*big.Int, error)
 }
 
@@ -490,6 +708,22 @@ func (m *mockBlockStore) appendBlocksToStore(blocks []*types.Block) {
 	}
 }
 
+func (m *mockBlockStore) LogIndexHead() (uint64, bool) {
+	return m.logIndexHead, m.logIndexEnabled
+}
+
+func (m *mockBlockStore) FilterLogBlocks(from, to uint64, addresses []types.Address, topics [][]types.Hash) []uint64 {
+	blocks := make([]uint64, 0)
+
+	for _, num := range m.indexedBlocks {
+		if num >= from && num <= to && num <= m.logIndexHead {
+			blocks = append(blocks, num)
+		}
+	}
+
+	return blocks
+}
+
 func (m *mockBlockStore) setupLogs() {
 	m.receipts = make(map[types.Hash][]*types.Receipt)
 
@@ -575,6 +809,14 @@ func (m *mockBlockStore) GetBlockByNumber(blockNumber uint64, full bool) (*types
 	return nil, false
 }
 
+func (m *mockBlockStore) GetHeaderByNumber(blockNumber uint64) (*types.Header, bool) {
+	if b, ok := m.GetBlockByNumber(blockNumber, false); ok {
+		return b.Header, true
+	}
+
+	return nil, false
+}
+
 func (m *mockBlockStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
 	for _, b := range m.blocks {
 		if b.Hash() == hash {
@@ -635,6 +877,29 @@ func (m *mockBlockStore) ApplyTxn(header *types.Header, txn *types.Transaction,
 	}, nil
 }
 
+func (m *mockBlockStore) SimulateBlocks(header *types.Header, blocks []*SimulatedBlock) ([][]*SimulatedCall, error) {
+	results := make([][]*SimulatedCall, len(blocks))
+
+	for i, block := range blocks {
+		results[i] = make([]*SimulatedCall, len(block.Txns))
+
+		for j, txn := range block.Txns {
+			results[i][j] = &SimulatedCall{
+				Result: &runtime.ExecutionResult{
+					Err:         m.ethCallError,
+					ReturnValue: m.returnValue,
+					GasUsed:     txn.Gas,
+				},
+				Logs: []*types.Log{
+					{Address: *txn.To},
+				},
+			}
+		}
+	}
+
+	return results, nil
+}
+
 func (m *mockBlockStore) SubscribeEvents() blockchain.Subscription {
 	return nil
 }
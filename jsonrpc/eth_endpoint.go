@@ -70,6 +70,9 @@ type ethBlockchainStore interface {
 
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
+
+	// SimulateBlocks executes the simulated blocks one after another on top of the state of the given header
+	SimulateBlocks(header *types.Header, blocks []*SimulatedBlock) ([][]*SimulatedCall, error)
 }
 
 type ethFilter interface {
@@ -93,10 +96,24 @@ type Eth struct {
 	chainID       uint64
 	filterManager *FilterManager
 	priceLimit    uint64
+	devStore      DevStore // nil if the node is not in the dev mode
 }
 
+const (
+	// maxSimulatedBlocks is the maximum number of blocks simulated by a single request
+	maxSimulatedBlocks = 256
+
+	// maxSimulatedCalls is the maximum number of calls, across all blocks, simulated by a single request
+	maxSimulatedCalls = 1000
+)
+
 var (
-	ErrInsufficientFunds = errors.New("insufficient funds for execution")
+	ErrInsufficientFunds      = errors.New("insufficient funds for execution")
+	ErrNoSimulatedBlocks      = errors.New("no blocks to simulate")
+	ErrNullSimulatedBlock     = NewInvalidParamsError("the block state calls must not be null")
+	ErrSimulatedBlockNumber   = errors.New("simulated block numbers must be increasing")
+	ErrTooManySimulatedBlocks = fmt.Errorf("too many blocks to simulate, the limit is %d", maxSimulatedBlocks)
+	ErrTooManySimulatedCalls  = fmt.Errorf("too many calls to simulate, the limit is %d", maxSimulatedCalls)
 )
 
 // ChainId returns the chain id of the client
@@ -211,10 +228,50 @@ func (e *Eth) SendRawTransaction(buf argBytes) (interface{}, error) {
 	return tx.Hash.String(), nil
 }
 
-// SendTransaction rejects eth_sendTransaction json-rpc call as we don't support wallet management
-func (e *Eth) SendTransaction(_ *txnArgs) (interface{}, error) {
-	return nil, fmt.Errorf("request calls to eth_sendTransaction method are not supported," +
-		" use eth_sendRawTransaction instead")
+// SendTransaction sends an unsigned transaction of an account impersonated in the dev mode.
+// Otherwise it rejects eth_sendTransaction json-rpc call as we don't support wallet management
+func (e *Eth) SendTransaction(arg *txnArgs) (interface{}, error) {
+	if e.devStore == nil || arg == nil || arg.From == nil || !e.devStore.IsImpersonated(*arg.From) {
+		return nil, fmt.Errorf("request calls to eth_sendTransaction method are not supported," +
+			" use eth_sendRawTransaction instead")
+	}
+
+	if arg.Nonce == nil {
+		arg.Nonce = argUintPtr(e.devStore.PendingNonce(*arg.From))
+	}
+
+	if arg.Gas == nil {
+		// the estimation overwrites the nonce and the gas of its arguments
+		estimateArg := *arg
+
+		gas, err := e.EstimateGas(&estimateArg, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		estimatedGas, ok := gas.(argUint64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected gas estimation %v", gas)
+		}
+
+		arg.Gas = &estimatedGas
+	}
+
+	tx, err := DecodeTxn(arg, e.store, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.fillTransactionGasPrice(tx); err != nil {
+		return nil, err
+	}
+
+	// the hash is computed once the transaction is complete
+	if err := e.devStore.AddImpersonatedTx(tx); err != nil {
+		return nil, err
+	}
+
+	return tx.Hash.String(), nil
 }
 
 // GetTransactionByHash returns a transaction by its hash.
@@ -333,37 +390,53 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 		logIndex += len(receipts[i].Logs)
 	}
 
-	raw := receipts[txIndex]
-
-	logs := make([]*Log, len(raw.Logs))
-	for i, elem := range raw.Logs {
-		logs[i] = &Log{
-			Address:     elem.Address,
-			Topics:      elem.Topics,
-			Data:        argBytes(elem.Data),
-			BlockHash:   block.Hash(),
-			BlockNumber: argUint64(block.Number()),
-			TxHash:      hash,
-			TxIndex:     argUint64(txIndex),
-			LogIndex:    argUint64(logIndex + i),
-			Removed:     false,
-		}
+	return toReceipt(receipts[txIndex], txn, uint64(txIndex), block.Header, logIndex), nil
+}
+
+// GetBlockReceipts returns the receipts of all transactions in the block referenced by number, hash or tag
+func (e *Eth) GetBlockReceipts(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := e.store.GetBlockByHash(header.Hash, true)
+	if !ok {
+		// block not found
+		return nil, nil
+	}
+
+	if len(block.Transactions) == 0 {
+		return []*receipt{}, nil
+	}
+
+	receipts, err := e.store.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		// block receipts not found
+		e.logger.Warn(
+			fmt.Sprintf("Receipts for block with hash [%s] not found", block.Hash().String()),
+		)
+
+		return nil, nil
 	}
 
-	res := &receipt{
-		Root:              raw.Root,
-		CumulativeGasUsed: argUint64(raw.CumulativeGasUsed),
-		LogsBloom:         raw.LogsBloom,
-		Status:            argUint64(*raw.Status),
-		TxHash:            hash,
-		TxIndex:           argUint64(txIndex),
-		BlockHash:         block.Hash(),
-		BlockNumber:       argUint64(block.Number()),
-		GasUsed:           argUint64(raw.GasUsed),
-		ContractAddress:   raw.ContractAddress,
-		FromAddr:          txn.From,
-		ToAddr:            txn.To,
-		Logs:              logs,
+	if len(receipts) != len(block.Transactions) {
+		// Receipts not written yet on the db
+		e.logger.Warn(
+			fmt.Sprintf("No receipts found for block with hash [%s]", block.Hash().String()),
+		)
+
+		return nil, nil
+	}
+
+	var (
+		res      = make([]*receipt, len(receipts))
+		logIndex = 0
+	)
+
+	for i, raw := range receipts {
+		res[i] = toReceipt(raw, block.Transactions[i], uint64(i), block.Header, logIndex)
+		logIndex += len(raw.Logs)
 	}
 
 	return res, nil
@@ -527,6 +600,146 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOve
 	return argBytesPtr(result.ReturnValue), nil
 }
 
+// SimulatedBlock is a block of calls executed by the call simulation
+type SimulatedBlock struct {
+	BlockOverride *types.BlockOverride
+	StateOverride types.StateOverride
+	Txns          []*types.Transaction
+}
+
+// SimulatedCall holds the outcome of a single simulated call
+type SimulatedCall struct {
+	Result *runtime.ExecutionResult
+	Logs   []*types.Log
+}
+
+type blockOverride struct {
+	Number       *argUint64     `json:"number"`
+	Time         *argUint64     `json:"time"`
+	GasLimit     *argUint64     `json:"gasLimit"`
+	FeeRecipient *types.Address `json:"feeRecipient"`
+	BaseFee      *argUint64     `json:"baseFeePerGas"`
+}
+
+type simulateBlockArgs struct {
+	BlockOverrides *blockOverride `json:"blockOverrides"`
+	StateOverrides *stateOverride `json:"stateOverrides"`
+	Calls          []*txnArgs     `json:"calls"`
+}
+
+type simulateArgs struct {
+	BlockStateCalls []*simulateBlockArgs `json:"blockStateCalls"`
+}
+
+// SimulateV1 executes the ordered list of calls across one or more simulated blocks
+// on top of the state of the referenced block. State changes are carried over to the subsequent calls and blocks.
+// Simulated blocks without overrides follow the parent block with the number and timestamp incremented by one
+func (e *Eth) SimulateV1(args *simulateArgs, filter BlockNumberOrHash) (interface{}, error) {
+	if args == nil || len(args.BlockStateCalls) == 0 {
+		return nil, ErrNoSimulatedBlocks
+	}
+
+	if len(args.BlockStateCalls) > maxSimulatedBlocks {
+		return nil, ErrTooManySimulatedBlocks
+	}
+
+	calls := 0
+	for _, blockArgs := range args.BlockStateCalls {
+		if blockArgs == nil {
+			return nil, ErrNullSimulatedBlock
+		}
+
+		if calls += len(blockArgs.Calls); calls > maxSimulatedCalls {
+			return nil, ErrTooManySimulatedCalls
+		}
+	}
+
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		blocks    = make([]*SimulatedBlock, len(args.BlockStateCalls))
+		number    = header.Number
+		timestamp = header.Timestamp
+		gasLimit  = header.GasLimit
+		baseFee   = header.BaseFee
+	)
+
+	for i, blockArgs := range args.BlockStateCalls {
+		number++
+		timestamp++
+
+		block := &SimulatedBlock{
+			BlockOverride: &types.BlockOverride{},
+			Txns:          make([]*types.Transaction, len(blockArgs.Calls)),
+		}
+
+		if o := blockArgs.BlockOverrides; o != nil {
+			if o.Number != nil {
+				if uint64(*o.Number) < number {
+					return nil, fmt.Errorf("%w: block %d", ErrSimulatedBlockNumber, uint64(*o.Number))
+				}
+
+				number = uint64(*o.Number)
+			}
+
+			if o.Time != nil {
+				timestamp = uint64(*o.Time)
+			}
+
+			if o.GasLimit != nil {
+				gasLimit = uint64(*o.GasLimit)
+			}
+
+			if o.BaseFee != nil {
+				baseFee = uint64(*o.BaseFee)
+			}
+
+			block.BlockOverride.Coinbase = o.FeeRecipient
+		}
+
+		// every block keeps its own copy of the running values
+		blockNumber, blockTimestamp, blockGasLimit := number, timestamp, gasLimit
+
+		block.BlockOverride.Number = &blockNumber
+		block.BlockOverride.Timestamp = &blockTimestamp
+		block.BlockOverride.GasLimit = &blockGasLimit
+		block.BlockOverride.BaseFee = new(big.Int).SetUint64(baseFee)
+
+		if blockArgs.StateOverrides != nil {
+			block.StateOverride = types.StateOverride{}
+			for addr, o := range *blockArgs.StateOverrides {
+				block.StateOverride[addr] = o.ToType()
+			}
+		}
+
+		for j, arg := range blockArgs.Calls {
+			// nonce of the call is taken from the simulated state
+			txn, err := DecodeTxn(arg, e.store, false)
+			if err != nil {
+				return nil, fmt.Errorf("invalid call %d in block %d: %w", j, i, err)
+			}
+
+			if err := e.fillTransactionGasPrice(txn); err != nil {
+				return nil, err
+			}
+
+			block.Txns[j] = txn
+		}
+
+		blocks[i] = block
+	}
+
+	results, err := e.store.SimulateBlocks(header, blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSimulatedBlocks(blocks, results), nil
+}
+
 // EstimateGas estimates the gas needed to execute a transaction
 func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error) {
 	number := LatestBlockNumber
@@ -310,6 +310,12 @@ type filterManagerStore interface {
 
 	// TxPoolSubscribe subscribes for tx pool events
 	TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error)
+
+	// LogIndexHead returns the last block covered by the log index, false if the log index is disabled
+	LogIndexHead() (uint64, bool)
+
+	// FilterLogBlocks returns the numbers of the indexed blocks in the range which may contain matching logs
+	FilterLogBlocks(from, to uint64, addresses []types.Address, topics [][]types.Hash) []uint64
 }
 
 // FilterManager manages all running filters
@@ -320,10 +326,11 @@ type FilterManager struct {
 
 	timeout time.Duration
 
-	store           filterManagerStore
-	subscription    blockchain.Subscription
-	blockStream     *blockStream
-	blockRangeLimit uint64
+	store                  filterManagerStore
+	subscription           blockchain.Subscription
+	blockStream            *blockStream
+	blockRangeLimit        uint64
+	indexedBlockRangeLimit uint64
 
 	filters  map[string]filter
 	timeouts timeHeapImpl
@@ -332,16 +339,22 @@ type FilterManager struct {
 	closeCh  chan struct{}
 }
 
-func NewFilterManager(logger hclog.Logger, store filterManagerStore, blockRangeLimit uint64) *FilterManager {
+func NewFilterManager(
+	logger hclog.Logger,
+	store filterManagerStore,
+	blockRangeLimit uint64,
+	indexedBlockRangeLimit uint64,
+) *FilterManager {
 	m := &FilterManager{
-		logger:          logger.Named("filter"),
-		timeout:         defaultTimeout,
-		store:           store,
-		blockRangeLimit: blockRangeLimit,
-		filters:         make(map[string]filter),
-		timeouts:        timeHeapImpl{},
-		updateCh:        make(chan struct{}),
-		closeCh:         make(chan struct{}),
+		logger:                 logger.Named("filter"),
+		timeout:                defaultTimeout,
+		store:                  store,
+		blockRangeLimit:        blockRangeLimit,
+		indexedBlockRangeLimit: indexedBlockRangeLimit,
+		filters:                make(map[string]filter),
+		timeouts:               timeHeapImpl{},
+		updateCh:               make(chan struct{}),
+		closeCh:                make(chan struct{}),
 	}
 
 	// start blockstream with the current header
@@ -537,35 +550,77 @@ func (f *FilterManager) getLogsFromBlocks(query *LogQuery) ([]*Log, error) {
 		from = 1
 	}
 
+	// blocks covered by the log index are filtered by it,
+	// the remaining ones have to be checked one by one.
+	// The queries without any address or topic match every block, so they can't use the index
+	indexHead, indexEnabled := f.store.LogIndexHead()
+	indexEnabled = indexEnabled && query.hasIndexCriteria()
+
+	scanFrom := from
+	if indexEnabled && indexHead >= from {
+		scanFrom = indexHead + 1
+	}
+
 	// if not disabled, avoid handling large block ranges
-	if f.blockRangeLimit != 0 && to-from > f.blockRangeLimit {
+	if f.blockRangeLimit != 0 && to >= scanFrom && to-scanFrom > f.blockRangeLimit {
+		return nil, ErrBlockRangeTooHigh
+	}
+
+	if indexEnabled && f.indexedBlockRangeLimit != 0 && to >= from && to-from > f.indexedBlockRangeLimit {
 		return nil, ErrBlockRangeTooHigh
 	}
 
 	logs := make([]*Log, 0)
 
-	for i := from; i <= to; i++ {
-		block, ok := f.store.GetBlockByNumber(i, true)
-		if !ok {
-			break
-		}
+	if scanFrom > from {
+		for _, num := range f.store.FilterLogBlocks(from, scanFrom-1, query.Addresses, query.Topics) {
+			blockLogs, ok, err := f.getLogsFromBlockNumber(query, num)
+			if err != nil {
+				return nil, err
+			}
 
-		if len(block.Transactions) == 0 {
-			// do not check logs if no txs
-			continue
+			if !ok {
+				return logs, nil
+			}
+
+			logs = append(logs, blockLogs...)
 		}
+	}
 
-		blockLogs, err := f.getLogsFromBlock(query, block)
+	for i := scanFrom; i <= to; i++ {
+		blockLogs, ok, err := f.getLogsFromBlockNumber(query, i)
 		if err != nil {
 			return nil, err
 		}
 
+		if !ok {
+			break
+		}
+
 		logs = append(logs, blockLogs...)
 	}
 
 	return logs, nil
 }
 
+// getLogsFromBlockNumber returns the logs matching the query from the block with the given number.
+// Returns false if the block is not found
+func (f *FilterManager) getLogsFromBlockNumber(query *LogQuery, num uint64) ([]*Log, bool, error) {
+	block, ok := f.store.GetBlockByNumber(num, true)
+	if !ok {
+		return nil, false, nil
+	}
+
+	if len(block.Transactions) == 0 {
+		// do not check logs if no txs
+		return nil, true, nil
+	}
+
+	logs, err := f.getLogsFromBlock(query, block)
+
+	return logs, true, err
+}
+
 // GetLogsForQuery return array of logs for given query
 func (f *FilterManager) GetLogsForQuery(query *LogQuery) ([]*Log, error) {
 	if query.BlockHash != nil {
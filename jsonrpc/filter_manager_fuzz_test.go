@@ -48,7 +48,7 @@ func FuzzGetLogsForQuery(f *testing.F) {
 
 	store.appendBlocksToStore(blocks)
 
-	fm := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	fm := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 
 	f.Cleanup(func() {
 		defer fm.Close()
@@ -105,7 +105,7 @@ func FuzzGetLogsForQuery(f *testing.F) {
 func FuzzGetLogFilterFromID(f *testing.F) {
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
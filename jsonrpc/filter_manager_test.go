@@ -129,7 +129,7 @@ func Test_GetLogsForQuery(t *testing.T) {
 
 	store.appendBlocksToStore(blocks)
 
-	f := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	f := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 
 	t.Cleanup(func() {
 		defer f.Close()
@@ -154,6 +154,118 @@ func Test_GetLogsForQuery(t *testing.T) {
 	}
 }
 
+func Test_GetLogsForQuery_LogIndex(t *testing.T) {
+	t.Parallel()
+
+	topics := [][]types.Hash{
+		{types.StringToHash("4")},
+		{types.StringToHash("5")},
+		{types.StringToHash("6")},
+	}
+
+	newStore := func(logIndexEnabled bool) *mockBlockStore {
+		store := &mockBlockStore{
+			topics: []types.Hash{topics[0][0], topics[1][0], topics[2][0]},
+		}
+		store.setupLogs()
+
+		blocks := make([]*types.Block, 5)
+
+		for i := range blocks {
+			blocks[i] = &types.Block{
+				Header: &types.Header{
+					Number: uint64(i),
+					Hash:   types.StringToHash(strconv.Itoa(i)),
+				},
+				Transactions: []*types.Transaction{
+					{Value: big.NewInt(10)},
+					{Value: big.NewInt(11)},
+					{Value: big.NewInt(12)},
+				},
+			}
+		}
+
+		store.appendBlocksToStore(blocks)
+
+		// the index covers blocks up to 2, and only the block 2 may contain the logs
+		store.logIndexEnabled = logIndexEnabled
+		store.logIndexHead = 2
+		store.indexedBlocks = []uint64{2}
+
+		return store
+	}
+
+	t.Run("blocks skipped by the log index are not checked", func(t *testing.T) {
+		t.Parallel()
+
+		f := NewFilterManager(hclog.NewNullLogger(), newStore(true), 1000, 0)
+		t.Cleanup(f.Close)
+
+		logs, err := f.GetLogsForQuery(&LogQuery{
+			fromBlock: 1,
+			toBlock:   4,
+			Topics:    topics,
+		})
+		require.NoError(t, err)
+
+		// the block 2 from the index and the block 3 after the index head
+		require.Len(t, logs, 2)
+		assert.Equal(t, uint64(2), uint64(logs[0].BlockNumber))
+		assert.Equal(t, uint64(3), uint64(logs[1].BlockNumber))
+	})
+
+	t.Run("block range limit applies to the blocks after the log index head", func(t *testing.T) {
+		t.Parallel()
+
+		query := &LogQuery{
+			fromBlock: 1,
+			toBlock:   4,
+			Topics:    topics,
+		}
+
+		f := NewFilterManager(hclog.NewNullLogger(), newStore(false), 1, 0)
+		t.Cleanup(f.Close)
+
+		_, err := f.GetLogsForQuery(query)
+		require.ErrorIs(t, err, ErrBlockRangeTooHigh)
+
+		f = NewFilterManager(hclog.NewNullLogger(), newStore(true), 1, 0)
+		t.Cleanup(f.Close)
+
+		logs, err := f.GetLogsForQuery(query)
+		require.NoError(t, err)
+		assert.Len(t, logs, 2)
+	})
+
+	t.Run("block range limit applies to the whole range of the query without criteria", func(t *testing.T) {
+		t.Parallel()
+
+		f := NewFilterManager(hclog.NewNullLogger(), newStore(true), 1, 1000)
+		t.Cleanup(f.Close)
+
+		_, err := f.GetLogsForQuery(&LogQuery{
+			fromBlock: 1,
+			toBlock:   4,
+			Topics:    [][]types.Hash{{}},
+		})
+		require.ErrorIs(t, err, ErrBlockRangeTooHigh)
+	})
+
+	t.Run("indexed block range limit applies to the whole range", func(t *testing.T) {
+		t.Parallel()
+
+		f := NewFilterManager(hclog.NewNullLogger(), newStore(true), 1000, 2)
+		t.Cleanup(f.Close)
+
+		_, err := f.GetLogsForQuery(&LogQuery{
+			fromBlock: 1,
+			toBlock:   4,
+			Topics:    topics,
+		})
+		require.ErrorIs(t, err, ErrBlockRangeTooHigh)
+	})
+}
+
 func Test_getLogsFromBlock(t *testing.T) {
 	t.Parallel()
 
@@ -211,7 +323,7 @@ func Test_getLogsFromBlock(t *testing.T) {
 
 	store.appendBlocksToStore([]*types.Block{block})
 
-	f := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	f := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 
 	t.Cleanup(func() {
 		defer f.Close()
@@ -235,7 +347,7 @@ func Test_GetLogFilterFromID(t *testing.T) {
 
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
@@ -258,7 +370,7 @@ func TestFilterLog(t *testing.T) {
 
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
@@ -322,7 +434,7 @@ func TestFilterBlock(t *testing.T) {
 
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
@@ -387,7 +499,7 @@ func TestFilterPendingTx(t *testing.T) {
 
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
@@ -437,7 +549,7 @@ func TestFilterTimeout(t *testing.T) {
 
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	m.timeout = 2 * time.Second
@@ -459,7 +571,7 @@ func TestRemoveFilterByWebsocket(t *testing.T) {
 
 	mock, _ := newMockWsConnWithMsgCh()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
@@ -477,7 +589,7 @@ func Test_flushWsFilters(t *testing.T) {
 
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 
 	t.Cleanup(func() {
 		m.Close()
@@ -566,7 +678,7 @@ func TestFilterWebsocket(t *testing.T) {
 
 	mock, msgCh := newMockWsConnWithMsgCh()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
@@ -602,7 +714,7 @@ func TestFilterPendingTxWebsocket(t *testing.T) {
 
 	mock, msgCh := newMockWsConnWithMsgCh()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
@@ -767,7 +879,7 @@ func TestClosedFilterDeletion(t *testing.T) {
 
 	store := newMockStore()
 
-	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 	defer m.Close()
 
 	go m.Run()
@@ -851,7 +963,7 @@ func Test_appendLogsToFilters(t *testing.T) {
 
 	store.appendBlocksToStore([]*types.Block{block})
 
-	f := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	f := NewFilterManager(hclog.NewNullLogger(), store, 1000, 0)
 
 	logFilter := &logFilter{
 		filterBase: newFilterBase(nil),
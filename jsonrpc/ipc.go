@@ -0,0 +1,136 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/gorilla/websocket"
+	"github.com/hashicorp/go-hclog"
+)
+
+// ipcWrapper is a wrapping object for the IPC connection and logger.
+// It implements wsConn, so the IPC clients can use subscriptions as web socket clients do
+type ipcWrapper struct {
+	sync.Mutex
+
+	conn     net.Conn     // the actual IPC connection
+	logger   hclog.Logger // module logger
+	filterID string       // filter ID
+}
+
+func (w *ipcWrapper) SetFilterID(filterID string) {
+	w.filterID = filterID
+}
+
+func (w *ipcWrapper) GetFilterID() string {
+	return w.filterID
+}
+
+// WriteMessage writes out the message to the IPC peer as a single newline delimited line
+func (w *ipcWrapper) WriteMessage(_ int, data []byte) error {
+	var buf bytes.Buffer
+
+	// subscription notifications are formatted over multiple lines
+	if err := json.Compact(&buf, data); err != nil {
+		buf.Reset()
+		buf.Write(data)
+	}
+
+	buf.WriteByte('\n')
+
+	w.Lock()
+	defer w.Unlock()
+
+	_, writeErr := w.conn.Write(buf.Bytes())
+	if writeErr != nil {
+		w.logger.Error(
+			fmt.Sprintf("Unable to write IPC message, %s", writeErr.Error()),
+		)
+	}
+
+	return writeErr
+}
+
+func (j *JSONRPC) setupIPC() error {
+	lis, err := ipc.Listen(j.config.IPCPath)
+	if err != nil {
+		return fmt.Errorf("unable to listen on IPC path %s: %w", j.config.IPCPath, err)
+	}
+
+	j.ipcListener = lis
+
+	j.logger.Info("ipc server started", "path", j.config.IPCPath)
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					j.logger.Error("closed ipc listener", "err", err)
+				}
+
+				return
+			}
+
+			go j.handleIPC(conn)
+		}
+	}()
+
+	return nil
+}
+
+// handleIPC serves the stream of JSON-RPC requests received over the IPC connection
+func (j *JSONRPC) handleIPC(conn net.Conn) {
+	defer func() {
+		if err := conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			j.logger.Error(
+				fmt.Sprintf("Unable to gracefully close IPC connection, %s", err.Error()),
+			)
+		}
+	}()
+
+	wrapConn := &ipcWrapper{conn: conn, logger: j.logger}
+	decoder := json.NewDecoder(conn)
+
+	j.logger.Debug("IPC connection established")
+
+	for {
+		var message json.RawMessage
+
+		// read the next request (or batch of requests) from the stream
+		if err := decoder.Decode(&message); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+				j.logger.Debug("Closing IPC connection gracefully")
+			} else {
+				j.logger.Error(fmt.Sprintf("Unable to read IPC message, %s", err.Error()))
+
+				// the stream can not be recovered after a malformed message
+				resp, _ := NewRPCResponse(nil, "2.0", nil,
+					NewInvalidRequestError("Invalid json request")).Bytes()
+				_ = wrapConn.WriteMessage(websocket.TextMessage, resp)
+			}
+
+			j.dispatcher.RemoveFilterByWs(wrapConn)
+
+			return
+		}
+
+		go func() {
+			// IPC clients are local and trusted, so they are not rate limited
+			resp, handleErr := j.dispatcher.HandleWs(message, wrapConn, "")
+			if handleErr != nil {
+				j.logger.Error(fmt.Sprintf("Unable to handle IPC request, %s", handleErr.Error()))
+
+				resp, _ = NewRPCResponse(nil, "2.0", nil, NewInternalError(handleErr.Error())).Bytes()
+			}
+
+			_ = wrapConn.WriteMessage(websocket.TextMessage, resp)
+		}()
+	}
+}
@@ -0,0 +1,93 @@
+//go:build !windows
+// +build !windows
+
+package jsonrpc
+
+import (
+	"bufio"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestIPCServer(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	ipcPath := filepath.Join(t.TempDir(), "edge.ipc")
+
+	srv, err := NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store:   store,
+		Addr:    &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port},
+		IPCPath: ipcPath,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, srv.Close())
+	})
+
+	conn, err := ipc.DialTimeout(ipcPath, time.Second)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+
+	readResponse := func(v interface{}) {
+		t.Helper()
+
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+
+		line, err := reader.ReadBytes('\n')
+		require.NoError(t, err)
+		require.NoError(t, expectJSONResult(line, v))
+	}
+
+	// plain request
+	_, err = conn.Write([]byte(`{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion","params":[]}`))
+	require.NoError(t, err)
+
+	var version string
+
+	readResponse(&version)
+	assert.NotEmpty(t, version)
+
+	// subscriptions are pushed over the same connection
+	_, err = conn.Write([]byte(`{"jsonrpc":"2.0","id":2,"method":"eth_subscribe","params":["newHeads"]}`))
+	require.NoError(t, err)
+
+	var filterID string
+
+	readResponse(&filterID)
+	require.NotEmpty(t, filterID)
+
+	store.emitEvent(&mockEvent{
+		NewChain: []*mockHeader{
+			{
+				header: &types.Header{
+					Hash: types.StringToHash("1"),
+				},
+			},
+		},
+	})
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+
+	notification, err := reader.ReadBytes('\n')
+	require.NoError(t, err)
+	assert.Contains(t, string(notification), filterID)
+}
@@ -37,15 +37,19 @@ func (s serverType) String() string {
 
 // JSONRPC is an API consensus
 type JSONRPC struct {
-	logger     hclog.Logger
-	config     *Config
-	dispatcher dispatcher
+	logger      hclog.Logger
+	config      *Config
+	dispatcher  dispatcher
+	ipcListener net.Listener
+
+	// apiKeys are the API keys trusted to identify the rate limited clients
+	apiKeys map[string]struct{}
 }
 
 type dispatcher interface {
 	RemoveFilterByWs(conn wsConn)
-	HandleWs(reqBody []byte, conn wsConn) ([]byte, error)
-	Handle(reqBody []byte) ([]byte, error)
+	HandleWs(reqBody []byte, conn wsConn, client string) ([]byte, error)
+	Handle(reqBody []byte, client string) ([]byte, error)
 }
 
 // JSONRPCStore defines all the methods required
@@ -62,15 +66,28 @@ type JSONRPCStore interface {
 type Config struct {
 	Store                    JSONRPCStore
 	Addr                     *net.TCPAddr
+	IPCPath                  string
 	ChainID                  uint64
 	ChainName                string
 	AccessControlAllowOrigin []string
 	PriceLimit               uint64
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+	IndexedBlockRangeLimit   uint64
 
 	ConcurrentRequestsDebug uint64
 	WebSocketReadLimit      uint64
+
+	RateLimit *RateLimitConfig
+
+	// DevStore enables the dev-only evm and anvil endpoints, nil if the node is not in the dev mode
+	DevStore DevStore
+
+	// AdminStore enables the admin endpoint, nil unless it is enabled by the operator
+	AdminStore AdminStore
+
+	// HTTPHandlers are the additional handlers served by the http server on their paths
+	HTTPHandlers map[string]http.Handler
 }
 
 // NewJSONRPC returns the JSONRPC http server
@@ -84,7 +101,11 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 			priceLimit:              config.PriceLimit,
 			jsonRPCBatchLengthLimit: config.BatchLengthLimit,
 			blockRangeLimit:         config.BlockRangeLimit,
+			indexedBlockRangeLimit:  config.IndexedBlockRangeLimit,
 			concurrentRequestsDebug: config.ConcurrentRequestsDebug,
+			rateLimit:               config.RateLimit,
+			devStore:                config.DevStore,
+			adminStore:              config.AdminStore,
 		},
 	)
 
@@ -96,6 +117,13 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 		logger:     logger.Named("jsonrpc"),
 		config:     config,
 		dispatcher: d,
+		apiKeys:    make(map[string]struct{}),
+	}
+
+	if config.RateLimit != nil {
+		for _, apiKey := range config.RateLimit.APIKeys {
+			srv.apiKeys[apiKey] = struct{}{}
+		}
 	}
 
 	// start http server
@@ -103,9 +131,25 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 		return nil, err
 	}
 
+	// start ipc server
+	if config.IPCPath != "" {
+		if err := srv.setupIPC(); err != nil {
+			return nil, err
+		}
+	}
+
 	return srv, nil
 }
 
+// Close stops accepting new IPC connections and removes the IPC socket
+func (j *JSONRPC) Close() error {
+	if j.ipcListener == nil {
+		return nil
+	}
+
+	return j.ipcListener.Close()
+}
+
 func (j *JSONRPC) setupHTTP() error {
 	j.logger.Info("http server started", "addr", j.config.Addr.String())
 
@@ -125,6 +169,10 @@ func (j *JSONRPC) setupHTTP() error {
 
 	mux.HandleFunc("/ws", j.handleWs)
 
+	for path, handler := range j.config.HTTPHandlers {
+		mux.Handle(path, handler)
+	}
+
 	srv := http.Server{
 		Handler:           mux,
 		ReadHeaderTimeout: 60 * time.Second,
@@ -239,6 +287,7 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 	}(ws)
 
 	wrapConn := &wsWrapper{ws: ws, logger: j.logger}
+	client := j.clientID(req)
 
 	j.logger.Info("Websocket connection established")
 	// Run the listen loop
@@ -265,7 +314,7 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 
 		if isSupportedWSType(msgType) {
 			go func() {
-				resp, handleErr := j.dispatcher.HandleWs(message, wrapConn)
+				resp, handleErr := j.dispatcher.HandleWs(message, wrapConn, client)
 				if handleErr != nil {
 					j.logger.Error(fmt.Sprintf("Unable to handle WS request, %s", handleErr.Error()))
 
@@ -312,7 +361,7 @@ func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request)
 	// log request
 	j.logger.Debug("handle", "request", string(data))
 
-	resp, err := j.dispatcher.Handle(data)
+	resp, err := j.dispatcher.Handle(data, j.clientID(req))
 	if err != nil {
 		_, _ = w.Write([]byte(err.Error()))
 	} else {
@@ -322,6 +371,24 @@ func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request)
 	j.logger.Debug("handle", "response", string(resp))
 }
 
+// clientID identifies the client of the request for rate limiting purposes,
+// either by its API key (if it is one of the trusted keys) or by its IP address
+func (j *JSONRPC) clientID(req *http.Request) string {
+	if rateLimit := j.config.RateLimit; rateLimit != nil && rateLimit.APIKeyHeader != "" {
+		apiKey := req.Header.Get(rateLimit.APIKeyHeader)
+		if _, ok := j.apiKeys[apiKey]; ok && apiKey != "" {
+			return "key:" + apiKey
+		}
+	}
+
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+
+	return host
+}
+
 type GetResponse struct {
 	Name    string `json:"name"`
 	ChainID uint64 `json:"chain_id"`
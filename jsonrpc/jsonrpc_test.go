@@ -113,3 +113,50 @@ func newTestJSONRPC(t *testing.T) (*JSONRPC, error) {
 
 	return NewJSONRPC(hclog.NewNullLogger(), config)
 }
+
+func TestJSONRPC_clientID(t *testing.T) {
+	t.Parallel()
+
+	j := &JSONRPC{
+		config: &Config{
+			RateLimit: &RateLimitConfig{APIKeyHeader: "X-API-Key"},
+		},
+		apiKeys: map[string]struct{}{"trusted": {}},
+	}
+
+	cases := []struct {
+		name     string
+		apiKey   string
+		expected string
+	}{
+		{
+			name:     "trusted API key",
+			apiKey:   "trusted",
+			expected: "key:trusted",
+		},
+		{
+			name:     "unknown API key",
+			apiKey:   "unknown",
+			expected: "192.0.2.1",
+		},
+		{
+			name:     "missing API key",
+			expected: "192.0.2.1",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest("POST", "/", nil)
+			if c.apiKey != "" {
+				req.Header.Set("X-API-Key", c.apiKey)
+			}
+
+			require.Equal(t, c.expected, j.clientID(req))
+		})
+	}
+}
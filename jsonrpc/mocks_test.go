@@ -54,6 +54,8 @@ type mockStore struct {
 
 	// headers is the list of historical headers
 	historicalHeaders []*types.Header
+
+	peersInfo []*PeerInfo
 }
 
 func newMockStore() *mockStore {
@@ -157,6 +159,14 @@ func (m *mockStore) TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *pr
 	return m.txPoolChannel, txPoolUnsubscribe, nil
 }
 
+func (m *mockStore) LogIndexHead() (uint64, bool) {
+	return 0, false
+}
+
+func (m *mockStore) FilterLogBlocks(from, to uint64, addresses []types.Address, topics [][]types.Hash) []uint64 {
+	return nil
+}
+
 func (m *mockStore) GetHeaderByNumber(num uint64) (*types.Header, bool) {
 	header := m.headerLoop(func(header *types.Header) bool {
 		return header.Number == num
@@ -211,6 +221,10 @@ func (m *mockStore) GetPeers() int {
 	return 20
 }
 
+func (m *mockStore) GetPeersInfo() []*PeerInfo {
+	return m.peersInfo
+}
+
 func (m *mockStore) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	hash := types.BytesToHash([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
 	ssp := types.Proof{
@@ -1,10 +1,47 @@
 package jsonrpc
 
-import "strconv"
+import (
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// PeerInfo is the connection and traffic information of a connected peer
+type PeerInfo struct {
+	ID          string
+	Addrs       []string
+	Protocols   []string
+	Inbound     bool
+	ConnectedAt time.Time
+	Latency     time.Duration
+	// Distance is the Kademlia (XOR) distance between the node and the peer
+	Distance *big.Int
+	// Score is the gossip score of the peer
+	Score float64
+	// HeadBlock is the latest block number reported by the peer, nil if unknown
+	HeadBlock *uint64
+
+	Bandwidth      []PeerProtocolBandwidth
+	GossipMessages []PeerTopicMessages
+}
+
+// PeerProtocolBandwidth is the number of bytes exchanged with the peer over a single protocol
+type PeerProtocolBandwidth struct {
+	Protocol string
+	BytesIn  uint64
+	BytesOut uint64
+}
+
+// PeerTopicMessages is the number of gossip messages received from the peer on a single topic
+type PeerTopicMessages struct {
+	Topic string
+	Count uint64
+}
 
 // networkStore provides methods needed for Net endpoint
 type networkStore interface {
 	GetPeers() int
+	GetPeersInfo() []*PeerInfo
 }
 
 // Net is the net jsonrpc endpoint
@@ -29,3 +66,86 @@ func (n *Net) PeerCount() (interface{}, error) {
 
 	return argUint64(peers), nil
 }
+
+type peerInfoResult struct {
+	ID             string                    `json:"id"`
+	Addrs          []string                  `json:"addrs"`
+	Protocols      []string                  `json:"protocols"`
+	Direction      string                    `json:"direction"`
+	ConnectedAt    argUint64                 `json:"connectedAt"`
+	Latency        argUint64                 `json:"latency"`
+	Distance       *argBig                   `json:"distance"`
+	Score          float64                   `json:"score"`
+	HeadBlock      *argUint64                `json:"headBlock"`
+	Bandwidth      []protocolBandwidthResult `json:"bandwidth"`
+	GossipMessages []topicMessagesResult     `json:"gossipMessages"`
+}
+
+type protocolBandwidthResult struct {
+	Protocol string    `json:"protocol"`
+	BytesIn  argUint64 `json:"bytesIn"`
+	BytesOut argUint64 `json:"bytesOut"`
+}
+
+type topicMessagesResult struct {
+	Topic string    `json:"topic"`
+	Count argUint64 `json:"count"`
+}
+
+// PeerInfo returns the diagnostics of the connected peers, except the private ones:
+// the connection direction and its opening unix time, the average ping latency in microseconds,
+// the Kademlia distance, the gossip score, the latest block reported by the peer,
+// the bytes exchanged per protocol and the gossip messages received per topic
+func (n *Net) PeerInfo() (interface{}, error) {
+	peers := n.store.GetPeersInfo()
+
+	result := make([]*peerInfoResult, len(peers))
+	for i, p := range peers {
+		result[i] = toPeerInfoResult(p)
+	}
+
+	return result, nil
+}
+
+func toPeerInfoResult(p *PeerInfo) *peerInfoResult {
+	res := &peerInfoResult{
+		ID:             p.ID,
+		Addrs:          p.Addrs,
+		Protocols:      p.Protocols,
+		Direction:      "outbound",
+		ConnectedAt:    argUint64(p.ConnectedAt.Unix()),
+		Latency:        argUint64(p.Latency.Microseconds()),
+		Score:          p.Score,
+		Bandwidth:      make([]protocolBandwidthResult, len(p.Bandwidth)),
+		GossipMessages: make([]topicMessagesResult, len(p.GossipMessages)),
+	}
+
+	if p.Inbound {
+		res.Direction = "inbound"
+	}
+
+	if p.Distance != nil {
+		res.Distance = argBigPtr(p.Distance)
+	}
+
+	if p.HeadBlock != nil {
+		res.HeadBlock = argUintPtr(*p.HeadBlock)
+	}
+
+	for i, bandwidth := range p.Bandwidth {
+		res.Bandwidth[i] = protocolBandwidthResult{
+			Protocol: bandwidth.Protocol,
+			BytesIn:  argUint64(bandwidth.BytesIn),
+			BytesOut: argUint64(bandwidth.BytesOut),
+		}
+	}
+
+	for i, messages := range p.GossipMessages {
+		res.GossipMessages[i] = topicMessagesResult{
+			Topic: messages.Topic,
+			Count: argUint64(messages.Count),
+		}
+	}
+
+	return res
+}
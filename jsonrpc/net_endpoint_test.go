@@ -1,10 +1,13 @@
 package jsonrpc
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNetEndpoint_PeerCount(t *testing.T) {
@@ -18,7 +21,7 @@ func TestNetEndpoint_PeerCount(t *testing.T) {
 	resp, err := dispatcher.Handle([]byte(`{
 		"method": "net_peerCount",
 		"params": [""]
-	}`))
+	}`), "")
 	assert.NoError(t, err)
 
 	var res string
@@ -26,3 +29,72 @@ func TestNetEndpoint_PeerCount(t *testing.T) {
 	assert.NoError(t, expectJSONResult(resp, &res))
 	assert.Equal(t, "0x14", res)
 }
+
+func TestNetEndpoint_PeerInfo(t *testing.T) {
+	headBlock := uint64(100)
+
+	store := newMockStore()
+	store.peersInfo = []*PeerInfo{
+		{
+			ID:          "peer1",
+			Addrs:       []string{"/ip4/127.0.0.1/tcp/1478"},
+			Protocols:   []string{"/id/0.1"},
+			Inbound:     true,
+			ConnectedAt: time.Unix(1000, 0),
+			Latency:     1500 * time.Microsecond,
+			Distance:    big.NewInt(255),
+			Score:       2.5,
+			HeadBlock:   &headBlock,
+			Bandwidth: []PeerProtocolBandwidth{
+				{Protocol: "/id/0.1", BytesIn: 16, BytesOut: 32},
+			},
+			GossipMessages: []PeerTopicMessages{
+				{Topic: "txs", Count: 10},
+			},
+		},
+		{
+			ID: "peer2",
+		},
+	}
+
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		store,
+		&dispatcherParams{
+			chainID: 1,
+		})
+
+	resp, err := dispatcher.Handle([]byte(`{
+		"method": "net_peerInfo",
+		"params": []
+	}`), "")
+	require.NoError(t, err)
+
+	var res []map[string]interface{}
+
+	require.NoError(t, expectJSONResult(resp, &res))
+	require.Len(t, res, 2)
+
+	assert.Equal(t, map[string]interface{}{
+		"id":          "peer1",
+		"addrs":       []interface{}{"/ip4/127.0.0.1/tcp/1478"},
+		"protocols":   []interface{}{"/id/0.1"},
+		"direction":   "inbound",
+		"connectedAt": "0x3e8",
+		"latency":     "0x5dc",
+		"distance":    "0xff",
+		"score":       2.5,
+		"headBlock":   "0x64",
+		"bandwidth": []interface{}{
+			map[string]interface{}{"protocol": "/id/0.1", "bytesIn": "0x10", "bytesOut": "0x20"},
+		},
+		"gossipMessages": []interface{}{
+			map[string]interface{}{"topic": "txs", "count": "0xa"},
+		},
+	}, res[0])
+
+	assert.Equal(t, "peer2", res[1]["id"])
+	assert.Equal(t, "outbound", res[1]["direction"])
+	assert.Nil(t, res[1]["headBlock"])
+	assert.Nil(t, res[1]["distance"])
+}
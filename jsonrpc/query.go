@@ -167,6 +167,22 @@ func (q *LogQuery) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// hasIndexCriteria returns whether the query filters the logs by any address or topic,
+// otherwise the log index can't skip any block
+func (q *LogQuery) hasIndexCriteria() bool {
+	if len(q.Addresses) > 0 {
+		return true
+	}
+
+	for _, sub := range q.Topics {
+		if len(sub) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Match returns whether the receipt includes topics for this filter
 func (q *LogQuery) Match(log *types.Log) bool {
 	// check addresses
@@ -0,0 +1,188 @@
+package jsonrpc
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRequestCost is the cost charged for every method without a specific cost
+	defaultRequestCost uint64 = 1
+
+	// defaultTraceRequestCost is the cost charged for debug_trace* methods
+	defaultTraceRequestCost uint64 = 20
+
+	// defaultLogsBlocksPerCost is the number of blocks in an eth_getLogs range
+	// that are charged as a single additional cost unit
+	defaultLogsBlocksPerCost uint64 = 100
+
+	// bucketPruneInterval is the interval after which idle client buckets are removed
+	bucketPruneInterval = time.Minute
+)
+
+// RateLimitConfig holds the configuration of the per-client JSON-RPC rate limiter
+type RateLimitConfig struct {
+	// RequestsPerSecond is the number of cost units refilled into each client bucket per second.
+	// Value of 0 disables rate limiting
+	RequestsPerSecond uint64
+
+	// Burst is the maximum number of cost units a client bucket can hold
+	Burst uint64
+
+	// APIKeyHeader is the HTTP header which identifies a client by its API key.
+	// If the header is missing (or not configured), the client is identified by its IP address
+	APIKeyHeader string
+
+	// APIKeys are the API keys trusted to identify a client.
+	// The clients presenting any other key are identified by their IP address
+	APIKeys []string
+
+	// MethodCosts overrides the cost of a method. A key ending with '*' matches all methods with that prefix
+	MethodCosts map[string]uint64
+
+	// LogsBlocksPerCost is the number of blocks queried by eth_getLogs charged as one additional cost unit
+	LogsBlocksPerCost uint64
+}
+
+// DefaultMethodCosts returns the default costs of heavy JSON-RPC methods
+func DefaultMethodCosts() map[string]uint64 {
+	return map[string]uint64{
+		"debug_trace*": defaultTraceRequestCost,
+	}
+}
+
+// tokenBucket holds the remaining cost units of a single client
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// RateLimiter limits the number of requests per client using token buckets
+// where every request consumes the cost of the invoked method
+type RateLimiter struct {
+	sync.Mutex
+
+	config    *RateLimitConfig
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+
+	// now returns the current time, replaceable in tests
+	now func() time.Time
+}
+
+// NewRateLimiter creates a new rate limiter. Returns nil if rate limiting is disabled
+func NewRateLimiter(config *RateLimitConfig) *RateLimiter {
+	if config == nil || config.RequestsPerSecond == 0 {
+		return nil
+	}
+
+	if config.Burst < config.RequestsPerSecond {
+		config.Burst = config.RequestsPerSecond
+	}
+
+	if config.LogsBlocksPerCost == 0 {
+		config.LogsBlocksPerCost = defaultLogsBlocksPerCost
+	}
+
+	if config.MethodCosts == nil {
+		config.MethodCosts = DefaultMethodCosts()
+	}
+
+	return &RateLimiter{
+		config:    config,
+		buckets:   make(map[string]*tokenBucket),
+		lastPrune: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// MethodCost returns the base cost of the given method
+func (r *RateLimiter) MethodCost(method string) uint64 {
+	if cost, ok := r.config.MethodCosts[method]; ok {
+		return cost
+	}
+
+	// the longest matching prefix wins
+	var (
+		cost      = defaultRequestCost
+		prefixLen = -1
+	)
+
+	for key, value := range r.config.MethodCosts {
+		prefix, isWildcard := strings.CutSuffix(key, "*")
+		if !isWildcard || !strings.HasPrefix(method, prefix) || len(prefix) <= prefixLen {
+			continue
+		}
+
+		cost, prefixLen = value, len(prefix)
+	}
+
+	return cost
+}
+
+// LogsRangeCost returns the additional cost of querying logs over the given number of blocks
+func (r *RateLimiter) LogsRangeCost(blockRange uint64) uint64 {
+	return blockRange / r.config.LogsBlocksPerCost
+}
+
+// Allow consumes the given cost from the client bucket.
+// Returns false if the client has not enough cost units left
+func (r *RateLimiter) Allow(client string, cost uint64) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	now := r.now()
+
+	r.pruneBuckets(now)
+
+	bucket, ok := r.buckets[client]
+	if !ok {
+		bucket = &tokenBucket{
+			tokens:     float64(r.config.Burst),
+			lastRefill: now,
+		}
+		r.buckets[client] = bucket
+	} else {
+		r.refill(bucket, now)
+	}
+
+	if bucket.tokens < float64(cost) {
+		return false
+	}
+
+	bucket.tokens -= float64(cost)
+
+	return true
+}
+
+// refill adds the cost units accumulated since the last refill to the bucket
+func (r *RateLimiter) refill(bucket *tokenBucket, now time.Time) {
+	elapsed := now.Sub(bucket.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	bucket.tokens += elapsed * float64(r.config.RequestsPerSecond)
+	if bucket.tokens > float64(r.config.Burst) {
+		bucket.tokens = float64(r.config.Burst)
+	}
+
+	bucket.lastRefill = now
+}
+
+// pruneBuckets removes buckets of clients which were idle long enough to be full again
+func (r *RateLimiter) pruneBuckets(now time.Time) {
+	if now.Sub(r.lastPrune) < bucketPruneInterval {
+		return
+	}
+
+	for client, bucket := range r.buckets {
+		refilled := bucket.tokens + now.Sub(bucket.lastRefill).Seconds()*float64(r.config.RequestsPerSecond)
+		if refilled >= float64(r.config.Burst) {
+			delete(r.buckets, client)
+		}
+	}
+
+	r.lastPrune = now
+}
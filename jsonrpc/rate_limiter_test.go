@@ -0,0 +1,119 @@
+package jsonrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, NewRateLimiter(nil))
+	assert.Nil(t, NewRateLimiter(&RateLimitConfig{}))
+}
+
+func TestRateLimiter_MethodCost(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(&RateLimitConfig{
+		RequestsPerSecond: 10,
+		MethodCosts: map[string]uint64{
+			"debug_trace*":              20,
+			"debug_traceBlockByNumber*": 30,
+			"eth_call":                  5,
+		},
+	})
+
+	assert.Equal(t, uint64(5), limiter.MethodCost("eth_call"))
+	assert.Equal(t, uint64(20), limiter.MethodCost("debug_traceTransaction"))
+	assert.Equal(t, uint64(30), limiter.MethodCost("debug_traceBlockByNumber"))
+	assert.Equal(t, defaultRequestCost, limiter.MethodCost("eth_blockNumber"))
+	assert.Equal(t, uint64(10), limiter.LogsRangeCost(1000))
+}
+
+func TestRateLimiter_Allow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := NewRateLimiter(&RateLimitConfig{
+		RequestsPerSecond: 2,
+		Burst:             4,
+	})
+	limiter.now = func() time.Time { return now }
+
+	// the bucket starts full
+	require.True(t, limiter.Allow("client1", 3))
+	require.True(t, limiter.Allow("client1", 1))
+	require.False(t, limiter.Allow("client1", 1))
+
+	// other clients have their own buckets
+	require.True(t, limiter.Allow("client2", 4))
+
+	// after a second, two cost units are refilled
+	now = now.Add(time.Second)
+
+	require.True(t, limiter.Allow("client1", 2))
+	require.False(t, limiter.Allow("client1", 1))
+
+	// refill never exceeds the burst
+	now = now.Add(time.Hour)
+
+	require.False(t, limiter.Allow("client1", 5))
+	require.True(t, limiter.Allow("client1", 4))
+
+	// idle buckets got pruned
+	assert.Len(t, limiter.buckets, 1)
+}
+
+func TestDispatcher_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+	dispatcher := newTestDispatcher(t,
+		hclog.NewNullLogger(),
+		store,
+		&dispatcherParams{
+			chainID:                 0,
+			priceLimit:              0,
+			jsonRPCBatchLengthLimit: 20,
+			blockRangeLimit:         1000,
+			rateLimit: &RateLimitConfig{
+				RequestsPerSecond: 1,
+				Burst:             2,
+			},
+		},
+	)
+
+	req := []byte(`{"method": "web3_clientVersion", "params": []}`)
+
+	for i := 0; i < 2; i++ {
+		resp, err := dispatcher.Handle(req, "127.0.0.1")
+		require.NoError(t, err)
+
+		var res string
+
+		require.NoError(t, expectJSONResult(resp, &res))
+	}
+
+	resp, err := dispatcher.Handle(req, "127.0.0.1")
+	require.NoError(t, err)
+
+	var res string
+
+	err = expectJSONResult(resp, &res)
+	require.Error(t, err)
+
+	var rpcErr *ObjectError
+
+	require.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, -32005, rpcErr.Code)
+
+	// requests without the client identifier are not limited
+	resp, err = dispatcher.Handle(req, "")
+	require.NoError(t, err)
+	require.NoError(t, expectJSONResult(resp, &res))
+}
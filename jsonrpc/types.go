@@ -191,6 +191,129 @@ func toBlock(b *types.Block, fullTx bool) *block {
 	return res
 }
 
+// toReceipt converts the stored receipt of the given transaction to its JSON-RPC representation.
+// logIndex is the index of the first receipt log within the block
+func toReceipt(
+	raw *types.Receipt,
+	txn *types.Transaction,
+	txIndex uint64,
+	header *types.Header,
+	logIndex int,
+) *receipt {
+	logs := make([]*Log, len(raw.Logs))
+	for i, elem := range raw.Logs {
+		logs[i] = &Log{
+			Address:     elem.Address,
+			Topics:      elem.Topics,
+			Data:        argBytes(elem.Data),
+			BlockHash:   header.Hash,
+			BlockNumber: argUint64(header.Number),
+			TxHash:      txn.Hash,
+			TxIndex:     argUint64(txIndex),
+			LogIndex:    argUint64(logIndex + i),
+			Removed:     false,
+		}
+	}
+
+	return &receipt{
+		Root:              raw.Root,
+		CumulativeGasUsed: argUint64(raw.CumulativeGasUsed),
+		LogsBloom:         raw.LogsBloom,
+		Status:            argUint64(*raw.Status),
+		TxHash:            txn.Hash,
+		TxIndex:           argUint64(txIndex),
+		BlockHash:         header.Hash,
+		BlockNumber:       argUint64(header.Number),
+		GasUsed:           argUint64(raw.GasUsed),
+		ContractAddress:   raw.ContractAddress,
+		FromAddr:          txn.From,
+		ToAddr:            txn.To,
+		Logs:              logs,
+	}
+}
+
+type simulatedCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type simulatedCall struct {
+	ReturnData argBytes            `json:"returnData"`
+	Logs       []*Log              `json:"logs"`
+	GasUsed    argUint64           `json:"gasUsed"`
+	Status     argUint64           `json:"status"`
+	Error      *simulatedCallError `json:"error,omitempty"`
+}
+
+type simulatedBlock struct {
+	Number       argUint64        `json:"number"`
+	Timestamp    argUint64        `json:"timestamp"`
+	GasLimit     argUint64        `json:"gasLimit"`
+	GasUsed      argUint64        `json:"gasUsed"`
+	BaseFee      argUint64        `json:"baseFeePerGas"`
+	FeeRecipient *types.Address   `json:"feeRecipient,omitempty"`
+	Calls        []*simulatedCall `json:"calls"`
+}
+
+// toSimulatedBlocks converts the outcome of the call simulation to its JSON-RPC representation
+func toSimulatedBlocks(blocks []*SimulatedBlock, results [][]*SimulatedCall) []*simulatedBlock {
+	res := make([]*simulatedBlock, len(blocks))
+
+	for i, b := range blocks {
+		block := &simulatedBlock{
+			Number:       argUint64(*b.BlockOverride.Number),
+			Timestamp:    argUint64(*b.BlockOverride.Timestamp),
+			GasLimit:     argUint64(*b.BlockOverride.GasLimit),
+			BaseFee:      argUint64(b.BlockOverride.BaseFee.Uint64()),
+			FeeRecipient: b.BlockOverride.Coinbase,
+			Calls:        make([]*simulatedCall, len(results[i])),
+		}
+
+		logIndex := 0
+
+		for j, r := range results[i] {
+			call := &simulatedCall{
+				ReturnData: argBytes(r.Result.ReturnValue),
+				Logs:       make([]*Log, len(r.Logs)),
+				GasUsed:    argUint64(r.Result.GasUsed),
+				Status:     argUint64(types.ReceiptSuccess),
+			}
+
+			if r.Result.Failed() {
+				call.Status = argUint64(types.ReceiptFailed)
+				call.Error = &simulatedCallError{
+					Code:    -32015,
+					Message: r.Result.Err.Error(),
+				}
+
+				if r.Result.Reverted() {
+					call.Error.Code = 3
+					call.Error.Message = constructErrorFromRevert(r.Result).Error()
+				}
+			}
+
+			for k, log := range r.Logs {
+				call.Logs[k] = &Log{
+					Address:     log.Address,
+					Topics:      log.Topics,
+					Data:        argBytes(log.Data),
+					BlockNumber: block.Number,
+					TxIndex:     argUint64(j),
+					LogIndex:    argUint64(logIndex),
+				}
+				logIndex++
+			}
+
+			block.GasUsed += call.GasUsed
+			block.Calls[j] = call
+		}
+
+		res[i] = block
+	}
+
+	return res
+}
+
 type receipt struct {
 	Root              types.Hash     `json:"root"`
 	CumulativeGasUsed argUint64      `json:"cumulativeGasUsed"`
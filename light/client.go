@@ -0,0 +1,266 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/light/proto"
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// defaultRequestTimeout is the time a request has to be answered by any of the peers,
+	// including the time waiting for the peers serving the light protocol to connect
+	defaultRequestTimeout = 30 * time.Second
+
+	// peerWaitInterval is the interval the peers are checked at, while all of them failed the request
+	peerWaitInterval = time.Second
+)
+
+var (
+	ErrNoLightPeers     = errors.New("no peers serving the light protocol")
+	ErrInvalidBody      = errors.New("body does not match the header")
+	ErrInvalidReceipts  = errors.New("receipts do not match the header")
+	ErrInvalidNodeData  = errors.New("node data does not match the hash")
+	ErrNodeDataNotFound = errors.New("node data not found")
+)
+
+// Client fetches the block bodies, receipts and state from the full peers on demand,
+// and verifies them against the locally verified headers, or the hashes they are requested by
+type Client struct {
+	logger  hclog.Logger
+	network Network
+	timeout time.Duration
+}
+
+func NewClient(logger hclog.Logger, network Network) *Client {
+	return &Client{
+		logger:  logger.Named(lightName),
+		network: network,
+		timeout: defaultRequestTimeout,
+	}
+}
+
+// GetBody fetches the body of the block with the given header.
+// The senders of the transactions are not verified, so they are left for the blockchain to recover
+func (c *Client) GetBody(header *types.Header) (*types.Body, error) {
+	if header.TxRoot == types.EmptyRootHash && header.Sha3Uncles == types.EmptyUncleHash {
+		return &types.Body{}, nil
+	}
+
+	var body *types.Body
+
+	err := c.request(func(ctx context.Context, clt proto.LightPeerClient) error {
+		resp, err := clt.GetBody(ctx, &proto.GetByHashRequest{Hash: header.Hash.Bytes()})
+		if err != nil {
+			return err
+		}
+
+		result := &types.Body{}
+		if err := result.UnmarshalRLP(resp.Body); err != nil {
+			return err
+		}
+
+		for _, tx := range result.Transactions {
+			tx.ComputeHash()
+
+			if tx.Type != types.StateTx {
+				tx.From = types.ZeroAddress
+			}
+		}
+
+		if buildroot.CalculateTransactionsRoot(result.Transactions) != header.TxRoot ||
+			buildroot.CalculateUncleRoot(result.Uncles) != header.Sha3Uncles {
+			return fmt.Errorf("%w: block %d", ErrInvalidBody, header.Number)
+		}
+
+		body = result
+
+		return nil
+	})
+
+	return body, err
+}
+
+// GetReceipts fetches the receipts of the block with the given header.
+// Only the consensus fields of the receipts are verified
+func (c *Client) GetReceipts(header *types.Header) ([]*types.Receipt, error) {
+	if header.ReceiptsRoot == types.EmptyRootHash {
+		return []*types.Receipt{}, nil
+	}
+
+	var receipts []*types.Receipt
+
+	err := c.request(func(ctx context.Context, clt proto.LightPeerClient) error {
+		resp, err := clt.GetReceipts(ctx, &proto.GetByHashRequest{Hash: header.Hash.Bytes()})
+		if err != nil {
+			return err
+		}
+
+		result := types.Receipts{}
+		if err := result.UnmarshalStoreRLP(resp.Receipts); err != nil {
+			return err
+		}
+
+		if buildroot.CalculateReceiptsRoot(result) != header.ReceiptsRoot {
+			return fmt.Errorf("%w: block %d", ErrInvalidReceipts, header.Number)
+		}
+
+		receipts = result
+
+		return nil
+	})
+
+	return receipts, err
+}
+
+// GetNodeData fetches the state trie node or the contract code by its hash
+func (c *Client) GetNodeData(hash types.Hash) ([]byte, error) {
+	data, err := c.GetNodesData([]types.Hash{hash})
+	if err != nil {
+		return nil, err
+	}
+
+	return data[0], nil
+}
+
+// GetNodesData fetches the state trie nodes or the contract codes by their hashes from a single peer,
+// which returns some of them at least. The ones it doesn't have are returned empty
+func (c *Client) GetNodesData(hashes []types.Hash) ([][]byte, error) {
+	req := &proto.GetNodeDataRequest{Hashes: make([][]byte, len(hashes))}
+	for i, hash := range hashes {
+		req.Hashes[i] = hash.Bytes()
+	}
+
+	data := make([][]byte, len(hashes))
+
+	err := c.request(func(ctx context.Context, clt proto.LightPeerClient) error {
+		resp, err := clt.GetNodeData(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		found := false
+
+		for i, hash := range hashes {
+			if i >= len(resp.Data) || len(resp.Data[i]) == 0 {
+				data[i] = nil
+
+				continue
+			}
+
+			if types.BytesToHash(crypto.Keccak256(resp.Data[i])) != hash {
+				return fmt.Errorf("%w: %s", ErrInvalidNodeData, hash)
+			}
+
+			data[i] = resp.Data[i]
+			found = true
+		}
+
+		if !found {
+			return fmt.Errorf("%w: %s", ErrNodeDataNotFound, hashes[0])
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// SyncState downloads the whole state with the given root into the storage,
+// resuming the download interrupted before
+func (c *Client) SyncState(root types.Hash, storage itrie.Storage) error {
+	return itrie.SyncState(root, storage, c.GetNodesData, maxNodeDataAmount)
+}
+
+// request sends the request to the peers serving the light protocol one after another,
+// until one of them answers it correctly. If all of them fail, it waits for the new ones
+// to connect until the request times out, and returns the last error
+func (c *Client) request(fn func(context.Context, proto.LightPeerClient) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var (
+		tried   = make(map[peer.ID]bool)
+		lastErr = ErrNoLightPeers
+	)
+
+	for {
+		for _, peerID := range c.lightPeers() {
+			if tried[peerID] {
+				continue
+			}
+
+			tried[peerID] = true
+
+			err := c.requestPeer(ctx, peerID, fn)
+			if err == nil {
+				return nil
+			}
+
+			c.logger.Debug("light request failed", "peer", peerID, "err", err)
+
+			lastErr = err
+		}
+
+		select {
+		case <-ctx.Done():
+			return lastErr
+		case <-time.After(peerWaitInterval):
+		}
+	}
+}
+
+func (c *Client) requestPeer(
+	ctx context.Context,
+	peerID peer.ID,
+	fn func(context.Context, proto.LightPeerClient) error,
+) error {
+	stream, err := c.network.NewStream(LightProto, peerID)
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.WrapClient(stream)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return fn(ctx, proto.NewLightPeerClient(conn))
+}
+
+// lightPeers returns the connected peers serving the light protocol
+func (c *Client) lightPeers() []peer.ID {
+	peers := c.network.Peers()
+	result := make([]peer.ID, 0, len(peers))
+
+	for _, p := range peers {
+		protocols, err := c.network.GetProtocols(p.Info.ID)
+		if err != nil {
+			continue
+		}
+
+		for _, protocol := range protocols {
+			if protocol == LightProto {
+				result = append(result, p.Info.ID)
+
+				break
+			}
+		}
+	}
+
+	return result
+}
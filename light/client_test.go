@@ -0,0 +1,242 @@
+package light
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNetwork(t *testing.T) *network.Server {
+	t.Helper()
+
+	srv, err := network.CreateServer(&network.CreateServerParams{
+		ConfigCallback: func(c *network.Config) {
+			c.NoDiscover = true
+		},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = srv.Close()
+	})
+
+	return srv
+}
+
+// newTestClient creates the full peer serving the given data over the light protocol,
+// and the client of the light node connected to it
+func newTestClient(t *testing.T, blockchain Blockchain, storage NodeStorage) *Client {
+	t.Helper()
+
+	peerSrv := newTestNetwork(t)
+	NewService(peerSrv, blockchain, storage).Start()
+
+	clientSrv := newTestNetwork(t)
+
+	require.NoError(t, network.JoinAndWait(
+		clientSrv,
+		peerSrv,
+		network.DefaultBufferTimeout,
+		network.DefaultJoinTimeout,
+	))
+
+	client := NewClient(hclog.NewNullLogger(), clientSrv)
+	client.timeout = 2 * time.Second
+
+	return client
+}
+
+func TestClient_GetBody(t *testing.T) {
+	t.Parallel()
+
+	var (
+		header, body, receipts = newTestBlock(1, 3)
+		otherHeader, other, _  = newTestBlock(2, 1)
+		emptyHeader, empty, _  = newTestBlock(3, 0)
+	)
+
+	client := newTestClient(t, newTestBlockchain(
+		[]*types.Header{header, otherHeader},
+		[]*types.Body{body, other},
+		[][]*types.Receipt{receipts, nil},
+	), nil)
+
+	result, err := client.GetBody(header)
+	require.NoError(t, err)
+	require.Len(t, result.Transactions, len(body.Transactions))
+
+	for i, tx := range result.Transactions {
+		assert.Equal(t, body.Transactions[i].Hash, tx.Hash)
+		// the senders are left to be recovered
+		assert.Equal(t, types.ZeroAddress, tx.From)
+	}
+
+	// the empty bodies are not requested
+	result, err = client.GetBody(emptyHeader)
+	require.NoError(t, err)
+	assert.Equal(t, empty.Transactions, result.Transactions)
+
+	// the body of another block is rejected
+	forged := otherHeader.Copy()
+	forged.TxRoot = header.TxRoot
+
+	_, err = client.GetBody(forged)
+	assert.ErrorIs(t, err, ErrInvalidBody)
+}
+
+func TestClient_GetReceipts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		header, body, receipts = newTestBlock(1, 3)
+		otherHeader, other, _  = newTestBlock(2, 1)
+	)
+
+	client := newTestClient(t, newTestBlockchain(
+		[]*types.Header{header, otherHeader},
+		[]*types.Body{body, other},
+		[][]*types.Receipt{receipts, receipts[:1]},
+	), nil)
+
+	result, err := client.GetReceipts(header)
+	require.NoError(t, err)
+	require.Len(t, result, len(receipts))
+
+	for i, receipt := range result {
+		assert.Equal(t, receipts[i].CumulativeGasUsed, receipt.CumulativeGasUsed)
+		assert.Equal(t, receipts[i].TxHash, receipt.TxHash)
+	}
+
+	// the receipts of another block are rejected
+	forged := otherHeader.Copy()
+	forged.ReceiptsRoot = header.ReceiptsRoot
+
+	_, err = client.GetReceipts(forged)
+	assert.ErrorIs(t, err, ErrInvalidReceipts)
+}
+
+func TestClient_GetNodeData(t *testing.T) {
+	t.Parallel()
+
+	var (
+		storage  = itrie.NewMemoryStorage()
+		node     = []byte{0x1, 0x2, 0x3}
+		nodeHash = types.BytesToHash(crypto.Keccak256(node))
+	)
+
+	storage.Put(nodeHash.Bytes(), node)
+
+	// the node stored under another hash
+	forgedHash := types.StringToHash("0x1")
+	storage.Put(forgedHash.Bytes(), node)
+
+	client := newTestClient(t, nil, storage)
+
+	data, err := client.GetNodeData(nodeHash)
+	require.NoError(t, err)
+	assert.Equal(t, node, data)
+
+	_, err = client.GetNodeData(forgedHash)
+	assert.ErrorIs(t, err, ErrInvalidNodeData)
+
+	_, err = client.GetNodeData(types.StringToHash("0x2"))
+	assert.ErrorIs(t, err, ErrNodeDataNotFound)
+}
+
+func TestClient_GetNodesData(t *testing.T) {
+	t.Parallel()
+
+	var (
+		storage  = itrie.NewMemoryStorage()
+		node     = []byte{0x1, 0x2, 0x3}
+		nodeHash = types.BytesToHash(crypto.Keccak256(node))
+		missing  = types.StringToHash("0x2")
+	)
+
+	storage.Put(nodeHash.Bytes(), node)
+
+	client := newTestClient(t, nil, storage)
+
+	// the missing ones are returned empty
+	data, err := client.GetNodesData([]types.Hash{missing, nodeHash})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{nil, node}, data)
+
+	_, err = client.GetNodesData([]types.Hash{missing})
+	assert.ErrorIs(t, err, ErrNodeDataNotFound)
+}
+
+func TestClient_SyncState(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr = types.StringToAddress("0x1")
+		code = []byte{0x60, 0x0, 0x60, 0x0}
+
+		fullStorage = itrie.NewMemoryStorage()
+	)
+
+	_, root := itrie.NewState(fullStorage).NewSnapshot().Commit([]*state.Object{
+		{
+			Address:   addr,
+			Balance:   big.NewInt(100),
+			Nonce:     2,
+			Root:      types.EmptyRootHash,
+			CodeHash:  types.BytesToHash(crypto.Keccak256(code)),
+			DirtyCode: true,
+			Code:      code,
+			Storage: []*state.StorageObject{
+				{Key: types.StringToHash("0x1").Bytes(), Val: types.StringToHash("0x2").Bytes()},
+			},
+		},
+		{
+			Address:  types.StringToAddress("0x2"),
+			Balance:  big.NewInt(200),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.EmptyCodeHash,
+		},
+	})
+
+	client := newTestClient(t, nil, fullStorage)
+
+	synced := itrie.NewMemoryStorage()
+	require.NoError(t, client.SyncState(types.BytesToHash(root), synced))
+
+	// the state is read without the client
+	snap, err := itrie.NewState(synced).NewSnapshotAt(types.BytesToHash(root))
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(addr)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+
+	assert.Equal(t, big.NewInt(100), account.Balance)
+	value, err := snap.GetStorage(addr, account.Root, types.StringToHash("0x1"))
+	require.NoError(t, err)
+	assert.Equal(t, types.StringToHash("0x2"), value)
+
+	readCode, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+	require.True(t, ok)
+	assert.Equal(t, code, readCode)
+}
+
+func TestClient_NoLightPeers(t *testing.T) {
+	t.Parallel()
+
+	header, _, _ := newTestBlock(1, 1)
+
+	client := NewClient(hclog.NewNullLogger(), newTestNetwork(t))
+	client.timeout = 100 * time.Millisecond
+
+	_, err := client.GetBody(header)
+	assert.ErrorIs(t, err, ErrNoLightPeers)
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.7
+// source: light/proto/light.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LightPeerClient is the client API for LightPeer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LightPeerClient interface {
+	// Returns the body of the block
+	GetBody(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*Body, error)
+	// Returns the receipts of the block
+	GetReceipts(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*Receipts, error)
+	// Returns the state trie nodes or contract codes by their hashes
+	GetNodeData(ctx context.Context, in *GetNodeDataRequest, opts ...grpc.CallOption) (*NodeData, error)
+}
+
+type lightPeerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLightPeerClient(cc grpc.ClientConnInterface) LightPeerClient {
+	return &lightPeerClient{cc}
+}
+
+func (c *lightPeerClient) GetBody(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*Body, error) {
+	out := new(Body)
+	err := c.cc.Invoke(ctx, "/v1.LightPeer/GetBody", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightPeerClient) GetReceipts(ctx context.Context, in *GetByHashRequest, opts ...grpc.CallOption) (*Receipts, error) {
+	out := new(Receipts)
+	err := c.cc.Invoke(ctx, "/v1.LightPeer/GetReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightPeerClient) GetNodeData(ctx context.Context, in *GetNodeDataRequest, opts ...grpc.CallOption) (*NodeData, error) {
+	out := new(NodeData)
+	err := c.cc.Invoke(ctx, "/v1.LightPeer/GetNodeData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LightPeerServer is the server API for LightPeer service.
+// All implementations must embed UnimplementedLightPeerServer
+// for forward compatibility
+type LightPeerServer interface {
+	// Returns the body of the block
+	GetBody(context.Context, *GetByHashRequest) (*Body, error)
+	// Returns the receipts of the block
+	GetReceipts(context.Context, *GetByHashRequest) (*Receipts, error)
+	// Returns the state trie nodes or contract codes by their hashes
+	GetNodeData(context.Context, *GetNodeDataRequest) (*NodeData, error)
+	mustEmbedUnimplementedLightPeerServer()
+}
+
+// UnimplementedLightPeerServer must be embedded to have forward compatible implementations.
+type UnimplementedLightPeerServer struct {
+}
+
+func (UnimplementedLightPeerServer) GetBody(context.Context, *GetByHashRequest) (*Body, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBody not implemented")
+}
+func (UnimplementedLightPeerServer) GetReceipts(context.Context, *GetByHashRequest) (*Receipts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipts not implemented")
+}
+func (UnimplementedLightPeerServer) GetNodeData(context.Context, *GetNodeDataRequest) (*NodeData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeData not implemented")
+}
+func (UnimplementedLightPeerServer) mustEmbedUnimplementedLightPeerServer() {}
+
+// UnsafeLightPeerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LightPeerServer will
+// result in compilation errors.
+type UnsafeLightPeerServer interface {
+	mustEmbedUnimplementedLightPeerServer()
+}
+
+func RegisterLightPeerServer(s grpc.ServiceRegistrar, srv LightPeerServer) {
+	s.RegisterService(&LightPeer_ServiceDesc, srv)
+}
+
+func _LightPeer_GetBody_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightPeerServer).GetBody(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.LightPeer/GetBody",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightPeerServer).GetBody(ctx, req.(*GetByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LightPeer_GetReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightPeerServer).GetReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.LightPeer/GetReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightPeerServer).GetReceipts(ctx, req.(*GetByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LightPeer_GetNodeData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightPeerServer).GetNodeData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.LightPeer/GetNodeData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightPeerServer).GetNodeData(ctx, req.(*GetNodeDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LightPeer_ServiceDesc is the grpc.ServiceDesc for LightPeer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LightPeer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.LightPeer",
+	HandlerType: (*LightPeerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBody",
+			Handler:    _LightPeer_GetBody_Handler,
+		},
+		{
+			MethodName: "GetReceipts",
+			Handler:    _LightPeer_GetReceipts_Handler,
+		},
+		{
+			MethodName: "GetNodeData",
+			Handler:    _LightPeer_GetNodeData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "light/proto/light.proto",
+}
@@ -0,0 +1,123 @@
+package light
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/light/proto"
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// maxNodeDataAmount is the maximum number of trie nodes or codes returned by a single request
+const maxNodeDataAmount = 384
+
+var (
+	ErrBlockNotFound = errors.New("block not found")
+)
+
+// Service serves the block bodies, receipts and state of the full node to the light nodes
+type Service struct {
+	proto.UnimplementedLightPeerServer
+
+	blockchain Blockchain       // reference to the blockchain module
+	storage    NodeStorage      // reference to the state storage
+	network    Network          // reference to the network module
+	stream     *grpc.GrpcStream // reference to the grpc stream
+}
+
+func NewService(
+	network Network,
+	blockchain Blockchain,
+	storage NodeStorage,
+) *Service {
+	return &Service{
+		blockchain: blockchain,
+		storage:    storage,
+		network:    network,
+	}
+}
+
+// Start registers the light protocol on the network
+func (s *Service) Start() {
+	s.stream = grpc.NewGrpcStream()
+
+	proto.RegisterLightPeerServer(s.stream.GrpcServer(), s)
+	s.stream.Serve()
+	s.network.RegisterProtocol(LightProto, s.stream)
+}
+
+// Close closes the light protocol stream
+func (s *Service) Close() error {
+	if s.stream == nil {
+		return nil
+	}
+
+	return s.stream.Close()
+}
+
+// GetBody is a gRPC endpoint to return the body of the block
+func (s *Service) GetBody(
+	ctx context.Context,
+	req *proto.GetByHashRequest,
+) (*proto.Body, error) {
+	body, ok := s.blockchain.GetBodyByHash(types.BytesToHash(req.Hash))
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+
+	return &proto.Body{
+		Body: body.MarshalRLPTo(nil),
+	}, nil
+}
+
+// GetReceipts is a gRPC endpoint to return the receipts of the block
+func (s *Service) GetReceipts(
+	ctx context.Context,
+	req *proto.GetByHashRequest,
+) (*proto.Receipts, error) {
+	hash := types.BytesToHash(req.Hash)
+
+	if _, ok := s.blockchain.GetHeaderByHash(hash); !ok {
+		return nil, ErrBlockNotFound
+	}
+
+	receipts, err := s.blockchain.GetReceiptsByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.Receipts{
+		Receipts: types.Receipts(receipts).MarshalStoreRLPTo(nil),
+	}, nil
+}
+
+// GetNodeData is a gRPC endpoint to return the state trie nodes or the contract codes by their hashes.
+// The unknown ones are returned empty
+func (s *Service) GetNodeData(
+	ctx context.Context,
+	req *proto.GetNodeDataRequest,
+) (*proto.NodeData, error) {
+	hashes := req.Hashes
+	if len(hashes) > maxNodeDataAmount {
+		hashes = hashes[:maxNodeDataAmount]
+	}
+
+	data := make([][]byte, len(hashes))
+
+	for i, hash := range hashes {
+		if len(hash) != types.HashLength {
+			continue
+		}
+
+		if node, ok := s.storage.Get(hash); ok {
+			data[i] = node
+		} else if code, ok := s.storage.GetCode(types.BytesToHash(hash)); ok {
+			data[i] = code
+		}
+	}
+
+	return &proto.NodeData{
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,170 @@
+package light
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/light/proto"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBlockchain struct {
+	headers  map[types.Hash]*types.Header
+	bodies   map[types.Hash]*types.Body
+	receipts map[types.Hash][]*types.Receipt
+}
+
+func (m *mockBlockchain) GetHeaderByHash(hash types.Hash) (*types.Header, bool) {
+	header, ok := m.headers[hash]
+
+	return header, ok
+}
+
+func (m *mockBlockchain) GetBodyByHash(hash types.Hash) (*types.Body, bool) {
+	body, ok := m.bodies[hash]
+
+	return body, ok
+}
+
+func (m *mockBlockchain) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return m.receipts[hash], nil
+}
+
+// newTestBlock creates a block with the transactions and their receipts, and the header matching them
+func newTestBlock(number uint64, numTxs int) (*types.Header, *types.Body, []*types.Receipt) {
+	var (
+		body     = &types.Body{}
+		receipts = make([]*types.Receipt, numTxs)
+	)
+
+	for i := 0; i < numTxs; i++ {
+		tx := &types.Transaction{
+			Nonce:    uint64(i),
+			GasPrice: big.NewInt(1),
+			Gas:      21000,
+			To:       &types.Address{0x1},
+			Value:    big.NewInt(int64(i + 1)),
+			V:        big.NewInt(27),
+			R:        big.NewInt(1),
+			S:        big.NewInt(1),
+			From:     types.Address{0x2},
+		}
+		tx.ComputeHash()
+
+		body.Transactions = append(body.Transactions, tx)
+
+		receipts[i] = &types.Receipt{
+			CumulativeGasUsed: uint64(i+1) * 21000,
+			GasUsed:           21000,
+			TxHash:            tx.Hash,
+		}
+		receipts[i].SetStatus(types.ReceiptSuccess)
+	}
+
+	header := &types.Header{
+		Number:       number,
+		TxRoot:       buildroot.CalculateTransactionsRoot(body.Transactions),
+		ReceiptsRoot: buildroot.CalculateReceiptsRoot(receipts),
+		Sha3Uncles:   types.EmptyUncleHash,
+	}
+	header.ComputeHash()
+
+	return header, body, receipts
+}
+
+func newTestBlockchain(headers []*types.Header, bodies []*types.Body, receipts [][]*types.Receipt) *mockBlockchain {
+	chain := &mockBlockchain{
+		headers:  make(map[types.Hash]*types.Header),
+		bodies:   make(map[types.Hash]*types.Body),
+		receipts: make(map[types.Hash][]*types.Receipt),
+	}
+
+	for i, header := range headers {
+		chain.headers[header.Hash] = header
+		chain.bodies[header.Hash] = bodies[i]
+		chain.receipts[header.Hash] = receipts[i]
+	}
+
+	return chain
+}
+
+func TestService_GetBody(t *testing.T) {
+	t.Parallel()
+
+	header, body, receipts := newTestBlock(1, 2)
+	service := NewService(nil, newTestBlockchain(
+		[]*types.Header{header}, []*types.Body{body}, [][]*types.Receipt{receipts}), nil)
+
+	resp, err := service.GetBody(context.Background(), &proto.GetByHashRequest{Hash: header.Hash.Bytes()})
+	require.NoError(t, err)
+
+	result := &types.Body{}
+	require.NoError(t, result.UnmarshalRLP(resp.Body))
+	require.Len(t, result.Transactions, 2)
+
+	for i, tx := range result.Transactions {
+		tx.ComputeHash()
+
+		assert.Equal(t, body.Transactions[i].Hash, tx.Hash)
+	}
+
+	_, err = service.GetBody(context.Background(), &proto.GetByHashRequest{Hash: types.ZeroHash.Bytes()})
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+}
+
+func TestService_GetReceipts(t *testing.T) {
+	t.Parallel()
+
+	header, body, receipts := newTestBlock(1, 2)
+	service := NewService(nil, newTestBlockchain(
+		[]*types.Header{header}, []*types.Body{body}, [][]*types.Receipt{receipts}), nil)
+
+	resp, err := service.GetReceipts(context.Background(), &proto.GetByHashRequest{Hash: header.Hash.Bytes()})
+	require.NoError(t, err)
+
+	result := types.Receipts{}
+	require.NoError(t, result.UnmarshalStoreRLP(resp.Receipts))
+	assert.Equal(t, header.ReceiptsRoot, buildroot.CalculateReceiptsRoot(result))
+
+	_, err = service.GetReceipts(context.Background(), &proto.GetByHashRequest{Hash: types.ZeroHash.Bytes()})
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+}
+
+func TestService_GetNodeData(t *testing.T) {
+	t.Parallel()
+
+	var (
+		storage = itrie.NewMemoryStorage()
+		node    = []byte{0x1, 0x2, 0x3}
+		code    = []byte{0x60, 0x0}
+
+		nodeHash = crypto.Keccak256(node)
+		codeHash = types.BytesToHash(crypto.Keccak256(code))
+	)
+
+	storage.Put(nodeHash, node)
+	storage.SetCode(codeHash, code)
+
+	service := NewService(nil, nil, storage)
+
+	resp, err := service.GetNodeData(context.Background(), &proto.GetNodeDataRequest{
+		Hashes: [][]byte{nodeHash, codeHash.Bytes(), types.ZeroHash.Bytes(), {0x1}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]byte{node, code, nil, nil}, resp.Data)
+
+	// the number of the returned items is limited
+	resp, err = service.GetNodeData(context.Background(), &proto.GetNodeDataRequest{
+		Hashes: make([][]byte, maxNodeDataAmount+1),
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, resp.Data, maxNodeDataAmount)
+}
@@ -0,0 +1,144 @@
+package light
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// chainStorage is the blockchain storage of the light node, keeping the verified headers only.
+// The block bodies and receipts missing locally are fetched from the peers on demand,
+// verified against the headers and saved
+type chainStorage struct {
+	storage.Storage
+
+	logger hclog.Logger
+	client *Client
+}
+
+func NewChainStorage(logger hclog.Logger, db storage.Storage, client *Client) storage.Storage {
+	return &chainStorage{
+		Storage: db,
+		logger:  logger.Named(lightName),
+		client:  client,
+	}
+}
+
+// ReadBody reads the body, fetching it from the peers if it is not saved locally
+func (s *chainStorage) ReadBody(hash types.Hash) (*types.Body, error) {
+	body, err := s.Storage.ReadBody(hash)
+	if !errors.Is(err, storage.ErrNotFound) {
+		return body, err
+	}
+
+	header, err := s.Storage.ReadHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if body, err = s.client.GetBody(header); err != nil {
+		return nil, err
+	}
+
+	batchWriter := storage.NewBatchWriter(s.Storage)
+
+	batchWriter.PutBody(hash, body)
+
+	for _, tx := range body.Transactions {
+		batchWriter.PutTxLookup(tx.Hash, hash)
+	}
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		s.logger.Warn("failed to write body into storage", "hash", hash, "err", err)
+	}
+
+	return body, nil
+}
+
+// ReadReceipts reads the receipts, fetching them from the peers if they are not saved locally
+func (s *chainStorage) ReadReceipts(hash types.Hash) ([]*types.Receipt, error) {
+	receipts, err := s.Storage.ReadReceipts(hash)
+	if !errors.Is(err, storage.ErrNotFound) {
+		return receipts, err
+	}
+
+	header, err := s.Storage.ReadHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if receipts, err = s.client.GetReceipts(header); err != nil {
+		return nil, err
+	}
+
+	batchWriter := storage.NewBatchWriter(s.Storage)
+
+	batchWriter.PutReceipts(hash, receipts)
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		s.logger.Warn("failed to write receipts into storage", "hash", hash, "err", err)
+	}
+
+	return receipts, nil
+}
+
+// stateStorage is the state storage of the light node. The state trie nodes
+// and the contract codes missing locally are fetched from the peers on demand,
+// verified against their hashes and saved
+type stateStorage struct {
+	itrie.Storage
+
+	logger hclog.Logger
+	client *Client
+}
+
+func NewStateStorage(logger hclog.Logger, db itrie.Storage, client *Client) itrie.Storage {
+	return &stateStorage{
+		Storage: db,
+		logger:  logger.Named(lightName),
+		client:  client,
+	}
+}
+
+// Get returns the trie node, fetching it from the peers if it is not saved locally
+func (s *stateStorage) Get(k []byte) ([]byte, bool) {
+	if data, ok := s.Storage.Get(k); ok {
+		return data, true
+	}
+
+	if len(k) != types.HashLength {
+		return nil, false
+	}
+
+	data, err := s.client.GetNodeData(types.BytesToHash(k))
+	if err != nil {
+		s.logger.Debug("unable to fetch trie node", "hash", types.BytesToHash(k), "err", err)
+
+		return nil, false
+	}
+
+	s.Storage.Put(k, data)
+
+	return data, true
+}
+
+// GetCode returns the contract code, fetching it from the peers if it is not saved locally
+func (s *stateStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	if code, ok := s.Storage.GetCode(hash); ok {
+		return code, true
+	}
+
+	code, err := s.client.GetNodeData(hash)
+	if err != nil {
+		s.logger.Debug("unable to fetch code", "hash", hash, "err", err)
+
+		return nil, false
+	}
+
+	s.Storage.SetCode(hash, code)
+
+	return code, true
+}
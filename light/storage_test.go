@@ -0,0 +1,122 @@
+package light
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainStorage_ReadBodyAndReceipts(t *testing.T) {
+	t.Parallel()
+
+	header, body, receipts := newTestBlock(1, 2)
+
+	client := newTestClient(t, newTestBlockchain(
+		[]*types.Header{header}, []*types.Body{body}, [][]*types.Receipt{receipts}), nil)
+
+	db, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	// the light node keeps the header only
+	batchWriter := storage.NewBatchWriter(db)
+	batchWriter.PutHeader(header)
+	require.NoError(t, batchWriter.WriteBatch())
+
+	chainStorage := NewChainStorage(hclog.NewNullLogger(), db, client)
+
+	readBody, err := chainStorage.ReadBody(header.Hash)
+	require.NoError(t, err)
+	require.Len(t, readBody.Transactions, len(body.Transactions))
+
+	readReceipts, err := chainStorage.ReadReceipts(header.Hash)
+	require.NoError(t, err)
+	require.Len(t, readReceipts, len(receipts))
+
+	// the fetched data is saved locally
+	savedBody, err := db.ReadBody(header.Hash)
+	require.NoError(t, err)
+	assert.Len(t, savedBody.Transactions, len(body.Transactions))
+
+	savedReceipts, err := db.ReadReceipts(header.Hash)
+	require.NoError(t, err)
+	assert.Len(t, savedReceipts, len(receipts))
+
+	for _, tx := range body.Transactions {
+		blockHash, ok := db.ReadTxLookup(tx.Hash)
+		require.True(t, ok)
+		assert.Equal(t, header.Hash, blockHash)
+	}
+
+	// the unknown blocks are not requested
+	_, err = chainStorage.ReadBody(types.StringToHash("0x1"))
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestStateStorage_Get(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr = types.StringToAddress("0x1")
+		code = []byte{0x60, 0x0, 0x60, 0x0}
+
+		fullStorage = itrie.NewMemoryStorage()
+	)
+
+	_, root := itrie.NewState(fullStorage).NewSnapshot().Commit([]*state.Object{
+		{
+			Address:   addr,
+			Balance:   big.NewInt(100),
+			Nonce:     2,
+			Root:      types.EmptyRootHash,
+			CodeHash:  types.BytesToHash(crypto.Keccak256(code)),
+			DirtyCode: true,
+			Code:      code,
+			Storage: []*state.StorageObject{
+				{Key: types.StringToHash("0x1").Bytes(), Val: types.StringToHash("0x2").Bytes()},
+			},
+		},
+		{
+			Address: types.StringToAddress("0x2"),
+			Balance: big.NewInt(200),
+		},
+	})
+
+	client := newTestClient(t, nil, fullStorage)
+
+	lightStorage := itrie.NewMemoryStorage()
+	lightState := itrie.NewState(NewStateStorage(hclog.NewNullLogger(), lightStorage, client))
+
+	snap, err := lightState.NewSnapshotAt(types.BytesToHash(root))
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(addr)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+
+	assert.Equal(t, big.NewInt(100), account.Balance)
+	assert.Equal(t, uint64(2), account.Nonce)
+
+	value, err := snap.GetStorage(addr, account.Root, types.StringToHash("0x1"))
+	require.NoError(t, err)
+	assert.Equal(t, types.StringToHash("0x2"), value)
+
+	readCode, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+	require.True(t, ok)
+	assert.Equal(t, code, readCode)
+
+	// the fetched nodes and code are saved locally
+	_, ok = lightStorage.Get(root)
+	assert.True(t, ok)
+
+	_, ok = lightStorage.GetCode(types.BytesToHash(account.CodeHash))
+	assert.True(t, ok)
+}
@@ -0,0 +1,43 @@
+package light
+
+import (
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/types"
+	libp2pNetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	lightName  = "light"
+	LightProto = "/light/0.1"
+)
+
+type Blockchain interface {
+	// GetHeaderByHash returns the header by its hash
+	GetHeaderByHash(types.Hash) (*types.Header, bool)
+	// GetBodyByHash returns the body by the block hash
+	GetBodyByHash(types.Hash) (*types.Body, bool)
+	// GetReceiptsByHash returns the receipts by the block hash
+	GetReceiptsByHash(types.Hash) ([]*types.Receipt, error)
+}
+
+// NodeStorage is the state storage the trie nodes and the contract codes are served from
+type NodeStorage interface {
+	// Get returns the trie node by its hash
+	Get(k []byte) ([]byte, bool)
+	// GetCode returns the contract code by its hash
+	GetCode(hash types.Hash) ([]byte, bool)
+}
+
+type Network interface {
+	// RegisterProtocol registers gRPC service
+	RegisterProtocol(string, network.Protocol)
+	// Peers returns current connected peers
+	Peers() []*network.PeerConnInfo
+	// GetProtocols returns the list of protocols supported by the peer
+	GetProtocols(peerID peer.ID) ([]string, error)
+	// NewStream opens up a new stream on the protocol to the peer.
+	// Unlike NewProtoConnection, it does not require the protocol to be registered locally,
+	// so the light node does not advertise the protocol it can not serve
+	NewStream(protocol string, peerID peer.ID) (libp2pNetwork.Stream, error)
+}
@@ -0,0 +1,97 @@
+package network
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+)
+
+var _ metrics.Reporter = (*bandwidthCounter)(nil)
+
+// ProtocolBandwidth is the number of bytes exchanged with the peer over a single protocol
+type ProtocolBandwidth struct {
+	Protocol string
+	BytesIn  uint64
+	BytesOut uint64
+}
+
+// bandwidthCounter is the libp2p bandwidth reporter, which additionally
+// keeps the totals per peer and protocol, since libp2p keeps them per peer or per protocol only
+type bandwidthCounter struct {
+	*metrics.BandwidthCounter
+
+	lock  sync.RWMutex
+	peers map[peer.ID]map[protocol.ID]*ProtocolBandwidth
+}
+
+func newBandwidthCounter() *bandwidthCounter {
+	return &bandwidthCounter{
+		BandwidthCounter: metrics.NewBandwidthCounter(),
+		peers:            make(map[peer.ID]map[protocol.ID]*ProtocolBandwidth),
+	}
+}
+
+// LogSentMessageStream records the bytes sent to the peer over the protocol
+func (c *bandwidthCounter) LogSentMessageStream(size int64, proto protocol.ID, p peer.ID) {
+	c.BandwidthCounter.LogSentMessageStream(size, proto, p)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.protocolBandwidth(p, proto).BytesOut += uint64(size)
+}
+
+// LogRecvMessageStream records the bytes received from the peer over the protocol
+func (c *bandwidthCounter) LogRecvMessageStream(size int64, proto protocol.ID, p peer.ID) {
+	c.BandwidthCounter.LogRecvMessageStream(size, proto, p)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.protocolBandwidth(p, proto).BytesIn += uint64(size)
+}
+
+// protocolBandwidth returns the totals of the peer and protocol, creating them if needed
+func (c *bandwidthCounter) protocolBandwidth(p peer.ID, proto protocol.ID) *ProtocolBandwidth {
+	protocols, ok := c.peers[p]
+	if !ok {
+		protocols = make(map[protocol.ID]*ProtocolBandwidth)
+		c.peers[p] = protocols
+	}
+
+	bandwidth, ok := protocols[proto]
+	if !ok {
+		bandwidth = &ProtocolBandwidth{Protocol: string(proto)}
+		protocols[proto] = bandwidth
+	}
+
+	return bandwidth
+}
+
+// peerBandwidth returns the totals of the peer per protocol, ordered by protocol
+func (c *bandwidthCounter) peerBandwidth(p peer.ID) []ProtocolBandwidth {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	result := make([]ProtocolBandwidth, 0, len(c.peers[p]))
+	for _, bandwidth := range c.peers[p] {
+		result = append(result, *bandwidth)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Protocol < result[j].Protocol
+	})
+
+	return result
+}
+
+// removePeer drops the totals of the disconnected peer
+func (c *bandwidthCounter) removePeer(p peer.ID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.peers, p)
+}
@@ -20,12 +20,21 @@ type Config struct {
 	MaxOutboundPeers int64                  // the maximum number of outbound peer connections
 	Chain            *chain.Chain           // the reference to the chain configuration
 	SecretsManager   secrets.SecretsManager // the secrets manager used for key storage
+	StaticPeers      []string               // the peer multiaddrs which are always kept connected
+	TrustedPeers     []string               // the peer IDs which are exempt from the connection limits
+	SentryNodes      []string               // the sentry multiaddrs, the only peers a private validator connects to
+	PrivatePeers     []string               // the peer IDs which are never advertised to other peers
+	ListenAddrs      []multiaddr.Multiaddr  // the additional listen multiaddrs, besides the base address
+	QUIC             bool                   // flag indicating if QUIC is listened on at the base address port
+	Security         string                 // the preferred security protocol of the TCP connections
 }
 
 func DefaultConfig() *Config {
 	return &Config{
 		// The discovery service is turned on by default
 		NoDiscover: false,
+		// The TCP connections are secured with noise by default
+		Security: SecurityNoise,
 		// Addresses are bound to localhost by default
 		Addr: &net.TCPAddr{
 			IP:   net.ParseIP("127.0.0.1"),
@@ -67,6 +67,9 @@ type networkingServer interface {
 	// GetRandomPeer fetches a random peer from the server's peer store
 	GetRandomPeer() *peer.ID
 
+	// IsPrivatePeer checks if the peer must never be advertised to other peers
+	IsPrivatePeer(peerID peer.ID) bool
+
 	// TEMPORARY DIALING //
 
 	// FetchOrSetTemporaryDial checks if the peer connection is a temporary dial,
@@ -137,6 +140,12 @@ func (d *DiscoveryService) HandleNetworkEvent(peerEvent *event.PeerEvent) {
 
 	switch peerEvent.Type {
 	case event.PeerConnected:
+		// Private peers are kept out of the routing table,
+		// so they are never handed out to the other peers
+		if d.baseServer.IsPrivatePeer(peerID) {
+			return
+		}
+
 		// Add peer to the routing table and to our local peer table
 		_, err := d.routingTable.TryAddPeer(peerID, false, false)
 		if err != nil {
@@ -168,6 +177,10 @@ func (d *DiscoveryService) ConnectToBootnodes(bootnodes []*peer.AddrInfo) {
 
 // addToTable adds the node to the peer store and the routing table
 func (d *DiscoveryService) addToTable(node *peer.AddrInfo) error {
+	if d.baseServer.IsPrivatePeer(node.ID) {
+		return nil
+	}
+
 	// before we include peers on the routing table -> dial queue
 	// we have to add them to the peer store so that they are
 	// available to all the libp2p services
@@ -418,8 +431,9 @@ func (d *DiscoveryService) FindPeers(
 	filteredPeers := make([]string, 0)
 
 	for _, id := range nearestPeers {
-		if id == from {
-			// Skip the peer that's initializing the request
+		if id == from || d.baseServer.IsPrivatePeer(id) {
+			// Skip the peer that's initializing the request,
+			// and the private peers which are never advertised
 			continue
 		}
 
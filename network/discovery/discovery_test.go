@@ -8,6 +8,8 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/helper/tests"
 	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/0xPolygon/polygon-edge/network/event"
+	networkGrpc "github.com/0xPolygon/polygon-edge/network/grpc"
 	"github.com/0xPolygon/polygon-edge/network/proto"
 	networkTesting "github.com/0xPolygon/polygon-edge/network/testing"
 	"github.com/hashicorp/go-hclog"
@@ -301,3 +303,56 @@ func TestDiscoveryService_RegularPeerDiscoveryUnconnected(t *testing.T) {
 	// Make sure that no peers were added to the peer store
 	assert.Len(t, peerStore, 0)
 }
+
+// TestDiscoveryService_PrivatePeers makes sure the private peers
+// are never added to the routing table nor handed out to other peers
+func TestDiscoveryService_PrivatePeers(t *testing.T) {
+	var (
+		randomPeers  = getRandomPeers(t, 3)
+		privatePeer  = randomPeers[0]
+		publicPeer   = randomPeers[1]
+		requestPeer  = randomPeers[2]
+		peerStore    = make(map[peer.ID]*peer.AddrInfo)
+		isPrivateFn  = func(id peer.ID) bool { return id == privatePeer.ID }
+		getPeerInfoF = func(id peer.ID) *peer.AddrInfo { return peerStore[id] }
+	)
+
+	discoveryService, setupErr := newDiscoveryService(
+		func(server *networkTesting.MockNetworkingServer) {
+			server.HookIsPrivatePeer(isPrivateFn)
+			server.HookGetPeerInfo(getPeerInfoF)
+			server.HookAddToPeerStore(func(info *peer.AddrInfo) {
+				peerStore[info.ID] = info
+			})
+		},
+	)
+	if setupErr != nil {
+		t.Fatalf("Unable to setup the discovery service")
+	}
+
+	// the private peer connects, and is learned through the peer exchange
+	discoveryService.HandleNetworkEvent(&event.PeerEvent{
+		PeerID: privatePeer.ID,
+		Type:   event.PeerConnected,
+	})
+	assert.NoError(t, discoveryService.addToTable(privatePeer))
+	assert.NoError(t, discoveryService.addToTable(publicPeer))
+
+	assert.Equal(t, []peer.ID{publicPeer.ID}, discoveryService.RoutingTablePeers())
+	assert.NotContains(t, peerStore, privatePeer.ID)
+
+	// the private peer is never handed out, even if present in the routing table
+	_, err := discoveryService.routingTable.TryAddPeer(privatePeer.ID, false, false)
+	assert.NoError(t, err)
+
+	resp, err := discoveryService.FindPeers(
+		&networkGrpc.Context{Context: context.Background(), PeerID: requestPeer.ID},
+		&proto.FindPeersReq{Count: 10},
+	)
+	assert.NoError(t, err)
+
+	publicAddr, err := common.AddrInfoToString(publicPeer)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{publicAddr}, resp.Nodes)
+}
@@ -0,0 +1,47 @@
+package network
+
+import (
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+var _ connmgr.ConnectionGater = (*banListGater)(nil)
+
+// banListGater is the libp2p connection gater refusing
+// the inbound and outbound connections of the banned peers,
+// and of all the peers but the sentry nodes if the node is a private validator
+type banListGater struct {
+	lists *peerLists
+}
+
+// InterceptPeerDial refuses dialing the banned peer IDs
+func (g *banListGater) InterceptPeerDial(id peer.ID) bool {
+	return g.lists.isAllowed(id) && !g.lists.isPeerBanned(id)
+}
+
+// InterceptAddrDial refuses dialing the banned IP addresses
+func (g *banListGater) InterceptAddrDial(id peer.ID, addr multiaddr.Multiaddr) bool {
+	return !g.lists.isAddrBanned(id, addr)
+}
+
+// InterceptAccept accepts every inbound connection,
+// the remote address is checked once the peer ID is known,
+// so the trusted peers can connect from a banned range
+func (g *banListGater) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured refuses the connections of the banned peer IDs and IP addresses
+func (g *banListGater) InterceptSecured(_ network.Direction, id peer.ID, addrs network.ConnMultiaddrs) bool {
+	return g.lists.isAllowed(id) &&
+		!g.lists.isPeerBanned(id) &&
+		!g.lists.isAddrBanned(id, addrs.RemoteMultiaddr())
+}
+
+// InterceptUpgraded accepts every connection which passed the previous checks
+func (g *banListGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -21,14 +22,42 @@ const (
 	subscribeOutputBufferSize = 1024
 )
 
+// TopicValidator validates the decoded gossip message before it is delivered and propagated.
+// The peers forwarding the rejected messages are penalized by the gossip peer scoring
+type TopicValidator func(obj interface{}, from peer.ID) ValidationResult
+
+// TopicOption is the option of the gossip topic
+type TopicOption func(*topicConfig)
+
+type topicConfig struct {
+	validator   TopicValidator
+	scoreParams *TopicScoreParams
+}
+
+// WithTopicValidator sets the validator of the topic messages
+func WithTopicValidator(validator TopicValidator) TopicOption {
+	return func(c *topicConfig) {
+		c.validator = validator
+	}
+}
+
+// WithTopicScoreParams overrides the default peer scoring parameters of the topic
+func WithTopicScoreParams(params *TopicScoreParams) TopicOption {
+	return func(c *topicConfig) {
+		c.scoreParams = params
+	}
+}
+
 type Topic struct {
 	logger hclog.Logger
 
-	topic     *pubsub.Topic
-	typ       reflect.Type
-	closeCh   chan struct{}
-	closed    atomic.Bool
-	waitGroup sync.WaitGroup
+	ps           *pubsub.PubSub
+	gossipCounts *gossipCounter
+	topic        *pubsub.Topic
+	typ          reflect.Type
+	closeCh      chan struct{}
+	closed       atomic.Bool
+	waitGroup    sync.WaitGroup
 }
 
 func (t *Topic) createObj() proto.Message {
@@ -51,7 +80,11 @@ func (t *Topic) Close() {
 
 	// if all subscribers are finished, close the topic
 	if t.topic != nil {
-		t.topic.Close()
+		// the validator is unregistered so the topic can be joined again
+		if err := t.topic.Close(); err == nil {
+			_ = t.ps.UnregisterTopicValidator(t.topic.String())
+		}
+
 		t.topic = nil
 	}
 }
@@ -107,12 +140,16 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{},
 		}
 
 		go func() {
-			obj := t.createObj()
-			if err := proto.Unmarshal(msg.Data, obj); err != nil {
-				t.logger.Error("failed to unmarshal topic", "err", err)
-				metrics.IncrCounter([]string{networkMetrics, "bad_messages"}, float32(1))
-
-				return
+			// the message is already decoded by the topic validator
+			obj, ok := msg.ValidatorData.(proto.Message)
+			if !ok {
+				obj = t.createObj()
+				if err := proto.Unmarshal(msg.Data, obj); err != nil {
+					t.logger.Error("failed to unmarshal topic", "err", err)
+					metrics.IncrCounter([]string{networkMetrics, "bad_messages"}, float32(1))
+
+					return
+				}
 			}
 
 			metrics.SetGauge([]string{networkMetrics, "ingress_bytes"}, float32(len(msg.Data)))
@@ -122,19 +159,79 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{},
 	}
 }
 
-func (s *Server) NewTopic(protoID string, obj proto.Message) (*Topic, error) {
+// NewTopic joins the gossip topic. The messages which can not be decoded are always rejected,
+// and the topic is scored with the default parameters unless overridden by the options
+func (s *Server) NewTopic(protoID string, obj proto.Message, opts ...TopicOption) (*Topic, error) {
+	config := &topicConfig{
+		scoreParams: DefaultTopicScoreParams(),
+	}
+
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	tt := &Topic{
+		logger:       s.logger.Named(protoID),
+		ps:           s.ps,
+		gossipCounts: s.gossipCounts,
+		typ:          reflect.TypeOf(obj).Elem(),
+		closeCh:      make(chan struct{}),
+	}
+	tt.closed.Store(false)
+
+	if err := s.ps.RegisterTopicValidator(protoID, tt.newValidator(config.validator)); err != nil {
+		return nil, fmt.Errorf("unable to register topic validator: %w", err)
+	}
+
 	topic, err := s.ps.Join(protoID)
 	if err != nil {
+		_ = s.ps.UnregisterTopicValidator(protoID)
+
 		return nil, err
 	}
 
-	tt := &Topic{
-		logger:  s.logger.Named(protoID),
-		topic:   topic,
-		typ:     reflect.TypeOf(obj).Elem(),
-		closeCh: make(chan struct{}),
+	if err := topic.SetScoreParams(config.scoreParams); err != nil {
+		_ = s.ps.UnregisterTopicValidator(protoID)
+		_ = topic.Close()
+
+		return nil, fmt.Errorf("unable to set topic score params: %w", err)
 	}
-	tt.closed.Store(false)
+
+	tt.topic = topic
 
 	return tt, nil
 }
+
+// newValidator returns the gossipsub validator, which decodes the message
+// and runs the topic validator on it, if any
+func (t *Topic) newValidator(validator TopicValidator) pubsub.ValidatorEx {
+	return func(_ context.Context, receivedFrom peer.ID, msg *pubsub.Message) ValidationResult {
+		if !msg.Local {
+			t.gossipCounts.add(receivedFrom, msg.GetTopic())
+		}
+
+		obj := t.createObj()
+		if err := proto.Unmarshal(msg.Data, obj); err != nil {
+			t.logger.Debug("rejecting undecodable message", "from", msg.GetFrom(), "err", err)
+			metrics.IncrCounter([]string{networkMetrics, "bad_messages"}, float32(1))
+
+			return ValidationReject
+		}
+
+		if validator != nil {
+			if result := validator(obj, msg.GetFrom()); result != ValidationAccept {
+				if result == ValidationReject {
+					t.logger.Debug("rejecting invalid message", "from", msg.GetFrom())
+					metrics.IncrCounter([]string{networkMetrics, "bad_messages"}, float32(1))
+				}
+
+				return result
+			}
+		}
+
+		// pass the decoded message to the subscribers
+		msg.ValidatorData = obj
+
+		return ValidationAccept
+	}
+}
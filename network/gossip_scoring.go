@@ -0,0 +1,151 @@
+package network
+
+import (
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// peerScoreInspectInterval is the interval the gossip peer scores are refreshed at
+	peerScoreInspectInterval = 5 * time.Second
+
+	// scoreDecayInterval is the interval the score counters are decayed at
+	scoreDecayInterval = time.Second
+
+	// scoreDecayToZero is the value below which the decayed score counters are considered zero
+	scoreDecayToZero = 0.01
+
+	// scoreRetention is the time the score of the disconnected peer is remembered for,
+	// so the penalized peers can not reset their score by reconnecting
+	scoreRetention = time.Hour
+)
+
+// The thresholds of the gossip peer score. A peer which sends invalid messages
+// drops below them quickly, while the behaviour penalties alone take a while
+const (
+	// gossipScoreThreshold is the score below which the gossip is not exchanged with the peer
+	gossipScoreThreshold = -500
+	// publishScoreThreshold is the score below which the messages are not published to the peer
+	publishScoreThreshold = -1000
+	// graylistScoreThreshold is the score below which all the messages of the peer are ignored
+	graylistScoreThreshold = -2500
+	// acceptPXScoreThreshold is the score above which the peer exchange of the peer is accepted
+	acceptPXScoreThreshold = 100
+	// opportunisticGraftScoreThreshold is the median mesh score below which the mesh is regrafted
+	opportunisticGraftScoreThreshold = 5
+)
+
+// ValidationResult is the outcome of the gossip message validation
+type ValidationResult = pubsub.ValidationResult
+
+const (
+	// ValidationAccept delivers and propagates the message
+	ValidationAccept = pubsub.ValidationAccept
+	// ValidationReject drops the message and penalizes the peer it was received from
+	ValidationReject = pubsub.ValidationReject
+	// ValidationIgnore drops the message without penalizing the peer
+	ValidationIgnore = pubsub.ValidationIgnore
+)
+
+// TopicScoreParams are the gossipsub peer scoring parameters of a single topic
+type TopicScoreParams = pubsub.TopicScoreParams
+
+// DefaultTopicScoreParams returns the topic scoring parameters which penalize
+// the invalid messages heavily, and reward the time in the mesh and the first deliveries.
+// The mesh delivery expectations are off, since the topics can go quiet for a while
+func DefaultTopicScoreParams() *TopicScoreParams {
+	return &TopicScoreParams{
+		TopicWeight: 1,
+
+		// P1: up to 10 points for an hour in the mesh
+		TimeInMeshWeight:  10.0 / 3600,
+		TimeInMeshQuantum: time.Second,
+		TimeInMeshCap:     3600,
+
+		// P2: up to 50 points for the first deliveries
+		FirstMessageDeliveriesWeight: 1,
+		FirstMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(10 * time.Minute),
+		FirstMessageDeliveriesCap:    50,
+
+		// P3: off by default, set per topic with the expected message rate
+		MeshMessageDeliveriesWeight:     0,
+		MeshMessageDeliveriesDecay:      pubsub.ScoreParameterDecay(time.Minute),
+		MeshMessageDeliveriesCap:        100,
+		MeshMessageDeliveriesThreshold:  1,
+		MeshMessageDeliveriesWindow:     10 * time.Millisecond,
+		MeshMessageDeliveriesActivation: time.Minute,
+
+		// P3b: sticky penalty of the peers pruned for failing the mesh deliveries
+		MeshFailurePenaltyWeight: 0,
+		MeshFailurePenaltyDecay:  pubsub.ScoreParameterDecay(time.Minute),
+
+		// P4: the square of the invalid messages count,
+		// so 5 invalid messages push the peer below the graylist threshold
+		InvalidMessageDeliveriesWeight: -100,
+		InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+	}
+}
+
+// ValidatorTopicScoreParams returns the scoring parameters of the topics carrying the validator messages,
+// i.e. the consensus messages and the bridge votes. They weigh twice as much as the other topics,
+// so the peers delivering them are preferred, and the peers forwarding the forged ones are dropped sooner
+func ValidatorTopicScoreParams() *TopicScoreParams {
+	params := DefaultTopicScoreParams()
+	params.TopicWeight = 2
+
+	return params
+}
+
+// peerScoreParams returns the gossipsub peer scoring parameters.
+// The topic parameters are set when the topics are created
+func peerScoreParams() *pubsub.PeerScoreParams {
+	return &pubsub.PeerScoreParams{
+		Topics:        make(map[string]*pubsub.TopicScoreParams),
+		TopicScoreCap: 100,
+
+		AppSpecificScore:  func(peer.ID) float64 { return 0 },
+		AppSpecificWeight: 1,
+
+		// the IP colocation penalty is off, since the nodes are commonly run next to each other
+		IPColocationFactorWeight:    0,
+		IPColocationFactorThreshold: 1,
+
+		BehaviourPenaltyWeight:    -10,
+		BehaviourPenaltyThreshold: 6,
+		BehaviourPenaltyDecay:     pubsub.ScoreParameterDecay(10 * time.Minute),
+
+		DecayInterval: scoreDecayInterval,
+		DecayToZero:   scoreDecayToZero,
+		RetainScore:   scoreRetention,
+	}
+}
+
+// peerScoreThresholds returns the gossipsub peer score thresholds
+func peerScoreThresholds() *pubsub.PeerScoreThresholds {
+	return &pubsub.PeerScoreThresholds{
+		GossipThreshold:             gossipScoreThreshold,
+		PublishThreshold:            publishScoreThreshold,
+		GraylistThreshold:           graylistScoreThreshold,
+		AcceptPXThreshold:           acceptPXScoreThreshold,
+		OpportunisticGraftThreshold: opportunisticGraftScoreThreshold,
+	}
+}
+
+// updatePeerScores stores the latest gossip peer scores [Thread safe]
+func (s *Server) updatePeerScores(scores map[peer.ID]float64) {
+	s.peerScoresLock.Lock()
+	defer s.peerScoresLock.Unlock()
+
+	s.peerScores = scores
+}
+
+// GetPeerScore returns the gossip score of the peer,
+// which is zero for the peers not scored yet [Thread safe]
+func (s *Server) GetPeerScore(peerID peer.ID) float64 {
+	s.peerScoresLock.RLock()
+	defer s.peerScoresLock.RUnlock()
+
+	return s.peerScores[peerID]
+}
@@ -9,6 +9,8 @@ import (
 
 	testproto "github.com/0xPolygon/polygon-edge/network/proto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func NumSubscribers(srv *Server, topic string) int {
@@ -118,3 +120,67 @@ func Test_RepeatedClose(t *testing.T) {
 	topic.Close()
 	topic.Close()
 }
+
+func TestGossip_InvalidMessagesPenalized(t *testing.T) {
+	servers, createErr := createServers(2, nil)
+	require.NoError(t, createErr)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	var (
+		topicName = "msg-validated"
+		sender    = servers[0]
+		receiver  = servers[1]
+		received  = make(chan string, 10)
+	)
+
+	senderTopic, err := sender.NewTopic(topicName, &testproto.GenericMessage{})
+	require.NoError(t, err)
+
+	receiverTopic, err := receiver.NewTopic(
+		topicName,
+		&testproto.GenericMessage{},
+		WithTopicValidator(func(obj interface{}, _ peer.ID) ValidationResult {
+			if obj.(*testproto.GenericMessage).Message == "invalid" { //nolint:forcetypeassert
+				return ValidationReject
+			}
+
+			return ValidationAccept
+		}),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, receiverTopic.Subscribe(func(obj interface{}, _ peer.ID) {
+		received <- obj.(*testproto.GenericMessage).Message //nolint:forcetypeassert
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, WaitForSubscribers(ctx, sender, topicName, 1))
+
+	// the valid message is delivered
+	require.NoError(t, senderTopic.Publish(&testproto.GenericMessage{Message: "valid"}))
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "valid", msg)
+	case <-time.After(10 * time.Second):
+		t.Fatal("valid message not received")
+	}
+
+	// the invalid messages are dropped and penalize the sender
+	for i := 0; i < 3; i++ {
+		require.NoError(t, senderTopic.Publish(&testproto.GenericMessage{Message: "invalid"}))
+	}
+
+	require.Eventually(t, func() bool {
+		return receiver.GetPeerScore(sender.host.ID()) < 0
+	}, 3*peerScoreInspectInterval, 100*time.Millisecond)
+
+	assert.Empty(t, received)
+}
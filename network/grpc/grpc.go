@@ -146,7 +146,8 @@ type wrapLibp2pAddr struct {
 func (c *streamConn) LocalAddr() net.Addr {
 	addr, err := manet.ToNetAddr(c.Stream.Conn().LocalMultiaddr())
 	if err != nil {
-		return fakeRemoteAddr()
+		// the QUIC multiaddrs have no net.Addr form, the peer ID is still wrapped
+		addr = fakeLocalAddr()
 	}
 
 	return &wrapLibp2pAddr{Addr: addr, id: c.Stream.Conn().LocalPeer()}
@@ -156,7 +157,8 @@ func (c *streamConn) LocalAddr() net.Addr {
 func (c *streamConn) RemoteAddr() net.Addr {
 	addr, err := manet.ToNetAddr(c.Stream.Conn().RemoteMultiaddr())
 	if err != nil {
-		return fakeRemoteAddr()
+		// the QUIC multiaddrs have no net.Addr form, the peer ID is still wrapped
+		addr = fakeRemoteAddr()
 	}
 
 	return &wrapLibp2pAddr{Addr: addr, id: c.Stream.Conn().RemotePeer()}
@@ -0,0 +1,236 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// peerPingTimeout is the time the peer has to answer the latency ping
+const peerPingTimeout = 2 * time.Second
+
+var ErrPeerNotConnected = errors.New("peer not connected")
+
+// TopicMessages is the number of gossip messages received from the peer on a single topic
+type TopicMessages struct {
+	Topic string
+	Count uint64
+}
+
+// gossipCounter counts the gossip messages received from the peers per topic.
+// The messages are counted as received, before their validation
+type gossipCounter struct {
+	lock  sync.RWMutex
+	peers map[peer.ID]map[string]uint64
+}
+
+func newGossipCounter() *gossipCounter {
+	return &gossipCounter{
+		peers: make(map[peer.ID]map[string]uint64),
+	}
+}
+
+// add counts a message received from the peer on the topic
+func (c *gossipCounter) add(p peer.ID, topic string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	topics, ok := c.peers[p]
+	if !ok {
+		topics = make(map[string]uint64)
+		c.peers[p] = topics
+	}
+
+	topics[topic]++
+}
+
+// peerMessages returns the message counts of the peer per topic, ordered by topic
+func (c *gossipCounter) peerMessages(p peer.ID) []TopicMessages {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	result := make([]TopicMessages, 0, len(c.peers[p]))
+	for topic, count := range c.peers[p] {
+		result = append(result, TopicMessages{Topic: topic, Count: count})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Topic < result[j].Topic
+	})
+
+	return result
+}
+
+// removePeer drops the message counts of the disconnected peer
+func (c *gossipCounter) removePeer(p peer.ID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.peers, p)
+}
+
+// PeerDiagnostics is the connection and traffic information of a connected peer
+type PeerDiagnostics struct {
+	ID        peer.ID
+	Addrs     []multiaddr.Multiaddr
+	Protocols []string
+
+	// Direction is the direction of the oldest connection to the peer
+	Direction network.Direction
+	// ConnectedAt is the time the oldest connection to the peer was opened
+	ConnectedAt time.Time
+	// Latency is the ping round trip time, or its moving average if the peer did not answer the ping
+	Latency time.Duration
+	// Distance is the Kademlia (XOR) distance between the node and the peer
+	Distance *big.Int
+	// Score is the gossip score of the peer
+	Score float64
+
+	Bandwidth      []ProtocolBandwidth
+	GossipMessages []TopicMessages
+}
+
+// PeerDiagnostics returns the diagnostics of the connected peer, pinging it for the latency
+func (s *Server) PeerDiagnostics(ctx context.Context, peerID peer.ID) (*PeerDiagnostics, error) {
+	return s.peerDiagnostics(peerID, func() time.Duration {
+		return s.pingPeer(ctx, peerID)
+	})
+}
+
+// PeersDiagnostics returns the diagnostics of all the connected peers, ordered by ID.
+// The peers are pinged concurrently, and the ones disconnected meanwhile are left out
+func (s *Server) PeersDiagnostics(ctx context.Context) []*PeerDiagnostics {
+	var (
+		peers  = s.Peers()
+		result = make([]*PeerDiagnostics, len(peers))
+		wg     sync.WaitGroup
+	)
+
+	for i, p := range peers {
+		wg.Add(1)
+
+		go func(i int, peerID peer.ID) {
+			defer wg.Done()
+
+			diagnostics, err := s.PeerDiagnostics(ctx, peerID)
+			if err != nil {
+				s.logger.Debug("unable to get peer diagnostics", "id", peerID, "err", err)
+
+				return
+			}
+
+			result[i] = diagnostics
+		}(i, p.Info.ID)
+	}
+
+	wg.Wait()
+
+	return sortDiagnostics(result)
+}
+
+// PublicPeersDiagnostics returns the diagnostics of the connected peers, ordered by ID,
+// leaving out the private peers, which must not be disclosed.
+// The peers are not pinged, the latency is the moving average of the previous measurements,
+// so the diagnostics can be served to the untrusted callers
+func (s *Server) PublicPeersDiagnostics() []*PeerDiagnostics {
+	peers := s.Peers()
+	result := make([]*PeerDiagnostics, 0, len(peers))
+
+	for _, p := range peers {
+		peerID := p.Info.ID
+		if s.peerLists.isPrivate(peerID) {
+			continue
+		}
+
+		diagnostics, err := s.peerDiagnostics(peerID, func() time.Duration {
+			return s.host.Peerstore().LatencyEWMA(peerID)
+		})
+		if err != nil {
+			s.logger.Debug("unable to get peer diagnostics", "id", peerID, "err", err)
+
+			continue
+		}
+
+		result = append(result, diagnostics)
+	}
+
+	return sortDiagnostics(result)
+}
+
+// peerDiagnostics returns the diagnostics of the connected peer with the latency measured by the given function
+func (s *Server) peerDiagnostics(peerID peer.ID, latency func() time.Duration) (*PeerDiagnostics, error) {
+	conns := s.host.Network().ConnsToPeer(peerID)
+	if len(conns) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrPeerNotConnected, peerID)
+	}
+
+	oldest := conns[0].Stat()
+	for _, conn := range conns[1:] {
+		if stat := conn.Stat(); stat.Opened.Before(oldest.Opened) {
+			oldest = stat
+		}
+	}
+
+	protocols, err := s.GetProtocols(peerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PeerDiagnostics{
+		ID:             peerID,
+		Addrs:          s.GetPeerInfo(peerID).Addrs,
+		Protocols:      protocols,
+		Direction:      oldest.Direction,
+		ConnectedAt:    oldest.Opened,
+		Latency:        latency(),
+		Distance:       s.GetPeerDistance(peerID),
+		Score:          s.GetPeerScore(peerID),
+		Bandwidth:      s.bandwidth.peerBandwidth(peerID),
+		GossipMessages: s.gossipCounts.peerMessages(peerID),
+	}, nil
+}
+
+// sortDiagnostics orders the diagnostics by the peer ID, dropping the missing ones
+func sortDiagnostics(result []*PeerDiagnostics) []*PeerDiagnostics {
+	diagnostics := make([]*PeerDiagnostics, 0, len(result))
+
+	for _, d := range result {
+		if d != nil {
+			diagnostics = append(diagnostics, d)
+		}
+	}
+
+	sort.Slice(diagnostics, func(i, j int) bool {
+		return diagnostics[i].ID < diagnostics[j].ID
+	})
+
+	return diagnostics
+}
+
+// pingPeer measures the round trip time to the peer. If the peer does not answer in time,
+// the moving average of the previous measurements is returned, which is zero if there are none
+func (s *Server) pingPeer(ctx context.Context, peerID peer.ID) time.Duration {
+	ctx, cancel := context.WithTimeout(ctx, peerPingTimeout)
+	defer cancel()
+
+	select {
+	case res := <-ping.Ping(ctx, s.host, peerID):
+		if res.Error == nil {
+			return res.RTT
+		}
+
+		s.logger.Debug("unable to ping peer", "id", peerID, "err", res.Error)
+	case <-ctx.Done():
+	}
+
+	return s.host.Peerstore().LatencyEWMA(peerID)
+}
@@ -0,0 +1,142 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network/common"
+	testproto "github.com/0xPolygon/polygon-edge/network/proto"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGossipCounter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		counter = newGossipCounter()
+		peerA   = peer.ID("A")
+		peerB   = peer.ID("B")
+	)
+
+	counter.add(peerA, "txs")
+	counter.add(peerA, "ibft")
+	counter.add(peerA, "txs")
+	counter.add(peerB, "txs")
+
+	assert.Equal(t, []TopicMessages{{Topic: "ibft", Count: 1}, {Topic: "txs", Count: 2}}, counter.peerMessages(peerA))
+	assert.Equal(t, []TopicMessages{{Topic: "txs", Count: 1}}, counter.peerMessages(peerB))
+
+	counter.removePeer(peerA)
+
+	assert.Empty(t, counter.peerMessages(peerA))
+	assert.Len(t, counter.peerMessages(peerB), 1)
+}
+
+func TestBandwidthCounter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		counter = newBandwidthCounter()
+		peerA   = peer.ID("A")
+	)
+
+	counter.LogSentMessageStream(10, "/b", peerA)
+	counter.LogRecvMessageStream(20, "/b", peerA)
+	counter.LogRecvMessageStream(5, "/a", peerA)
+	counter.LogSentMessageStream(1, "/b", peerA)
+
+	assert.Equal(t, []ProtocolBandwidth{
+		{Protocol: "/a", BytesIn: 5},
+		{Protocol: "/b", BytesIn: 20, BytesOut: 11},
+	}, counter.peerBandwidth(peerA))
+
+	counter.removePeer(peerA)
+
+	assert.Empty(t, counter.peerBandwidth(peerA))
+}
+
+func TestPeerDiagnostics(t *testing.T) {
+	servers, createErr := createServers(2, nil)
+	require.NoError(t, createErr)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	var (
+		topicName = "diagnostics"
+		sender    = servers[0]
+		receiver  = servers[1]
+		received  = make(chan struct{}, 1)
+	)
+
+	senderTopic, err := sender.NewTopic(topicName, &testproto.GenericMessage{})
+	require.NoError(t, err)
+
+	receiverTopic, err := receiver.NewTopic(topicName, &testproto.GenericMessage{})
+	require.NoError(t, err)
+
+	require.NoError(t, receiverTopic.Subscribe(func(_ interface{}, _ peer.ID) {
+		received <- struct{}{}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, WaitForSubscribers(ctx, sender, topicName, 1))
+	require.NoError(t, senderTopic.Publish(&testproto.GenericMessage{Message: "hello"}))
+
+	select {
+	case <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("message not received")
+	}
+
+	diagnostics, err := receiver.PeerDiagnostics(ctx, sender.host.ID())
+	require.NoError(t, err)
+
+	assert.Equal(t, sender.host.ID(), diagnostics.ID)
+	assert.Equal(t, network.DirInbound, diagnostics.Direction)
+	assert.False(t, diagnostics.ConnectedAt.IsZero())
+	assert.Positive(t, diagnostics.Latency)
+	assert.Equal(t, receiver.GetPeerDistance(sender.host.ID()), diagnostics.Distance)
+	assert.Contains(t, diagnostics.Protocols, common.IdentityProto)
+	assert.Equal(t, []TopicMessages{{Topic: topicName, Count: 1}}, diagnostics.GossipMessages)
+
+	var identityBandwidth *ProtocolBandwidth
+
+	for i, bandwidth := range diagnostics.Bandwidth {
+		if bandwidth.Protocol == common.IdentityProto {
+			identityBandwidth = &diagnostics.Bandwidth[i]
+		}
+	}
+
+	require.NotNil(t, identityBandwidth)
+	assert.Positive(t, identityBandwidth.BytesIn+identityBandwidth.BytesOut)
+
+	// the sender dialed the receiver, and did not receive any gossip
+	all := sender.PeersDiagnostics(ctx)
+	require.Len(t, all, 1)
+	assert.Equal(t, receiver.host.ID(), all[0].ID)
+	assert.Equal(t, network.DirOutbound, all[0].Direction)
+	assert.Empty(t, all[0].GossipMessages)
+
+	// the public diagnostics leave out the private peers
+	public := receiver.PublicPeersDiagnostics()
+	require.Len(t, public, 1)
+	assert.Equal(t, sender.host.ID(), public[0].ID)
+
+	receiver.peerLists.private[sender.host.ID()] = struct{}{}
+
+	assert.Empty(t, receiver.PublicPeersDiagnostics())
+
+	// the diagnostics of the disconnected peers are not available
+	_, err = receiver.PeerDiagnostics(ctx, peer.ID("unknown"))
+	assert.ErrorIs(t, err, ErrPeerNotConnected)
+}
@@ -0,0 +1,427 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+)
+
+// peerListsFile is the name of the file in the networking data directory
+// the ban list and the trusted peers added at runtime are persisted to
+const peerListsFile = "peer_lists.json"
+
+var (
+	ErrInvalidBanTarget = errors.New("ban target is neither a peer ID, an IP address nor a CIDR range")
+	ErrBanTrustedPeer   = errors.New("trusted peers can not be banned")
+	ErrBanNotFound      = errors.New("ban not found")
+)
+
+// BanEntry is a single entry of the ban list
+type BanEntry struct {
+	// Target is the banned peer ID, IP address or CIDR range
+	Target string `json:"target"`
+	// Reason is the optional reason of the ban
+	Reason string `json:"reason,omitempty"`
+	// ExpiresAt is the unix time the ban expires at, zero for a permanent ban
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+// isExpired checks if the ban has expired at the given time
+func (b *BanEntry) isExpired(now time.Time) bool {
+	return b.ExpiresAt != 0 && now.Unix() >= b.ExpiresAt
+}
+
+// bannedSubnet is the parsed IP address or CIDR range ban
+type bannedSubnet struct {
+	subnet *net.IPNet
+	entry  *BanEntry
+}
+
+// peerListsJSON is the persisted form of the peer lists
+type peerListsJSON struct {
+	Bans         []*BanEntry `json:"bans"`
+	TrustedPeers []string    `json:"trustedPeers"`
+}
+
+// peerLists keeps track of the trusted and the banned peers.
+// Trusted peers are exempt from the connection limits and can never be banned.
+// The bans and the trusted peers added at runtime are persisted to the file, if set
+type peerLists struct {
+	sync.RWMutex
+
+	// path of the file the lists are persisted to, empty if kept in memory only
+	path string
+
+	// configTrusted holds the trusted peers set in the configuration, which are not persisted
+	configTrusted map[peer.ID]struct{}
+
+	// sentries holds the sentry nodes of the private validator.
+	// If set, the connections of any other peer are refused
+	sentries map[peer.ID]struct{}
+
+	// private holds the peers which are never advertised to other peers
+	private map[peer.ID]struct{}
+
+	// runtimeTrusted holds the trusted peers added at runtime
+	runtimeTrusted map[peer.ID]struct{}
+
+	bannedPeers   map[peer.ID]*BanEntry
+	bannedSubnets []*bannedSubnet
+
+	// now returns the current time, overridden in tests
+	now func() time.Time
+}
+
+// newPeerLists loads the persisted peer lists from the given directory, if set.
+// The persisted bans of the peers trusted since are dropped
+func newPeerLists(logger hclog.Logger, dataDir string, trustedPeers []peer.ID) (*peerLists, error) {
+	l := &peerLists{
+		configTrusted:  make(map[peer.ID]struct{}, len(trustedPeers)),
+		sentries:       make(map[peer.ID]struct{}),
+		private:        make(map[peer.ID]struct{}),
+		runtimeTrusted: make(map[peer.ID]struct{}),
+		bannedPeers:    make(map[peer.ID]*BanEntry),
+		now:            time.Now,
+	}
+
+	for _, id := range trustedPeers {
+		l.configTrusted[id] = struct{}{}
+	}
+
+	if dataDir == "" {
+		return l, nil
+	}
+
+	l.path = filepath.Join(dataDir, peerListsFile)
+
+	raw, err := os.ReadFile(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read peer lists: %w", err)
+	}
+
+	persisted := &peerListsJSON{}
+	if err := json.Unmarshal(raw, persisted); err != nil {
+		return nil, fmt.Errorf("invalid peer lists file %s: %w", l.path, err)
+	}
+
+	for _, rawID := range persisted.TrustedPeers {
+		id, err := peer.Decode(rawID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted peer %s in %s: %w", rawID, l.path, err)
+		}
+
+		l.runtimeTrusted[id] = struct{}{}
+	}
+
+	var (
+		now     = l.now()
+		dropped = false
+	)
+
+	for _, entry := range persisted.Bans {
+		if entry.isExpired(now) {
+			continue
+		}
+
+		if err := l.addBan(entry); errors.Is(err, ErrBanTrustedPeer) {
+			logger.Warn("dropping the ban of the trusted peer", "peer", entry.Target, "reason", entry.Reason)
+
+			dropped = true
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid ban in %s: %w", l.path, err)
+		}
+	}
+
+	if dropped {
+		if err := l.persist(); err != nil {
+			return nil, err
+		}
+	}
+
+	return l, nil
+}
+
+// isTrusted checks if the peer is trusted [Thread safe]
+func (l *peerLists) isTrusted(id peer.ID) bool {
+	l.RLock()
+	defer l.RUnlock()
+
+	return l.isTrustedLocked(id)
+}
+
+func (l *peerLists) isTrustedLocked(id peer.ID) bool {
+	if _, ok := l.configTrusted[id]; ok {
+		return true
+	}
+
+	_, ok := l.runtimeTrusted[id]
+
+	return ok
+}
+
+// isAllowed checks if the peer is allowed to connect,
+// which is always the case unless the node is a private validator
+// that connects to its sentry nodes only
+func (l *peerLists) isAllowed(id peer.ID) bool {
+	if len(l.sentries) == 0 {
+		return true
+	}
+
+	_, ok := l.sentries[id]
+
+	return ok
+}
+
+// isPrivate checks if the peer must not be advertised to other peers
+func (l *peerLists) isPrivate(id peer.ID) bool {
+	_, ok := l.private[id]
+
+	return ok
+}
+
+// trust marks the peer as trusted and lifts its bans, if any [Thread safe]
+func (l *peerLists) trust(id peer.ID) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.isTrustedLocked(id) {
+		return nil
+	}
+
+	l.runtimeTrusted[id] = struct{}{}
+	delete(l.bannedPeers, id)
+
+	return l.persist()
+}
+
+// isPeerBanned checks if the peer ID is banned [Thread safe]
+func (l *peerLists) isPeerBanned(id peer.ID) bool {
+	l.RLock()
+	defer l.RUnlock()
+
+	if l.isTrustedLocked(id) {
+		return false
+	}
+
+	entry, ok := l.bannedPeers[id]
+
+	return ok && !entry.isExpired(l.now())
+}
+
+// isAddrBanned checks if the IP address of the multiaddr is banned for the peer [Thread safe]
+func (l *peerLists) isAddrBanned(id peer.ID, addr multiaddr.Multiaddr) bool {
+	if addr == nil {
+		return false
+	}
+
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		// not an IP based address
+		return false
+	}
+
+	l.RLock()
+	defer l.RUnlock()
+
+	if id != "" && l.isTrustedLocked(id) {
+		return false
+	}
+
+	now := l.now()
+
+	for _, banned := range l.bannedSubnets {
+		if banned.subnet.Contains(ip) && !banned.entry.isExpired(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ban adds the peer ID, IP address or CIDR range to the ban list.
+// A zero duration bans the target permanently [Thread safe]
+func (l *peerLists) ban(target string, duration time.Duration, reason string) (*BanEntry, error) {
+	entry := &BanEntry{
+		Target: target,
+		Reason: reason,
+	}
+
+	if duration > 0 {
+		entry.ExpiresAt = l.now().Add(duration).Unix()
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	// replace the previous ban of the same target, if any
+	l.removeBan(target)
+
+	if err := l.addBan(entry); err != nil {
+		return nil, err
+	}
+
+	if err := l.persist(); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// unban removes the target from the ban list [Thread safe]
+func (l *peerLists) unban(target string) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if !l.removeBan(target) {
+		return fmt.Errorf("%w: %s", ErrBanNotFound, target)
+	}
+
+	return l.persist()
+}
+
+// bans returns the active bans [Thread safe]
+func (l *peerLists) bans() []*BanEntry {
+	l.RLock()
+	defer l.RUnlock()
+
+	return l.activeBans()
+}
+
+// addBan parses the ban target and adds the entry to the ban list
+func (l *peerLists) addBan(entry *BanEntry) error {
+	if id, err := peer.Decode(entry.Target); err == nil {
+		if l.isTrustedLocked(id) {
+			return ErrBanTrustedPeer
+		}
+
+		l.bannedPeers[id] = entry
+
+		return nil
+	}
+
+	subnet, err := parseSubnet(entry.Target)
+	if err != nil {
+		return err
+	}
+
+	l.bannedSubnets = append(l.bannedSubnets, &bannedSubnet{subnet: subnet, entry: entry})
+
+	return nil
+}
+
+// removeBan removes the ban of the target and returns true if it was present
+func (l *peerLists) removeBan(target string) bool {
+	if id, err := peer.Decode(target); err == nil {
+		_, ok := l.bannedPeers[id]
+		delete(l.bannedPeers, id)
+
+		return ok
+	}
+
+	subnet, err := parseSubnet(target)
+	if err != nil {
+		return false
+	}
+
+	for i, banned := range l.bannedSubnets {
+		if banned.subnet.String() == subnet.String() {
+			l.bannedSubnets = append(l.bannedSubnets[:i], l.bannedSubnets[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// activeBans returns the bans which have not expired, ordered by target
+func (l *peerLists) activeBans() []*BanEntry {
+	now := l.now()
+	bans := make([]*BanEntry, 0, len(l.bannedPeers)+len(l.bannedSubnets))
+
+	for _, entry := range l.bannedPeers {
+		if !entry.isExpired(now) {
+			bans = append(bans, entry)
+		}
+	}
+
+	for _, banned := range l.bannedSubnets {
+		if !banned.entry.isExpired(now) {
+			bans = append(bans, banned.entry)
+		}
+	}
+
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Target < bans[j].Target
+	})
+
+	return bans
+}
+
+// persist writes the active bans and the runtime trusted peers to the file
+func (l *peerLists) persist() error {
+	if l.path == "" {
+		return nil
+	}
+
+	persisted := &peerListsJSON{
+		Bans:         l.activeBans(),
+		TrustedPeers: make([]string, 0, len(l.runtimeTrusted)),
+	}
+
+	for id := range l.runtimeTrusted {
+		persisted.TrustedPeers = append(persisted.TrustedPeers, id.String())
+	}
+
+	sort.Strings(persisted.TrustedPeers)
+
+	raw, err := json.MarshalIndent(persisted, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write the lists atomically, a partially written file would lose the bans
+	tmpPath := l.path + ".tmp"
+
+	if err := os.WriteFile(tmpPath, raw, 0600); err != nil {
+		return fmt.Errorf("unable to write peer lists: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, l.path); err != nil {
+		return fmt.Errorf("unable to write peer lists: %w", err)
+	}
+
+	return nil
+}
+
+// parseSubnet parses the IP address or the CIDR range
+func parseSubnet(raw string) (*net.IPNet, error) {
+	if _, subnet, err := net.ParseCIDR(raw); err == nil {
+		return subnet, nil
+	}
+
+	ip := net.ParseIP(raw)
+	if ip == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBanTarget, raw)
+	}
+
+	bits := 8 * net.IPv6len
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 8*net.IPv4len
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
@@ -0,0 +1,173 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPeerID(t *testing.T) peer.ID {
+	t.Helper()
+
+	key, _, err := GenerateAndEncodeLibp2pKey()
+	require.NoError(t, err)
+
+	id, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+
+	return id
+}
+
+func TestPeerLists_Ban(t *testing.T) {
+	t.Parallel()
+
+	var (
+		bannedPeer  = newTestPeerID(t)
+		trustedPeer = newTestPeerID(t)
+		otherPeer   = newTestPeerID(t)
+
+		bannedAddr = multiaddr.StringCast("/ip4/10.0.0.1/tcp/1478")
+		otherAddr  = multiaddr.StringCast("/ip4/192.168.0.1/tcp/1478")
+	)
+
+	lists, err := newPeerLists(hclog.NewNullLogger(), "", []peer.ID{trustedPeer})
+	require.NoError(t, err)
+
+	_, err = lists.ban(bannedPeer.String(), 0, "misbehaving")
+	require.NoError(t, err)
+
+	_, err = lists.ban("10.0.0.0/8", 0, "")
+	require.NoError(t, err)
+
+	assert.True(t, lists.isPeerBanned(bannedPeer))
+	assert.False(t, lists.isPeerBanned(otherPeer))
+
+	assert.True(t, lists.isAddrBanned(otherPeer, bannedAddr))
+	assert.False(t, lists.isAddrBanned(otherPeer, otherAddr))
+
+	// trusted peers can connect from the banned ranges, but can not be banned
+	assert.False(t, lists.isAddrBanned(trustedPeer, bannedAddr))
+
+	_, err = lists.ban(trustedPeer.String(), 0, "")
+	require.ErrorIs(t, err, ErrBanTrustedPeer)
+
+	_, err = lists.ban("not a target", 0, "")
+	require.ErrorIs(t, err, ErrInvalidBanTarget)
+
+	require.Len(t, lists.bans(), 2)
+
+	// unban
+	require.NoError(t, lists.unban("10.0.0.0/8"))
+	assert.False(t, lists.isAddrBanned(otherPeer, bannedAddr))
+
+	require.ErrorIs(t, lists.unban("10.0.0.0/8"), ErrBanNotFound)
+
+	// trusting the peer lifts its ban
+	require.NoError(t, lists.trust(bannedPeer))
+	assert.False(t, lists.isPeerBanned(bannedPeer))
+	assert.Empty(t, lists.bans())
+}
+
+func TestPeerLists_Expiry(t *testing.T) {
+	t.Parallel()
+
+	bannedPeer := newTestPeerID(t)
+	now := time.Unix(1_000_000, 0)
+
+	lists, err := newPeerLists(hclog.NewNullLogger(), "", nil)
+	require.NoError(t, err)
+
+	lists.now = func() time.Time { return now }
+
+	entry, err := lists.ban(bannedPeer.String(), time.Hour, "")
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Hour).Unix(), entry.ExpiresAt)
+
+	_, err = lists.ban("1.2.3.4", time.Hour, "")
+	require.NoError(t, err)
+
+	assert.True(t, lists.isPeerBanned(bannedPeer))
+	assert.True(t, lists.isAddrBanned("", multiaddr.StringCast("/ip4/1.2.3.4/udp/1478")))
+
+	now = now.Add(time.Hour)
+
+	assert.False(t, lists.isPeerBanned(bannedPeer))
+	assert.False(t, lists.isAddrBanned("", multiaddr.StringCast("/ip4/1.2.3.4/udp/1478")))
+	assert.Empty(t, lists.bans())
+}
+
+func TestPeerLists_Persistence(t *testing.T) {
+	t.Parallel()
+
+	var (
+		dataDir = t.TempDir()
+
+		bannedPeer  = newTestPeerID(t)
+		expiredPeer = newTestPeerID(t)
+		trustedPeer = newTestPeerID(t)
+	)
+
+	lists, err := newPeerLists(hclog.NewNullLogger(), dataDir, nil)
+	require.NoError(t, err)
+
+	_, err = lists.ban(bannedPeer.String(), 0, "misbehaving")
+	require.NoError(t, err)
+
+	_, err = lists.ban("2001:db8::/32", 0, "")
+	require.NoError(t, err)
+
+	// the ban expires before the lists are reloaded
+	_, err = lists.ban(expiredPeer.String(), time.Nanosecond, "")
+	require.NoError(t, err)
+
+	require.NoError(t, lists.trust(trustedPeer))
+
+	reloaded, err := newPeerLists(hclog.NewNullLogger(), dataDir, nil)
+	require.NoError(t, err)
+
+	assert.True(t, reloaded.isPeerBanned(bannedPeer))
+	assert.True(t, reloaded.isAddrBanned("", multiaddr.StringCast("/ip6/2001:db8::1/tcp/1478")))
+	assert.False(t, reloaded.isPeerBanned(expiredPeer))
+	assert.True(t, reloaded.isTrusted(trustedPeer))
+
+	bans := reloaded.bans()
+	require.Len(t, bans, 2)
+
+	for _, ban := range bans {
+		if ban.Target == bannedPeer.String() {
+			assert.Equal(t, "misbehaving", ban.Reason)
+		}
+	}
+}
+
+func TestPeerLists_PersistedBanOfTrustedPeer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		dataDir    = t.TempDir()
+		bannedPeer = newTestPeerID(t)
+	)
+
+	lists, err := newPeerLists(hclog.NewNullLogger(), dataDir, nil)
+	require.NoError(t, err)
+
+	_, err = lists.ban(bannedPeer.String(), 0, "")
+	require.NoError(t, err)
+
+	// the peer trusted in the configuration since is not banned, and the ban is dropped
+	reloaded, err := newPeerLists(hclog.NewNullLogger(), dataDir, []peer.ID{bannedPeer})
+	require.NoError(t, err)
+
+	assert.False(t, reloaded.isPeerBanned(bannedPeer))
+	assert.Empty(t, reloaded.bans())
+
+	reloaded, err = newPeerLists(hclog.NewNullLogger(), dataDir, nil)
+	require.NoError(t, err)
+
+	assert.False(t, reloaded.isPeerBanned(bannedPeer))
+}
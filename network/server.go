@@ -12,7 +12,6 @@ import (
 	"github.com/0xPolygon/polygon-edge/network/discovery"
 	"github.com/armon/go-metrics"
 	"github.com/libp2p/go-libp2p"
-	"github.com/libp2p/go-libp2p/p2p/security/noise"
 	rawGrpc "google.golang.org/grpc"
 
 	peerEvent "github.com/0xPolygon/polygon-edge/network/event"
@@ -26,6 +25,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 )
 
 const (
@@ -88,42 +88,83 @@ type Server struct {
 	temporaryDials sync.Map // map of temporary connections; peerID -> bool
 
 	bootnodes *bootnodesWrapper // reference of all bootnodes for the node
+
+	staticPeers []*peer.AddrInfo // peers which are always kept connected
+	peerLists   *peerLists       // trusted and banned peers
+
+	peerScores     map[peer.ID]float64 // the latest gossip peer scores
+	peerScoresLock sync.RWMutex
+
+	bandwidth    *bandwidthCounter // bytes exchanged per peer and protocol
+	gossipCounts *gossipCounter    // gossip messages received per peer and topic
 }
 
 // NewServer returns a new instance of the networking server
 func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 	logger = logger.Named("network")
 
+	// a private validator connects to its sentry nodes only,
+	// so it must not discover nor be discovered by any other peer
+	if len(config.SentryNodes) > 0 && !config.NoDiscover {
+		logger.Info("Sentry nodes set, turning off the peer discovery")
+
+		config.NoDiscover = true
+	}
+
 	key, err := setupLibp2pKey(config.SecretsManager)
 	if err != nil {
 		return nil, err
 	}
 
-	listenAddr, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", config.Addr.IP.String(), config.Addr.Port))
+	listenAddrs, err := listenMultiaddrs(config)
 	if err != nil {
 		return nil, err
 	}
 
-	addrsFactory := func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
-		if config.NatAddr != nil {
-			addr, _ := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", config.NatAddr.String(), config.Addr.Port))
+	transportOpts, err := transportOptions(config, listenAddrs)
+	if err != nil {
+		return nil, err
+	}
 
-			if addr != nil {
-				addrs = []multiaddr.Multiaddr{addr}
-			}
-		} else if config.DNS != nil {
-			addrs = []multiaddr.Multiaddr{config.DNS}
+	// the advertised host is replaced for all the listen addresses, keeping their transports
+	var advertisedHost multiaddr.Multiaddr
+
+	if config.NatAddr != nil {
+		advertisedHost, err = manet.FromIP(config.NatAddr)
+	} else if config.DNS != nil {
+		advertisedHost, err = hostComponent(config.DNS)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	addrsFactory := func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+		if advertisedHost != nil {
+			return replaceHost(addrs, advertisedHost)
 		}
 
 		return addrs
 	}
 
+	staticPeers, peerLists, err := setupPeerLists(logger, config)
+	if err != nil {
+		return nil, err
+	}
+
+	bandwidth := newBandwidthCounter()
+
 	host, err := libp2p.New(
-		// Use noise as the encryption protocol
-		libp2p.Security(noise.ID, noise.New),
-		libp2p.ListenAddrs(listenAddr),
-		libp2p.AddrsFactory(addrsFactory),
-		libp2p.Identity(key),
+		append(
+			transportOpts,
+			libp2p.ListenAddrs(listenAddrs...),
+			libp2p.AddrsFactory(addrsFactory),
+			libp2p.Identity(key),
+			// Refuse the connections of the banned peers,
+			// and of all the peers but the sentry nodes of a private validator
+			libp2p.ConnectionGater(&banListGater{lists: peerLists}),
+			libp2p.BandwidthReporter(bandwidth),
+		)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create libp2p stack: %w", err)
@@ -154,6 +195,11 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 			config.MaxInboundPeers,
 			config.MaxOutboundPeers,
 		),
+		staticPeers:  staticPeers,
+		peerLists:    peerLists,
+		peerScores:   make(map[peer.ID]float64),
+		bandwidth:    bandwidth,
+		gossipCounts: newGossipCounter(),
 	}
 
 	// start gossip protocol
@@ -161,6 +207,9 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		context.Background(),
 		host, pubsub.WithPeerOutboundQueueSize(peerOutboundBufferSize),
 		pubsub.WithValidateQueueSize(validateBufferSize),
+		// penalize the peers sending invalid messages, the topics are scored when joined
+		pubsub.WithPeerScore(peerScoreParams(), peerScoreThresholds()),
+		pubsub.WithPeerScoreInspect(pubsub.PeerScoreInspectFn(srv.updatePeerScores), peerScoreInspectInterval),
 	)
 	if err != nil {
 		return nil, err
@@ -182,6 +231,10 @@ type PeerConnInfo struct {
 
 	connDirections  map[network.Direction]bool
 	protocolStreams map[string]*rawGrpc.ClientConn
+
+	// exempt is set if the peer was trusted when connected,
+	// in which case the connection is not counted towards the limits
+	exempt bool
 }
 
 // addProtocolStream adds a protocol stream
@@ -268,6 +321,7 @@ func (s *Server) Start() error {
 
 	go s.runDial()
 	go s.keepAliveMinimumPeerConnections()
+	go s.keepStaticPeersConnected()
 
 	// watch for disconnected peers
 	s.host.Network().Notify(&network.NotifyBundle{
@@ -472,6 +526,9 @@ func (s *Server) GetProtocols(peerID peer.ID) ([]string, error) {
 func (s *Server) removePeer(peerID peer.ID) {
 	s.logger.Info("Peer disconnected", "id", peerID)
 
+	s.bandwidth.removePeer(peerID)
+	s.gossipCounts.removePeer(peerID)
+
 	// Remove the peer from the peers map
 	connectionInfo := s.removePeerInfo(peerID)
 	if connectionInfo == nil {
@@ -507,8 +564,11 @@ func (s *Server) removePeerInfo(peerID peer.ID) *PeerConnInfo {
 	// Update connection counters
 	for connDirection, active := range connectionInfo.connDirections {
 		if active {
-			s.connectionCounts.UpdateConnCountByDirection(-1, connDirection)
-			s.updateConnCountMetrics(connDirection)
+			if !connectionInfo.exempt {
+				s.connectionCounts.UpdateConnCountByDirection(-1, connDirection)
+				s.updateConnCountMetrics(connDirection)
+			}
+
 			s.updateBootnodeConnCount(peerID, -1)
 		}
 	}
@@ -542,6 +602,23 @@ func (s *Server) DisconnectFromPeer(peer peer.ID, reason string) {
 	}
 }
 
+// DropPeer disconnects the peer with the given ID.
+// The peer is free to connect again, unless it is banned
+func (s *Server) DropPeer(rawPeerID string, reason string) error {
+	peerID, err := peer.Decode(rawPeerID)
+	if err != nil {
+		return err
+	}
+
+	if s.host.Network().Connectedness(peerID) != network.Connected {
+		return ErrPeerNotConnected
+	}
+
+	s.DisconnectFromPeer(peerID, reason)
+
+	return nil
+}
+
 var (
 	// Anything below 35s is prone to false timeouts, as seen from empirical test data
 	DefaultJoinTimeout   = 100 * time.Second
@@ -66,6 +66,7 @@ func (s *Server) addPeerInfo(id peer.ID, direction network.Direction) bool {
 			Info:            s.host.Peerstore().PeerInfo(id),
 			connDirections:  make(map[network.Direction]bool),
 			protocolStreams: make(map[string]*rawGrpc.ClientConn),
+			exempt:          s.peerLists.isTrusted(id),
 		}
 	}
 
@@ -74,9 +75,12 @@ func (s *Server) addPeerInfo(id peer.ID, direction network.Direction) bool {
 
 	s.peers[id] = connectionInfo
 
-	// Update connection counters
-	s.connectionCounts.UpdateConnCountByDirection(1, direction)
-	s.updateConnCountMetrics(direction)
+	// Update connection counters, the trusted peers are not counted towards the limits
+	if !connectionInfo.exempt {
+		s.connectionCounts.UpdateConnCountByDirection(1, direction)
+		s.updateConnCountMetrics(direction)
+	}
+
 	s.updateBootnodeConnCount(id, 1)
 
 	// Update the metric stats
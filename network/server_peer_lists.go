@@ -0,0 +1,200 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+)
+
+// staticPeersRedialInterval is the interval the disconnected static peers are redialed at
+const staticPeersRedialInterval = 10 * time.Second
+
+// setupPeerLists parses the static, the trusted, the sentry and the private peers
+// from the config and loads the persisted ban list
+func setupPeerLists(logger hclog.Logger, config *Config) ([]*peer.AddrInfo, *peerLists, error) {
+	var (
+		staticPeers  = make([]*peer.AddrInfo, 0, len(config.StaticPeers)+len(config.SentryNodes))
+		trustedPeers = make([]peer.ID, 0, len(config.StaticPeers)+len(config.SentryNodes)+
+			len(config.TrustedPeers)+len(config.PrivatePeers))
+		sentries     = make([]peer.ID, 0, len(config.SentryNodes))
+		privatePeers = make([]peer.ID, 0, len(config.PrivatePeers))
+	)
+
+	for _, rawAddr := range config.StaticPeers {
+		staticPeer, err := common.StringToAddrInfo(rawAddr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse static peer %s: %w", rawAddr, err)
+		}
+
+		staticPeers = append(staticPeers, staticPeer)
+		trustedPeers = append(trustedPeers, staticPeer.ID)
+	}
+
+	// the sentry nodes are kept connected the same way as the static peers
+	for _, rawAddr := range config.SentryNodes {
+		sentry, err := common.StringToAddrInfo(rawAddr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse sentry node %s: %w", rawAddr, err)
+		}
+
+		staticPeers = append(staticPeers, sentry)
+		trustedPeers = append(trustedPeers, sentry.ID)
+		sentries = append(sentries, sentry.ID)
+	}
+
+	for _, rawID := range config.TrustedPeers {
+		id, err := peer.Decode(rawID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse trusted peer %s: %w", rawID, err)
+		}
+
+		trustedPeers = append(trustedPeers, id)
+	}
+
+	// the private peers are the validators behind the sentry,
+	// which are always accepted regardless of the connection limits
+	for _, rawID := range config.PrivatePeers {
+		id, err := peer.Decode(rawID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse private peer %s: %w", rawID, err)
+		}
+
+		trustedPeers = append(trustedPeers, id)
+		privatePeers = append(privatePeers, id)
+	}
+
+	lists, err := newPeerLists(logger, config.DataDir, trustedPeers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, id := range sentries {
+		lists.sentries[id] = struct{}{}
+	}
+
+	for _, id := range privatePeers {
+		lists.private[id] = struct{}{}
+	}
+
+	return staticPeers, lists, nil
+}
+
+// IsTrustedPeer checks if the peer is a static or a trusted peer,
+// which is exempt from the connection limits [Thread safe]
+func (s *Server) IsTrustedPeer(peerID peer.ID) bool {
+	return s.peerLists.isTrusted(peerID)
+}
+
+// IsPrivatePeer checks if the peer must never be advertised to other peers
+func (s *Server) IsPrivatePeer(peerID peer.ID) bool {
+	return s.peerLists.isPrivate(peerID)
+}
+
+// TrustPeer marks the peer as trusted, lifting its ban if any.
+// The peer is kept trusted over the restarts
+func (s *Server) TrustPeer(rawPeerID string) error {
+	peerID, err := peer.Decode(rawPeerID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.peerLists.trust(peerID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Peer trusted", "id", peerID)
+
+	return nil
+}
+
+// BanPeer bans the peer ID, IP address or CIDR range for the given duration,
+// or permanently if the duration is zero, and disconnects the matching peers
+func (s *Server) BanPeer(target string, duration time.Duration, reason string) (*BanEntry, error) {
+	entry, err := s.peerLists.ban(target, duration, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Peer banned", "target", target, "duration", duration, "reason", reason)
+
+	for _, peerID := range s.host.Network().Peers() {
+		if s.isBannedConnection(peerID) {
+			s.DisconnectFromPeer(peerID, "banned")
+		}
+	}
+
+	return entry, nil
+}
+
+// UnbanPeer removes the peer ID, IP address or CIDR range from the ban list
+func (s *Server) UnbanPeer(target string) error {
+	if err := s.peerLists.unban(target); err != nil {
+		return err
+	}
+
+	s.logger.Info("Peer unbanned", "target", target)
+
+	return nil
+}
+
+// BannedPeers returns the active bans
+func (s *Server) BannedPeers() []*BanEntry {
+	return s.peerLists.bans()
+}
+
+// isBannedConnection checks if the peer or any of its connection addresses is banned
+func (s *Server) isBannedConnection(peerID peer.ID) bool {
+	if s.peerLists.isPeerBanned(peerID) {
+		return true
+	}
+
+	for _, conn := range s.host.Network().ConnsToPeer(peerID) {
+		if s.peerLists.isAddrBanned(peerID, conn.RemoteMultiaddr()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keepStaticPeersConnected dials the static peers and redials them when disconnected.
+// Static peers are dialed outside of the dial queue, so they do not take the outbound slots
+func (s *Server) keepStaticPeersConnected() {
+	if len(s.staticPeers) == 0 {
+		return
+	}
+
+	for _, staticPeer := range s.staticPeers {
+		s.host.Peerstore().AddAddrs(staticPeer.ID, staticPeer.Addrs, peerstore.PermanentAddrTTL)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		for _, staticPeer := range s.staticPeers {
+			if s.IsConnected(staticPeer.ID) {
+				continue
+			}
+
+			go func(peerInfo peer.AddrInfo) {
+				s.logger.Debug("Dialing static peer", "addr", peerInfo)
+
+				if err := s.host.Connect(ctx, peerInfo); err != nil {
+					s.logger.Debug("failed to dial static peer", "addr", peerInfo, "err", err.Error())
+				}
+			}(*staticPeer)
+		}
+
+		select {
+		case <-time.After(staticPeersRedialInterval):
+		case <-s.closeCh:
+			return
+		}
+	}
+}
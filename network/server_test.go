@@ -18,6 +18,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConnLimit_Inbound(t *testing.T) {
@@ -1158,3 +1159,180 @@ func generateRandomPeers(t *testing.T, count int) ([]*randomPeer, error) {
 
 	return randomPeers, nil
 }
+
+func TestConnLimit_TrustedPeer(t *testing.T) {
+	// trusted peers can connect even if the connection limits are reached
+	defaultConfig := &CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.MaxInboundPeers = 1
+			c.MaxOutboundPeers = 1
+			c.NoDiscover = true
+		},
+	}
+
+	servers, createErr := createServers(3, map[int]*CreateServerParams{
+		0: defaultConfig,
+		1: defaultConfig,
+		2: defaultConfig,
+	})
+	require.NoError(t, createErr)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	require.NoError(t, servers[1].TrustPeer(servers[2].host.ID().String()))
+
+	// Server 0 takes the only inbound slot of Server 1
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	// Server 2 is trusted, so it connects regardless of the limit
+	require.NoError(t, JoinAndWait(servers[2], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	// the trusted connection is not counted towards the limits
+	assert.Equal(t, int64(1), servers[1].connectionCounts.GetInboundConnCount())
+	assert.Len(t, servers[1].Peers(), 2)
+}
+
+func TestStaticPeers(t *testing.T) {
+	staticPeer, err := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+		},
+	})
+	require.NoError(t, err)
+
+	staticPeerAddr, err := common.AddrInfoToString(staticPeer.AddrInfo())
+	require.NoError(t, err)
+
+	server, err := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+			c.MaxOutboundPeers = 0
+			c.StaticPeers = []string{staticPeerAddr}
+		},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		closeTestServers(t, []*Server{server, staticPeer})
+	})
+
+	assert.True(t, server.IsTrustedPeer(staticPeer.host.ID()))
+
+	// the static peer is dialed even though there are no outbound slots
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer cancelWait()
+
+	_, err = WaitUntilPeerConnectsTo(waitCtx, server, staticPeer.host.ID())
+	require.NoError(t, err)
+}
+
+func TestBanPeer(t *testing.T) {
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {ConfigCallback: func(c *Config) { c.NoDiscover = true }},
+		1: {ConfigCallback: func(c *Config) { c.NoDiscover = true }},
+	})
+	require.NoError(t, createErr)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	// banning the peer disconnects it
+	_, err := servers[1].BanPeer(servers[0].host.ID().String(), 0, "misbehaving")
+	require.NoError(t, err)
+
+	disconnectCtx, disconnectFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer disconnectFn()
+
+	_, err = WaitUntilPeerDisconnectsFrom(disconnectCtx, servers[1], servers[0].host.ID())
+	require.NoError(t, err)
+
+	_, err = WaitUntilPeerDisconnectsFrom(disconnectCtx, servers[0], servers[1].host.ID())
+	require.NoError(t, err)
+
+	// the banned peer can not reconnect
+	smallTimeout := time.Second * 5
+	require.Error(t, JoinAndWait(servers[0], servers[1], smallTimeout, smallTimeout))
+
+	// the unbanned peer connects again
+	require.NoError(t, servers[1].UnbanPeer(servers[0].host.ID().String()))
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+}
+
+func TestDropPeer(t *testing.T) {
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {ConfigCallback: func(c *Config) { c.NoDiscover = true }},
+		1: {ConfigCallback: func(c *Config) { c.NoDiscover = true }},
+	})
+	require.NoError(t, createErr)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	require.NoError(t, servers[1].DropPeer(servers[0].host.ID().String(), "dropped"))
+
+	disconnectCtx, disconnectFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer disconnectFn()
+
+	_, err := WaitUntilPeerDisconnectsFrom(disconnectCtx, servers[1], servers[0].host.ID())
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, servers[1].DropPeer(servers[0].host.ID().String(), "dropped"), ErrPeerNotConnected)
+	assert.Error(t, servers[1].DropPeer("invalid", "dropped"))
+
+	// the dropped peer can connect again
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+}
+
+func TestSentryNodes(t *testing.T) {
+	sentry, err := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+		},
+	})
+	require.NoError(t, err)
+
+	other, err := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+		},
+	})
+	require.NoError(t, err)
+
+	sentryAddr, err := common.AddrInfoToString(sentry.AddrInfo())
+	require.NoError(t, err)
+
+	validator, err := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.SentryNodes = []string{sentryAddr}
+		},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		closeTestServers(t, []*Server{validator, sentry, other})
+	})
+
+	// the discovery is turned off for the private validator
+	assert.True(t, validator.config.NoDiscover)
+	assert.True(t, validator.IsTrustedPeer(sentry.host.ID()))
+
+	// the validator connects to its sentry node
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer cancelWait()
+
+	_, err = WaitUntilPeerConnectsTo(waitCtx, validator, sentry.host.ID())
+	require.NoError(t, err)
+
+	// any other peer is refused
+	smallTimeout := time.Second * 5
+	require.Error(t, JoinAndWait(other, validator, smallTimeout, smallTimeout))
+	require.Error(t, JoinAndWait(validator, other, smallTimeout, smallTimeout))
+}
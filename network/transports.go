@@ -0,0 +1,135 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	libp2ptls "github.com/libp2p/go-libp2p/p2p/security/tls"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// SecurityNoise secures the TCP connections with the noise protocol
+	SecurityNoise = "noise"
+	// SecurityTLS secures the TCP connections with TLS 1.3
+	SecurityTLS = "tls"
+)
+
+var (
+	ErrInvalidSecurity = fmt.Errorf("invalid security protocol, expected %s or %s", SecurityNoise, SecurityTLS)
+	ErrNoHostComponent = errors.New("multiaddr has no host component")
+)
+
+// listenMultiaddrs returns the multiaddrs the host listens on: the base TCP address,
+// the QUIC address at the same port if enabled, and the additional listen addresses
+func listenMultiaddrs(config *Config) ([]multiaddr.Multiaddr, error) {
+	tcpAddr, err := multiaddr.NewMultiaddr(
+		fmt.Sprintf("/ip4/%s/tcp/%d", config.Addr.IP.String(), config.Addr.Port),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := []multiaddr.Multiaddr{tcpAddr}
+
+	if config.QUIC {
+		quicAddr, err := multiaddr.NewMultiaddr(
+			fmt.Sprintf("/ip4/%s/udp/%d/quic-v1", config.Addr.IP.String(), config.Addr.Port),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		addrs = append(addrs, quicAddr)
+	}
+
+	return append(addrs, config.ListenAddrs...), nil
+}
+
+// transportOptions returns the libp2p transport and security options.
+// TCP is always enabled, while QUIC is enabled only if listened on,
+// so the nodes without QUIC do not attempt dialing it.
+// Both noise and TLS are supported for TCP, with the configured one preferred,
+// so the nodes with different preferences can still connect to each other
+func transportOptions(config *Config, listenAddrs []multiaddr.Multiaddr) ([]libp2p.Option, error) {
+	opts := []libp2p.Option{
+		libp2p.Transport(tcp.NewTCPTransport),
+	}
+
+	if hasQUICAddr(listenAddrs) {
+		opts = append(opts, libp2p.Transport(libp2pquic.NewTransport))
+	}
+
+	switch config.Security {
+	case SecurityNoise, "":
+		opts = append(opts,
+			libp2p.Security(noise.ID, noise.New),
+			libp2p.Security(libp2ptls.ID, libp2ptls.New),
+		)
+	case SecurityTLS:
+		opts = append(opts,
+			libp2p.Security(libp2ptls.ID, libp2ptls.New),
+			libp2p.Security(noise.ID, noise.New),
+		)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSecurity, config.Security)
+	}
+
+	return opts, nil
+}
+
+// hasQUICAddr checks if any of the multiaddrs is a QUIC one
+func hasQUICAddr(addrs []multiaddr.Multiaddr) bool {
+	for _, addr := range addrs {
+		if _, err := addr.ValueForProtocol(multiaddr.P_QUIC_V1); err == nil {
+			return true
+		}
+
+		if _, err := addr.ValueForProtocol(multiaddr.P_QUIC); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// replaceHost replaces the host component (IP address or DNS name) of the multiaddrs,
+// keeping the transport parts, and drops the duplicates
+func replaceHost(addrs []multiaddr.Multiaddr, host multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	var (
+		result = make([]multiaddr.Multiaddr, 0, len(addrs))
+		seen   = make(map[string]struct{}, len(addrs))
+	)
+
+	for _, addr := range addrs {
+		_, rest := multiaddr.SplitFirst(addr)
+		if rest == nil {
+			continue
+		}
+
+		replaced := host.Encapsulate(rest)
+		if _, ok := seen[replaced.String()]; ok {
+			continue
+		}
+
+		seen[replaced.String()] = struct{}{}
+
+		result = append(result, replaced)
+	}
+
+	return result
+}
+
+// hostComponent returns the host component of the multiaddr
+func hostComponent(addr multiaddr.Multiaddr) (multiaddr.Multiaddr, error) {
+	host, _ := multiaddr.SplitFirst(addr)
+	if host == nil {
+		return nil, ErrNoHostComponent
+	}
+
+	return host, nil
+}
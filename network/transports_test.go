@@ -0,0 +1,132 @@
+package network
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	libp2ptls "github.com/libp2p/go-libp2p/p2p/security/tls"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransports_ListenMultiaddrs(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig()
+	config.Addr.Port = 1478
+	config.QUIC = true
+	config.ListenAddrs = []multiaddr.Multiaddr{multiaddr.StringCast("/ip4/0.0.0.0/tcp/1479")}
+
+	addrs, err := listenMultiaddrs(config)
+	require.NoError(t, err)
+
+	assert.Equal(t, []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/127.0.0.1/tcp/1478"),
+		multiaddr.StringCast("/ip4/127.0.0.1/udp/1478/quic-v1"),
+		multiaddr.StringCast("/ip4/0.0.0.0/tcp/1479"),
+	}, addrs)
+	assert.True(t, hasQUICAddr(addrs))
+	assert.False(t, hasQUICAddr(addrs[:1]))
+
+	config.Security = "plaintext"
+
+	_, err = transportOptions(config, addrs)
+	require.ErrorIs(t, err, ErrInvalidSecurity)
+}
+
+func TestTransports_ReplaceHost(t *testing.T) {
+	t.Parallel()
+
+	addrs := []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/127.0.0.1/tcp/1478"),
+		multiaddr.StringCast("/ip4/10.0.0.1/tcp/1478"),
+		multiaddr.StringCast("/ip4/127.0.0.1/udp/1478/quic-v1"),
+	}
+
+	host, err := hostComponent(multiaddr.StringCast("/dns/example.com/tcp/1478"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []multiaddr.Multiaddr{
+		multiaddr.StringCast("/dns/example.com/tcp/1478"),
+		multiaddr.StringCast("/dns/example.com/udp/1478/quic-v1"),
+	}, replaceHost(addrs, host))
+}
+
+func TestTransports_QUIC(t *testing.T) {
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+			c.QUIC = true
+		}},
+		1: {ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+			c.QUIC = true
+			c.NatAddr = net.ParseIP("127.0.0.1")
+		}},
+	})
+	require.NoError(t, createErr)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	// the NAT address is advertised for both of the transports
+	assert.True(t, hasQUICAddr(servers[1].host.Addrs()))
+	assert.Len(t, servers[1].host.Addrs(), 2)
+
+	// dial the QUIC address only
+	var quicAddrs []multiaddr.Multiaddr
+
+	for _, addr := range servers[1].host.Addrs() {
+		if hasQUICAddr([]multiaddr.Multiaddr{addr}) {
+			quicAddrs = append(quicAddrs, addr)
+		}
+	}
+
+	servers[0].joinPeer(&peer.AddrInfo{ID: servers[1].host.ID(), Addrs: quicAddrs})
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer cancel()
+
+	_, err := WaitUntilPeerConnectsTo(ctx, servers[0], servers[1].host.ID())
+	require.NoError(t, err)
+
+	conns := servers[0].host.Network().ConnsToPeer(servers[1].host.ID())
+	require.NotEmpty(t, conns)
+	assert.True(t, hasQUICAddr([]multiaddr.Multiaddr{conns[0].RemoteMultiaddr()}))
+}
+
+func TestTransports_Security(t *testing.T) {
+	servers, createErr := createServers(3, map[int]*CreateServerParams{
+		0: {ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+			c.Security = SecurityTLS
+		}},
+		1: {ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+			c.Security = SecurityTLS
+		}},
+		2: {ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+			c.Security = SecurityNoise
+		}},
+	})
+	require.NoError(t, createErr)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	// the TLS nodes secure their connection with TLS
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	conns := servers[0].host.Network().ConnsToPeer(servers[1].host.ID())
+	require.NotEmpty(t, conns)
+	assert.EqualValues(t, libp2ptls.ID, conns[0].ConnState().Security)
+
+	// the nodes with different preferences can still connect
+	require.NoError(t, JoinAndWait(servers[2], servers[0], DefaultBufferTimeout, DefaultJoinTimeout))
+}
@@ -0,0 +1,266 @@
+package remotesigner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/umbracle/ethgo"
+
+	ibftSigner "github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// DefaultRequestTimeout is the default timeout of the remote signer requests
+const DefaultRequestTimeout = 5 * time.Second
+
+// maxResponseSize is the maximum size of the remote signer response body
+const maxResponseSize = 1 << 20
+
+var errNoBLSKey = errors.New("remote signer holds no BLS key")
+
+// Client signs with the validator keys held by the remote signer.
+// It signs with the first ECDSA and BLS keys the remote signer holds
+type Client struct {
+	url    string
+	client *http.Client
+
+	ecdsaPublicKey string
+	address        types.Address
+
+	blsPublicKey string
+}
+
+// NewClient connects to the remote signer at the given URL and fetches its keys.
+// The remote signer has to hold an ECDSA key, the BLS key is optional
+func NewClient(url string, timeout time.Duration) (*Client, error) {
+	c := &Client{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+
+	if err := c.Upcheck(); err != nil {
+		return nil, err
+	}
+
+	ecdsaKeys, err := c.publicKeys(ECDSAPublicKeysEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ecdsaKeys) == 0 {
+		return nil, errNoKeys
+	}
+
+	rawPublicKey, err := hex.DecodeHex(ecdsaKeys[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid ECDSA public key: %w", err)
+	}
+
+	publicKey, err := crypto.ParsePublicKey(rawPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ECDSA public key: %w", err)
+	}
+
+	c.ecdsaPublicKey = normalizeIdentifier(ecdsaKeys[0])
+	c.address = crypto.PubKeyToAddress(publicKey)
+
+	blsKeys, err := c.publicKeys(BLSPublicKeysEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blsKeys) > 0 {
+		c.blsPublicKey = normalizeIdentifier(blsKeys[0])
+	}
+
+	return c, nil
+}
+
+// Upcheck checks whether the remote signer is running
+func (c *Client) Upcheck() error {
+	_, err := c.do(http.MethodGet, UpcheckEndpoint, nil)
+
+	return err
+}
+
+// Address returns the address of the ECDSA key
+func (c *Client) Address() types.Address {
+	return c.address
+}
+
+// ECDSAAddress returns the address of the ECDSA key
+func (c *Client) ECDSAAddress() ethgo.Address {
+	return ethgo.Address(c.address)
+}
+
+// SignECDSA signs the digest with the ECDSA key, if the remote signer allows signing the digests
+func (c *Client) SignECDSA(digest []byte) ([]byte, error) {
+	signature, err := c.sign(ECDSASignEndpoint+c.ecdsaPublicKey, &ECDSASignRequest{
+		Digest: hex.EncodeToHex(digest),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, c.verifyECDSASignature(signature, digest)
+}
+
+// SignIBFTMessage signs the keccak256 hash of the marshaled IBFT message with the ECDSA key.
+// The remote signer refuses to sign the messages violating its slashing protection
+func (c *Client) SignIBFTMessage(payload []byte) ([]byte, error) {
+	signature, err := c.sign(IBFTSignEndpoint+c.ecdsaPublicKey, &IBFTSignRequest{
+		Message: hex.EncodeToHex(payload),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, c.verifyECDSASignature(signature, crypto.Keccak256(payload))
+}
+
+// SignECDSAProposerSeal signs the IBFT proposer seal with the ECDSA key,
+// given the RLP encoded header fields the IBFT header hash is calculated of
+func (c *Client) SignECDSAProposerSeal(header []byte) ([]byte, error) {
+	digest, err := ibftSigner.ProposerSealDigest(header)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := c.sign(ECDSAProposerSealEndpoint+c.ecdsaPublicKey, &ProposerSealRequest{
+		Header: hex.EncodeToHex(header),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, c.verifyECDSASignature(signature, digest)
+}
+
+// SignECDSACommittedSeal signs the IBFT committed seal of the proposal at the given height and round
+// with the ECDSA key. The remote signer refuses to sign the seals violating its slashing protection
+func (c *Client) SignECDSACommittedSeal(proposalHash []byte, height, round uint64) ([]byte, error) {
+	signature, err := c.sign(ECDSACommittedSealEndpoint+c.ecdsaPublicKey, &CommittedSealRequest{
+		Height:       height,
+		Round:        round,
+		ProposalHash: hex.EncodeToHex(proposalHash),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return signature, c.verifyECDSASignature(signature, ibftSigner.CommittedSealDigest(proposalHash))
+}
+
+// SignBLS signs the digest with the BLS key and the given domain, returning the marshaled signature
+func (c *Client) SignBLS(digest, domain []byte) ([]byte, error) {
+	if c.blsPublicKey == "" {
+		return nil, errNoBLSKey
+	}
+
+	return c.sign(BLSSignEndpoint+c.blsPublicKey, &BLSSignRequest{
+		SigningRoot: hex.EncodeToHex(digest),
+		Domain:      hex.EncodeToHex(domain),
+	})
+}
+
+// SignBLSCommittedSeal signs the polybft committed seal of the proposal at the given height and round
+// with the BLS key, returning the marshaled signature.
+// The remote signer refuses to sign the seals violating its slashing protection
+func (c *Client) SignBLSCommittedSeal(proposalHash []byte, height, round uint64) ([]byte, error) {
+	if c.blsPublicKey == "" {
+		return nil, errNoBLSKey
+	}
+
+	return c.sign(BLSCommittedSealEndpoint+c.blsPublicKey, &CommittedSealRequest{
+		Height:       height,
+		Round:        round,
+		ProposalHash: hex.EncodeToHex(proposalHash),
+	})
+}
+
+// verifyECDSASignature checks the signature returned by the remote signer is made by its ECDSA key
+func (c *Client) verifyECDSASignature(signature, digest []byte) error {
+	publicKey, err := crypto.RecoverPubkey(signature, digest)
+	if err != nil {
+		return fmt.Errorf("invalid remote signer signature: %w", err)
+	}
+
+	if crypto.PubKeyToAddress(publicKey) != c.address {
+		return errors.New("invalid remote signer signature: signed by another key")
+	}
+
+	return nil
+}
+
+func (c *Client) publicKeys(endpoint string) ([]string, error) {
+	body, err := c.do(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return nil, fmt.Errorf("invalid remote signer public keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (c *Client) sign(endpoint string, req interface{}) ([]byte, error) {
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.do(http.MethodPost, endpoint, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := hex.DecodeHex(strings.TrimSpace(string(body)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer signature encoding: %w", err)
+	}
+
+	return signature, nil
+}
+
+func (c *Client) do(method, endpoint string, reqBody []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, c.url+endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer request failed: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("remote signer request failed: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusPreconditionFailed:
+		return nil, fmt.Errorf("%w: %s", ErrSlashingProtection, strings.TrimSpace(string(body)))
+	default:
+		return nil, fmt.Errorf("remote signer responded with %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+}
@@ -0,0 +1,103 @@
+package remotesigner
+
+import (
+	"errors"
+	"strings"
+)
+
+// The remote signer protocol follows the Web3Signer HTTP API layout.
+// Every key is identified by its hex encoded public key, the signing requests
+// and responses carry the hex encoded data and the signatures are returned as the hex encoded plain text.
+// Unlike Web3Signer, the BLS keys are the BN254 (polybft) keys and the IBFT messages and seals
+// are signed by their own endpoints from the data they are made of, so they are checked against
+// the slashing protection before signing. The digests of the committed seals are never signed as is:
+// the ECDSA digest signing is disabled unless allowed, and the BLS signing refuses the committed seal domain
+const (
+	// UpcheckEndpoint returns OK if the signer is running
+	UpcheckEndpoint = "/upcheck"
+
+	// ECDSAPublicKeysEndpoint returns the ECDSA public keys held by the signer
+	ECDSAPublicKeysEndpoint = "/api/v1/eth1/publicKeys"
+
+	// ECDSASignEndpoint signs the digest with the ECDSA key identified by the path suffix,
+	// if the signer allows signing the raw digests
+	ECDSASignEndpoint = "/api/v1/eth1/sign/"
+
+	// ECDSAProposerSealEndpoint signs the IBFT proposer seal of the header
+	// with the ECDSA key identified by the path suffix
+	ECDSAProposerSealEndpoint = "/api/v1/eth1/proposerSeal/"
+
+	// ECDSACommittedSealEndpoint signs the IBFT committed seal with the ECDSA key identified by the path suffix,
+	// checking the seal against the slashing protection
+	ECDSACommittedSealEndpoint = "/api/v1/eth1/committedSeal/"
+
+	// IBFTSignEndpoint signs the IBFT consensus message with the ECDSA key identified by the path suffix,
+	// checking the message against the slashing protection
+	IBFTSignEndpoint = "/api/v1/eth1/ibft/"
+
+	// BLSPublicKeysEndpoint returns the BLS public keys held by the signer
+	BLSPublicKeysEndpoint = "/api/v1/eth2/publicKeys"
+
+	// BLSSignEndpoint signs the signing root with the BLS key identified by the path suffix,
+	// in any domain but the one of the committed seals
+	BLSSignEndpoint = "/api/v1/eth2/sign/"
+
+	// BLSCommittedSealEndpoint signs the polybft committed seal with the BLS key identified by the path suffix,
+	// checking the seal against the slashing protection
+	BLSCommittedSealEndpoint = "/api/v1/eth2/committedSeal/"
+)
+
+var (
+	// ErrSlashingProtection is returned if the remote signer refuses to sign a slashable message
+	ErrSlashingProtection = errors.New("signing prevented by the slashing protection")
+
+	errKeyNotFound         = errors.New("key not found")
+	errRawSigningDisabled  = errors.New("signing the digests is disabled")
+	errCommittedSealDomain = errors.New("committed seals must be signed by the committed seal endpoint")
+	errNoKeys              = errors.New("remote signer holds no keys")
+)
+
+// ECDSASignRequest is the request of the ECDSA signing endpoint
+type ECDSASignRequest struct {
+	// Digest is the hex encoded 32 bytes digest to sign
+	Digest string `json:"digest"`
+}
+
+// IBFTSignRequest is the request of the IBFT message signing endpoint
+type IBFTSignRequest struct {
+	// Message is the hex encoded protobuf IBFT message without the signature
+	Message string `json:"message"`
+}
+
+// ProposerSealRequest is the request of the proposer seal signing endpoint
+type ProposerSealRequest struct {
+	// Header is the hex encoded RLP of the header fields the IBFT header hash is calculated of
+	Header string `json:"header"`
+}
+
+// CommittedSealRequest is the request of the committed seal signing endpoints
+type CommittedSealRequest struct {
+	Height uint64 `json:"height"`
+	Round  uint64 `json:"round"`
+	// ProposalHash is the hex encoded hash of the committed proposal
+	ProposalHash string `json:"proposalHash"`
+}
+
+// BLSSignRequest is the request of the BLS signing endpoint
+type BLSSignRequest struct {
+	// SigningRoot is the hex encoded data to sign
+	SigningRoot string `json:"signingRoot"`
+	// Domain is the hex encoded signing domain
+	Domain string `json:"domain"`
+}
+
+// normalizeIdentifier returns the lower case hex encoded key identifier with the 0x prefix
+func normalizeIdentifier(identifier string) string {
+	identifier = strings.ToLower(identifier)
+
+	if !strings.HasPrefix(identifier, "0x") {
+		identifier = "0x" + identifier
+	}
+
+	return identifier
+}
@@ -0,0 +1,248 @@
+package remotesigner
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	ibftSigner "github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+)
+
+func newTestSigner(t *testing.T) (*wallet.Account, secrets.SecretsManager) {
+	t.Helper()
+
+	manager, err := helper.SetupLocalSecretsManager(t.TempDir())
+	require.NoError(t, err)
+
+	account, err := wallet.GenerateAccount()
+	require.NoError(t, err)
+	require.NoError(t, account.Save(manager))
+
+	return account, manager
+}
+
+func startTestServer(
+	t *testing.T,
+	manager secrets.SecretsManager,
+	slashingProtectionPath string,
+	allowRawSigning bool,
+) *Client {
+	t.Helper()
+
+	server, err := NewServer(hclog.NewNullLogger(), manager, slashingProtectionPath, allowRawSigning)
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	client, err := NewClient(httpServer.URL, DefaultRequestTimeout)
+	require.NoError(t, err)
+
+	return client
+}
+
+func newTestIBFTMessage(t *testing.T, from types.Address, height, round uint64, proposalHash []byte) []byte {
+	t.Helper()
+
+	payload, err := proto.Marshal(&protoIBFT.Message{
+		View: &protoIBFT.View{Height: height, Round: round},
+		From: from.Bytes(),
+		Type: protoIBFT.MessageType_PREPARE,
+		Payload: &protoIBFT.Message_PrepareData{
+			PrepareData: &protoIBFT.PrepareMessage{ProposalHash: proposalHash},
+		},
+	})
+	require.NoError(t, err)
+
+	return payload
+}
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	account, manager := newTestSigner(t)
+	client := startTestServer(t, manager, "", true)
+
+	require.Equal(t, account.Address(), client.Address())
+
+	// ECDSA digest
+	digest := crypto.Keccak256([]byte("digest"))
+
+	signature, err := client.SignECDSA(digest)
+	require.NoError(t, err)
+
+	expected, err := account.Ecdsa.Sign(digest)
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	_, err = client.SignECDSA([]byte("not a digest"))
+	require.ErrorContains(t, err, "32 bytes")
+
+	// BLS
+	key := wallet.NewKeyFromSigner(client)
+
+	blsSignature, err := key.Sign(digest)
+	require.NoError(t, err)
+
+	sig, err := bls.UnmarshalSignature(blsSignature)
+	require.NoError(t, err)
+	assert.True(t, sig.Verify(account.Bls.PublicKey(), digest, bls.DomainCommonSigning))
+
+	// polybft committed seal
+	blsSignature, err = key.SignCommittedSeal(digest, &protoIBFT.View{Height: 1, Round: 0})
+	require.NoError(t, err)
+
+	sig, err = bls.UnmarshalSignature(blsSignature)
+	require.NoError(t, err)
+	assert.True(t, sig.Verify(account.Bls.PublicKey(), digest, bls.DomainCheckpointManager))
+
+	// IBFT seals are the same as signed by the local key
+	ecdsaKey, err := crypto.ReadConsensusKey(manager)
+	require.NoError(t, err)
+
+	var (
+		localSigner  = ibftSigner.NewSigner(ibftSigner.NewECDSAKeyManagerFromKey(ecdsaKey), nil)
+		remoteSigner = ibftSigner.NewSigner(ibftSigner.NewRemoteECDSAKeyManager(client), nil)
+		header       = &types.Header{Number: 1}
+	)
+
+	localSigner.InitIBFTExtra(header, validators.NewECDSAValidatorSet(), nil)
+
+	localHeader, err := localSigner.WriteProposerSeal(header.Copy())
+	require.NoError(t, err)
+
+	remoteHeader, err := remoteSigner.WriteProposerSeal(header.Copy())
+	require.NoError(t, err)
+	assert.Equal(t, localHeader.ExtraData, remoteHeader.ExtraData)
+
+	localSeal, err := localSigner.CreateCommittedSeal(digest, 1, 0)
+	require.NoError(t, err)
+
+	remoteSeal, err := remoteSigner.CreateCommittedSeal(digest, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, localSeal, remoteSeal)
+
+	// IBFT message
+	msg, err := key.SignIBFTMessage(&protoIBFT.Message{
+		View: &protoIBFT.View{Height: 1, Round: 0},
+		From: key.Address().Bytes(),
+		Type: protoIBFT.MessageType_COMMIT,
+		Payload: &protoIBFT.Message_CommitData{
+			CommitData: &protoIBFT.CommitMessage{ProposalHash: digest, CommittedSeal: blsSignature},
+		},
+	})
+	require.NoError(t, err)
+
+	signer, err := wallet.RecoverSignerFromIBFTMessage(msg)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address(), signer)
+
+	// messages of other validators are not signed
+	_, err = client.SignIBFTMessage(newTestIBFTMessage(t, types.StringToAddress("1"), 1, 0, digest))
+	require.ErrorContains(t, err, "not from the signer")
+}
+
+func TestRemoteSigner_SlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		slashingProtectionPath = filepath.Join(t.TempDir(), "slashing-protection.json")
+
+		proposalA = crypto.Keccak256([]byte("A"))
+		proposalB = crypto.Keccak256([]byte("B"))
+	)
+
+	account, manager := newTestSigner(t)
+	client := startTestServer(t, manager, slashingProtectionPath, false)
+
+	_, err := client.SignIBFTMessage(newTestIBFTMessage(t, account.Address(), 10, 0, proposalA))
+	require.NoError(t, err)
+
+	// the same message can be signed again
+	_, err = client.SignIBFTMessage(newTestIBFTMessage(t, account.Address(), 10, 0, proposalA))
+	require.NoError(t, err)
+
+	// another proposal in the same height and round is refused
+	_, err = client.SignIBFTMessage(newTestIBFTMessage(t, account.Address(), 10, 0, proposalB))
+	require.ErrorIs(t, err, ErrSlashingProtection)
+
+	// another proposal in the next round is signed
+	_, err = client.SignIBFTMessage(newTestIBFTMessage(t, account.Address(), 10, 1, proposalB))
+	require.NoError(t, err)
+
+	// the signed messages are kept over the restarts
+	restarted := startTestServer(t, manager, slashingProtectionPath, false)
+
+	_, err = restarted.SignIBFTMessage(newTestIBFTMessage(t, account.Address(), 10, 1, proposalA))
+	require.ErrorIs(t, err, ErrSlashingProtection)
+
+	// the heights below the retention window are never signed
+	_, err = restarted.SignIBFTMessage(
+		newTestIBFTMessage(t, account.Address(), 10+slashingProtectionRetention+1, 0, proposalA),
+	)
+	require.NoError(t, err)
+
+	_, err = restarted.SignIBFTMessage(newTestIBFTMessage(t, account.Address(), 10, 2, proposalA))
+	require.ErrorIs(t, err, ErrSlashingProtection)
+}
+
+func TestRemoteSigner_CommittedSealProtection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		proposalA = crypto.Keccak256([]byte("A"))
+		proposalB = crypto.Keccak256([]byte("B"))
+	)
+
+	account, manager := newTestSigner(t)
+	client := startTestServer(t, manager, "", false)
+
+	commit, err := proto.Marshal(&protoIBFT.Message{
+		View: &protoIBFT.View{Height: 10, Round: 0},
+		From: account.Address().Bytes(),
+		Type: protoIBFT.MessageType_COMMIT,
+		Payload: &protoIBFT.Message_CommitData{
+			CommitData: &protoIBFT.CommitMessage{ProposalHash: proposalA},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = client.SignIBFTMessage(commit)
+	require.NoError(t, err)
+
+	// the committed seals are recorded along with the COMMIT messages of the same view
+	_, err = client.SignBLSCommittedSeal(proposalA, 10, 0)
+	require.NoError(t, err)
+
+	_, err = client.SignECDSACommittedSeal(proposalA, 10, 0)
+	require.NoError(t, err)
+
+	_, err = client.SignBLSCommittedSeal(proposalB, 10, 0)
+	require.ErrorIs(t, err, ErrSlashingProtection)
+
+	_, err = client.SignECDSACommittedSeal(proposalB, 10, 0)
+	require.ErrorIs(t, err, ErrSlashingProtection)
+
+	_, err = client.SignECDSACommittedSeal(proposalB, 10, 1)
+	require.NoError(t, err)
+
+	// the committed seals can not be signed bypassing the slashing protection
+	_, err = client.SignECDSA(ibftSigner.CommittedSealDigest(proposalB))
+	require.ErrorContains(t, err, errRawSigningDisabled.Error())
+
+	_, err = client.SignBLS(proposalB, bls.DomainCheckpointManager)
+	require.ErrorContains(t, err, errCommittedSealDomain.Error())
+}
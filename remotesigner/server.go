@@ -0,0 +1,385 @@
+package remotesigner
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/hashicorp/go-hclog"
+	"google.golang.org/protobuf/proto"
+
+	ibftSigner "github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
+	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// maxRequestSize is the maximum size of the signing request body
+const maxRequestSize = 1 << 20
+
+// Server is the remote signer serving the validator keys
+// loaded from the SecretsManager over the remote signer protocol
+type Server struct {
+	logger hclog.Logger
+
+	ecdsaKey       *ecdsa.PrivateKey
+	ecdsaPublicKey string
+	address        types.Address
+
+	blsKey       *bls.PrivateKey
+	blsPublicKey string
+
+	protection *slashingProtection
+
+	// allowRawSigning enables signing the raw digests with the ECDSA key
+	allowRawSigning bool
+
+	mux *http.ServeMux
+}
+
+// NewServer creates the remote signer server holding the validator keys of the given SecretsManager.
+// The BLS key is optional, the signer serves only the ECDSA key if it is not present.
+// The signed IBFT messages and committed seals are recorded in the slashing protection database
+// at the given path, or in memory only if the path is empty.
+// Signing the raw digests with the ECDSA key is needed by the polybft validators to send the bridge transactions,
+// but it bypasses the slashing protection of the IBFT committed seals, which are ECDSA signatures,
+// so it is disabled unless allowed
+func NewServer(
+	logger hclog.Logger,
+	manager secrets.SecretsManager,
+	slashingProtectionPath string,
+	allowRawSigning bool,
+) (*Server, error) {
+	ecdsaKey, err := crypto.ReadConsensusKey(manager)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read validator key: %w", err)
+	}
+
+	protection, err := newSlashingProtection(slashingProtectionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		logger:          logger.Named("remote-signer"),
+		ecdsaKey:        ecdsaKey,
+		ecdsaPublicKey:  hex.EncodeToHex(crypto.MarshalPublicKey(&ecdsaKey.PublicKey)),
+		address:         crypto.PubKeyToAddress(&ecdsaKey.PublicKey),
+		protection:      protection,
+		allowRawSigning: allowRawSigning,
+		mux:             http.NewServeMux(),
+	}
+
+	if manager.HasSecret(secrets.ValidatorBLSKey) {
+		encodedKey, err := manager.GetSecret(secrets.ValidatorBLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read validator BLS key: %w", err)
+		}
+
+		// the IBFT BLS keys are of another curve and can not be served
+		if s.blsKey, err = bls.UnmarshalPrivateKey(encodedKey); err != nil {
+			s.logger.Warn("validator BLS key is not a polybft key, serving the ECDSA key only", "err", err)
+		} else {
+			s.blsPublicKey = hex.EncodeToHex(s.blsKey.PublicKey().Marshal())
+		}
+	}
+
+	s.mux.HandleFunc(UpcheckEndpoint, s.handleUpcheck)
+	s.mux.HandleFunc(ECDSAPublicKeysEndpoint, s.handlePublicKeys(func() string { return s.ecdsaPublicKey }))
+	s.mux.HandleFunc(ECDSASignEndpoint, s.handleECDSASign)
+	s.mux.HandleFunc(ECDSAProposerSealEndpoint, s.handleECDSAProposerSeal)
+	s.mux.HandleFunc(ECDSACommittedSealEndpoint, s.handleECDSACommittedSeal)
+	s.mux.HandleFunc(IBFTSignEndpoint, s.handleIBFTSign)
+	s.mux.HandleFunc(BLSPublicKeysEndpoint, s.handlePublicKeys(func() string { return s.blsPublicKey }))
+	s.mux.HandleFunc(BLSSignEndpoint, s.handleBLSSign)
+	s.mux.HandleFunc(BLSCommittedSealEndpoint, s.handleBLSCommittedSeal)
+
+	return s, nil
+}
+
+// Address returns the address of the ECDSA key the signer holds
+func (s *Server) Address() types.Address {
+	return s.address
+}
+
+// ServeHTTP implements the http.Handler interface
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleUpcheck(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	_, _ = w.Write([]byte("OK"))
+}
+
+func (s *Server) handlePublicKeys(publicKey func() string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		keys := make([]string, 0, 1)
+		if key := publicKey(); key != "" {
+			keys = append(keys, key)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(keys)
+	}
+}
+
+func (s *Server) handleECDSASign(w http.ResponseWriter, r *http.Request) {
+	req := &ECDSASignRequest{}
+	if !s.decodeSignRequest(w, r, ECDSASignEndpoint, s.ecdsaPublicKey, req) {
+		return
+	}
+
+	if !s.allowRawSigning {
+		http.Error(w, errRawSigningDisabled.Error(), http.StatusForbidden)
+
+		return
+	}
+
+	digest, err := hex.DecodeHex(req.Digest)
+	if err != nil || len(digest) != types.HashLength {
+		http.Error(w, "digest must be 32 bytes hex encoded", http.StatusBadRequest)
+
+		return
+	}
+
+	signature, err := crypto.Sign(s.ecdsaKey, digest)
+	s.writeSignature(w, signature, err)
+}
+
+func (s *Server) handleIBFTSign(w http.ResponseWriter, r *http.Request) {
+	req := &IBFTSignRequest{}
+	if !s.decodeSignRequest(w, r, IBFTSignEndpoint, s.ecdsaPublicKey, req) {
+		return
+	}
+
+	payload, err := hex.DecodeHex(req.Message)
+	if err != nil {
+		http.Error(w, "invalid message encoding", http.StatusBadRequest)
+
+		return
+	}
+
+	msg := &protoIBFT.Message{}
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		http.Error(w, "invalid IBFT message", http.StatusBadRequest)
+
+		return
+	}
+
+	if len(msg.Signature) != 0 {
+		http.Error(w, "IBFT message is already signed", http.StatusBadRequest)
+
+		return
+	}
+
+	if types.BytesToAddress(msg.From) != s.address {
+		http.Error(w, "IBFT message is not from the signer", http.StatusBadRequest)
+
+		return
+	}
+
+	if !s.checkProtection(w, "IBFT message", s.protection.checkAndRecord(msg)) {
+		return
+	}
+
+	signature, err := crypto.Sign(s.ecdsaKey, crypto.Keccak256(payload))
+	s.writeSignature(w, signature, err)
+}
+
+func (s *Server) handleECDSAProposerSeal(w http.ResponseWriter, r *http.Request) {
+	req := &ProposerSealRequest{}
+	if !s.decodeSignRequest(w, r, ECDSAProposerSealEndpoint, s.ecdsaPublicKey, req) {
+		return
+	}
+
+	header, err := hex.DecodeHex(req.Header)
+	if err != nil {
+		http.Error(w, "invalid header encoding", http.StatusBadRequest)
+
+		return
+	}
+
+	digest, err := ibftSigner.ProposerSealDigest(header)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid header: %v", err), http.StatusBadRequest)
+
+		return
+	}
+
+	signature, err := crypto.Sign(s.ecdsaKey, digest)
+	s.writeSignature(w, signature, err)
+}
+
+func (s *Server) handleECDSACommittedSeal(w http.ResponseWriter, r *http.Request) {
+	proposalHash, ok := s.decodeCommittedSealRequest(w, r, ECDSACommittedSealEndpoint, s.ecdsaPublicKey)
+	if !ok {
+		return
+	}
+
+	signature, err := crypto.Sign(s.ecdsaKey, ibftSigner.CommittedSealDigest(proposalHash))
+	s.writeSignature(w, signature, err)
+}
+
+func (s *Server) handleBLSCommittedSeal(w http.ResponseWriter, r *http.Request) {
+	proposalHash, ok := s.decodeCommittedSealRequest(w, r, BLSCommittedSealEndpoint, s.blsPublicKey)
+	if !ok {
+		return
+	}
+
+	s.writeBLSSignature(w, proposalHash, bls.DomainCheckpointManager)
+}
+
+func (s *Server) handleBLSSign(w http.ResponseWriter, r *http.Request) {
+	req := &BLSSignRequest{}
+	if !s.decodeSignRequest(w, r, BLSSignEndpoint, s.blsPublicKey, req) {
+		return
+	}
+
+	signingRoot, err := hex.DecodeHex(req.SigningRoot)
+	if err != nil {
+		http.Error(w, "invalid signing root encoding", http.StatusBadRequest)
+
+		return
+	}
+
+	domain, err := hex.DecodeHex(req.Domain)
+	if err != nil {
+		http.Error(w, "invalid domain encoding", http.StatusBadRequest)
+
+		return
+	}
+
+	// the committed seals are signed in the checkpoint manager domain
+	if bytes.Equal(domain, bls.DomainCheckpointManager) {
+		http.Error(w, errCommittedSealDomain.Error(), http.StatusForbidden)
+
+		return
+	}
+
+	s.writeBLSSignature(w, signingRoot, domain)
+}
+
+// decodeCommittedSealRequest decodes the committed seal signing request and records the seal
+// in the slashing protection. Returns the proposal hash to seal,
+// or false if the seal can not be signed, in which case the error response has been written
+func (s *Server) decodeCommittedSealRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	endpoint string,
+	publicKey string,
+) ([]byte, bool) {
+	req := &CommittedSealRequest{}
+	if !s.decodeSignRequest(w, r, endpoint, publicKey, req) {
+		return nil, false
+	}
+
+	proposalHash, err := hex.DecodeHex(req.ProposalHash)
+	if err != nil || len(proposalHash) != types.HashLength {
+		http.Error(w, "proposal hash must be 32 bytes hex encoded", http.StatusBadRequest)
+
+		return nil, false
+	}
+
+	err = s.protection.checkAndRecordCommittedSeal(req.Height, req.Round, proposalHash)
+	if !s.checkProtection(w, "committed seal", err) {
+		return nil, false
+	}
+
+	return proposalHash, true
+}
+
+// checkProtection writes the error response if the slashing protection check failed.
+// Returns false if the check failed
+func (s *Server) checkProtection(w http.ResponseWriter, what string, err error) bool {
+	if err == nil {
+		return true
+	}
+
+	if errors.Is(err, ErrSlashingProtection) {
+		s.logger.Warn("refused to sign "+what, "err", err)
+
+		// Web3Signer responds with the precondition failed status to the slashable requests
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+
+		return false
+	}
+
+	s.logger.Error("failed to check "+what, "err", err)
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+
+	return false
+}
+
+func (s *Server) writeBLSSignature(w http.ResponseWriter, signingRoot, domain []byte) {
+	signature, err := s.blsKey.Sign(signingRoot, domain)
+	if err != nil {
+		s.writeSignature(w, nil, err)
+
+		return
+	}
+
+	marshaled, err := signature.Marshal()
+	s.writeSignature(w, marshaled, err)
+}
+
+// decodeSignRequest checks the signing request is for the given key and decodes its body.
+// Returns false if the request is invalid, in which case the error response has been written
+func (s *Server) decodeSignRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	endpoint string,
+	publicKey string,
+	req interface{},
+) bool {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return false
+	}
+
+	identifier := normalizeIdentifier(strings.TrimPrefix(r.URL.Path, endpoint))
+	if publicKey == "" || identifier != publicKey {
+		http.Error(w, errKeyNotFound.Error(), http.StatusNotFound)
+
+		return false
+	}
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) writeSignature(w http.ResponseWriter, signature []byte, err error) {
+	if err != nil {
+		s.logger.Error("failed to sign", "err", err)
+		http.Error(w, "failed to sign", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(hex.EncodeToHex(signature)))
+}
@@ -0,0 +1,243 @@
+package remotesigner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+// slashingProtectionRetention is the number of heights below the highest signed one
+// for which the signed messages are kept. Messages for the older heights are never signed
+const slashingProtectionRetention uint64 = 1024
+
+// signedSlot is the height, round and type of the signed IBFT message
+type signedSlot struct {
+	height  uint64
+	round   uint64
+	msgType protoIBFT.MessageType
+}
+
+// slashingProtection keeps the record of the signed IBFT messages
+// and prevents signing different proposals for the same height, round and message type,
+// so the validator can not equivocate even if the node is compromised
+type slashingProtection struct {
+	sync.Mutex
+
+	// path of the file the record is persisted to, empty if kept in memory only
+	path string
+
+	// lowestHeight is the lowest height the messages can be signed for
+	lowestHeight uint64
+
+	// signed holds the proposal hash of every signed message
+	signed map[signedSlot][]byte
+}
+
+// slashingProtectionJSON is the persisted record of the signed IBFT messages
+type slashingProtectionJSON struct {
+	LowestHeight   uint64              `json:"lowestHeight"`
+	SignedMessages []signedMessageJSON `json:"signedMessages"`
+}
+
+type signedMessageJSON struct {
+	Height       uint64 `json:"height"`
+	Round        uint64 `json:"round"`
+	Type         string `json:"type"`
+	ProposalHash string `json:"proposalHash"`
+}
+
+// newSlashingProtection loads the record of the signed messages from the given file, if it exists
+func newSlashingProtection(path string) (*slashingProtection, error) {
+	s := &slashingProtection{
+		path:   path,
+		signed: make(map[signedSlot][]byte),
+	}
+
+	if path == "" {
+		return s, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read slashing protection database: %w", err)
+	}
+
+	record := &slashingProtectionJSON{}
+	if err := json.Unmarshal(raw, record); err != nil {
+		return nil, fmt.Errorf("invalid slashing protection database: %w", err)
+	}
+
+	s.lowestHeight = record.LowestHeight
+
+	for _, msg := range record.SignedMessages {
+		msgType, ok := protoIBFT.MessageType_value[msg.Type]
+		if !ok {
+			return nil, fmt.Errorf("invalid slashing protection database: unknown message type %s", msg.Type)
+		}
+
+		proposalHash, err := hex.DecodeHex(msg.ProposalHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slashing protection database: %w", err)
+		}
+
+		s.signed[signedSlot{
+			height:  msg.Height,
+			round:   msg.Round,
+			msgType: protoIBFT.MessageType(msgType),
+		}] = proposalHash
+	}
+
+	return s, nil
+}
+
+// checkAndRecord checks whether the message can be signed and records it as signed.
+// The record is persisted before returning, so the message is never signed unrecorded
+func (s *slashingProtection) checkAndRecord(msg *protoIBFT.Message) error {
+	view := msg.GetView()
+	if view == nil {
+		return errors.New("message has no view")
+	}
+
+	var proposalHash []byte
+
+	switch msg.Type {
+	case protoIBFT.MessageType_PREPREPARE:
+		proposalHash = msg.GetPreprepareData().GetProposalHash()
+	case protoIBFT.MessageType_PREPARE:
+		proposalHash = msg.GetPrepareData().GetProposalHash()
+	case protoIBFT.MessageType_COMMIT:
+		proposalHash = msg.GetCommitData().GetProposalHash()
+	default:
+		// round change messages do not commit to a proposal
+		s.Lock()
+		defer s.Unlock()
+
+		return s.checkHeight(view.Height)
+	}
+
+	return s.record(signedSlot{height: view.Height, round: view.Round, msgType: msg.Type}, proposalHash)
+}
+
+// checkAndRecordCommittedSeal checks whether the committed seal of the proposal can be signed
+// and records it as signed. The seal commits to the proposal the same as the COMMIT message of its view,
+// so both are recorded in the same slot and can only be signed for the same proposal
+func (s *slashingProtection) checkAndRecordCommittedSeal(height, round uint64, proposalHash []byte) error {
+	return s.record(signedSlot{height: height, round: round, msgType: protoIBFT.MessageType_COMMIT}, proposalHash)
+}
+
+// checkHeight checks the height is not below the lowest signable height
+func (s *slashingProtection) checkHeight(height uint64) error {
+	if height < s.lowestHeight {
+		return fmt.Errorf("%w: height %d is below the lowest signable height %d",
+			ErrSlashingProtection, height, s.lowestHeight)
+	}
+
+	return nil
+}
+
+// record records the proposal hash as signed in the slot,
+// unless another proposal has already been signed in it
+func (s *slashingProtection) record(slot signedSlot, proposalHash []byte) error {
+	if len(proposalHash) == 0 {
+		return fmt.Errorf("%s message has no proposal hash", slot.msgType)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if err := s.checkHeight(slot.height); err != nil {
+		return err
+	}
+
+	if signed, ok := s.signed[slot]; ok {
+		if !bytes.Equal(signed, proposalHash) {
+			return fmt.Errorf("%w: %s message for another proposal already signed at height %d and round %d",
+				ErrSlashingProtection, slot.msgType, slot.height, slot.round)
+		}
+
+		return nil
+	}
+
+	s.signed[slot] = proposalHash
+
+	if err := s.persist(slot.height); err != nil {
+		delete(s.signed, slot)
+
+		return err
+	}
+
+	return nil
+}
+
+// persist prunes the messages below the retention window of the given height
+// and writes the record to the file
+func (s *slashingProtection) persist(height uint64) error {
+	if height > slashingProtectionRetention && height-slashingProtectionRetention > s.lowestHeight {
+		s.lowestHeight = height - slashingProtectionRetention
+
+		for slot := range s.signed {
+			if slot.height < s.lowestHeight {
+				delete(s.signed, slot)
+			}
+		}
+	}
+
+	if s.path == "" {
+		return nil
+	}
+
+	record := &slashingProtectionJSON{
+		LowestHeight:   s.lowestHeight,
+		SignedMessages: make([]signedMessageJSON, 0, len(s.signed)),
+	}
+
+	for slot, proposalHash := range s.signed {
+		record.SignedMessages = append(record.SignedMessages, signedMessageJSON{
+			Height:       slot.height,
+			Round:        slot.round,
+			Type:         slot.msgType.String(),
+			ProposalHash: hex.EncodeToHex(proposalHash),
+		})
+	}
+
+	sort.Slice(record.SignedMessages, func(i, j int) bool {
+		a, b := record.SignedMessages[i], record.SignedMessages[j]
+		if a.Height != b.Height {
+			return a.Height < b.Height
+		}
+
+		if a.Round != b.Round {
+			return a.Round < b.Round
+		}
+
+		return a.Type < b.Type
+	})
+
+	raw, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write the record atomically, a partially written file would lose the signed messages
+	tmpPath := s.path + ".tmp"
+
+	if err := os.WriteFile(tmpPath, raw, 0600); err != nil {
+		return fmt.Errorf("unable to write slashing protection database: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("unable to write slashing protection database: %w", err)
+	}
+
+	return nil
+}
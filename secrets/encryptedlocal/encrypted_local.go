@@ -0,0 +1,278 @@
+package encryptedlocal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/hashicorp/go-hclog"
+)
+
+// EncryptedLocalSecretsManager is a SecretsManager that
+// stores secrets locally on disk, encrypted with a passphrase
+// into the Web3 Secret Storage (keystore) files
+type EncryptedLocalSecretsManager struct {
+	// Logger object
+	logger hclog.Logger
+
+	// Path to the base working directory
+	path string
+
+	// Passphrase used to encrypt the secrets
+	passphrase string
+
+	// Scrypt parameters, along with the salt, used when encrypting the secrets.
+	// Every keystore file has its own random IV, so the secrets can share the key
+	scryptParams scryptParamsJSON
+
+	// Keys derived from the passphrase by their scrypt parameters,
+	// so the costly derivation runs once for the life of the manager
+	derivedKeys     map[scryptParamsJSON][]byte
+	derivedKeysLock sync.Mutex
+
+	// Map of known secrets and their paths
+	secretPathMap map[string]string
+
+	// Mux for the secretPathMap
+	secretPathMapLock sync.RWMutex
+}
+
+// SecretsManagerFactory implements the factory method.
+// The passphrase is read from the sources set in the config or params extra data (see ReadPassphrase)
+func SecretsManagerFactory(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+) (secrets.SecretsManager, error) {
+	// the passphrase sources may be set in the config file
+	extra := make(map[string]interface{})
+
+	if config != nil {
+		for key, value := range config.Extra {
+			extra[key] = value
+		}
+	}
+
+	for key, value := range params.Extra {
+		extra[key] = value
+	}
+
+	// Grab the path to the working directory
+	rawPath, ok := extra[secrets.Path]
+	if !ok {
+		return nil, errors.New("no path specified for encrypted local secrets manager")
+	}
+
+	path, ok := rawPath.(string)
+	if !ok {
+		return nil, errors.New("invalid type assertion")
+	}
+
+	passphrase, err := ReadPassphrase(extra, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEncryptedLocalSecretsManager(params.Logger, path, passphrase, StandardScryptN, StandardScryptP)
+}
+
+// NewEncryptedLocalSecretsManager creates the encrypted local SecretsManager
+// using the given passphrase and scrypt parameters
+func NewEncryptedLocalSecretsManager(
+	logger hclog.Logger,
+	path string,
+	passphrase string,
+	scryptN, scryptP int,
+) (*EncryptedLocalSecretsManager, error) {
+	if passphrase == "" {
+		return nil, errEmptyPassphrase
+	}
+
+	scryptParams, err := newScryptParams(scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+
+	manager := &EncryptedLocalSecretsManager{
+		logger:        logger.Named(string(secrets.EncryptedLocal)),
+		path:          path,
+		passphrase:    passphrase,
+		scryptParams:  scryptParams,
+		derivedKeys:   make(map[scryptParamsJSON][]byte),
+		secretPathMap: make(map[string]string),
+	}
+
+	// Run the initial setup
+	_ = manager.Setup()
+
+	return manager, nil
+}
+
+// Setup sets up the encrypted local SecretsManager
+func (e *EncryptedLocalSecretsManager) Setup() error {
+	e.secretPathMapLock.Lock()
+	defer e.secretPathMapLock.Unlock()
+
+	subDirectories := []string{secrets.ConsensusFolderLocal, secrets.NetworkFolderLocal}
+
+	// Set up the local directories
+	if err := common.SetupDataDir(e.path, subDirectories, 0770); err != nil {
+		return err
+	}
+
+	// baseDir/consensus/validator.keystore.json
+	e.secretPathMap[secrets.ValidatorKey] = filepath.Join(
+		e.path,
+		secrets.ConsensusFolderLocal,
+		secrets.ValidatorKeyEncryptedLocal,
+	)
+
+	// baseDir/consensus/validator-bls.keystore.json
+	e.secretPathMap[secrets.ValidatorBLSKey] = filepath.Join(
+		e.path,
+		secrets.ConsensusFolderLocal,
+		secrets.ValidatorBLSKeyEncryptedLocal,
+	)
+
+	// baseDir/libp2p/libp2p.keystore.json
+	e.secretPathMap[secrets.NetworkKey] = filepath.Join(
+		e.path,
+		secrets.NetworkFolderLocal,
+		secrets.NetworkKeyEncryptedLocal,
+	)
+
+	return nil
+}
+
+// GetSecret reads the secret from disk and decrypts it
+func (e *EncryptedLocalSecretsManager) GetSecret(name string) ([]byte, error) {
+	secretPath, err := e.secretPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	keystore, err := os.ReadFile(secretPath)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to read secret from disk (%s), %w",
+			secretPath,
+			err,
+		)
+	}
+
+	secret, err := decryptSecret(name, keystore, e.deriveKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt secret (%s), %w", secretPath, err)
+	}
+
+	return secret, nil
+}
+
+// SetSecret encrypts the secret and saves it to disk
+func (e *EncryptedLocalSecretsManager) SetSecret(name string, value []byte) error {
+	// If the data directory is not specified, skip write
+	if e.path == "" {
+		return nil
+	}
+
+	secretPath, err := e.secretPath(name)
+	if err != nil {
+		return err
+	}
+
+	// Checks for existing secret
+	if _, err := os.Stat(secretPath); err == nil {
+		return fmt.Errorf(
+			"%s already initialized",
+			secretPath,
+		)
+	}
+
+	derivedKey, err := e.deriveKey(e.scryptParams)
+	if err != nil {
+		return fmt.Errorf("unable to derive the encryption key, %w", err)
+	}
+
+	keystore, err := encryptSecret(name, value, derivedKey, e.scryptParams)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt secret, %w", err)
+	}
+
+	// Write the secret to disk
+	if err := common.SaveFileSafe(secretPath, keystore, 0440); err != nil {
+		return fmt.Errorf(
+			"unable to write secret to disk (%s), %w",
+			secretPath,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// HasSecret checks if the secret is present on disk
+func (e *EncryptedLocalSecretsManager) HasSecret(name string) bool {
+	secretPath, err := e.secretPath(name)
+	if err != nil {
+		return false
+	}
+
+	_, err = os.Stat(secretPath)
+
+	return err == nil
+}
+
+// RemoveSecret removes the secret from disk
+func (e *EncryptedLocalSecretsManager) RemoveSecret(name string) error {
+	e.secretPathMapLock.Lock()
+	secretPath, ok := e.secretPathMap[name]
+	defer e.secretPathMapLock.Unlock()
+
+	if !ok {
+		return secrets.ErrSecretNotFound
+	}
+
+	delete(e.secretPathMap, name)
+
+	if removeErr := os.Remove(secretPath); removeErr != nil {
+		return fmt.Errorf("unable to remove secret, %w", removeErr)
+	}
+
+	return nil
+}
+
+// deriveKey returns the key derived from the passphrase using the given scrypt parameters,
+// deriving it only if it is not derived yet
+func (e *EncryptedLocalSecretsManager) deriveKey(params scryptParamsJSON) ([]byte, error) {
+	e.derivedKeysLock.Lock()
+	defer e.derivedKeysLock.Unlock()
+
+	if key, ok := e.derivedKeys[params]; ok {
+		return key, nil
+	}
+
+	key, err := deriveKey(e.passphrase, params)
+	if err != nil {
+		return nil, err
+	}
+
+	e.derivedKeys[params] = key
+
+	return key, nil
+}
+
+// secretPath returns the path of the keystore file of the secret
+func (e *EncryptedLocalSecretsManager) secretPath(name string) (string, error) {
+	e.secretPathMapLock.RLock()
+	defer e.secretPathMapLock.RUnlock()
+
+	secretPath, ok := e.secretPathMap[name]
+	if !ok {
+		return "", secrets.ErrSecretNotFound
+	}
+
+	return secretPath, nil
+}
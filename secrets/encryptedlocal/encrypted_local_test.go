@@ -0,0 +1,183 @@
+package encryptedlocal
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+const testPassphrase = "correct horse battery staple"
+
+func newTestManager(t *testing.T, path, passphrase string) *EncryptedLocalSecretsManager {
+	t.Helper()
+
+	manager, err := NewEncryptedLocalSecretsManager(hclog.NewNullLogger(), path, passphrase, LightScryptN, LightScryptP)
+	require.NoError(t, err)
+
+	return manager
+}
+
+func TestEncryptedLocalSecretsManager_SetGetSecret(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	manager := newTestManager(t, path, testPassphrase)
+
+	validatorKey, validatorKeyEncoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+
+	_, blsKeyEncoded, err := crypto.GenerateAndEncodeBLSSecretKey()
+	require.NoError(t, err)
+
+	_, networkKeyEncoded, err := network.GenerateAndEncodeLibp2pKey()
+	require.NoError(t, err)
+
+	values := map[string][]byte{
+		secrets.ValidatorKey:    validatorKeyEncoded,
+		secrets.ValidatorBLSKey: blsKeyEncoded,
+		secrets.NetworkKey:      networkKeyEncoded,
+	}
+
+	for name, value := range values {
+		assert.False(t, manager.HasSecret(name))
+		require.NoError(t, manager.SetSecret(name, value))
+		assert.True(t, manager.HasSecret(name))
+
+		// secrets can not be overwritten
+		require.Error(t, manager.SetSecret(name, value))
+
+		secret, err := manager.GetSecret(name)
+		require.NoError(t, err)
+		assert.Equal(t, value, secret)
+	}
+
+	// the secrets are not stored in plaintext
+	for name, value := range values {
+		secretPath, err := manager.secretPath(name)
+		require.NoError(t, err)
+
+		raw, err := os.ReadFile(secretPath)
+		require.NoError(t, err)
+		assert.NotContains(t, string(raw), string(value))
+	}
+
+	// the validator keystore holds the raw key and the address, as other keystore tools expect
+	raw, err := os.ReadFile(filepath.Join(path, secrets.ConsensusFolderLocal, secrets.ValidatorKeyEncryptedLocal))
+	require.NoError(t, err)
+
+	keystore := &keystoreJSON{}
+	require.NoError(t, json.Unmarshal(raw, keystore))
+
+	assert.Equal(t, keystoreVersion, keystore.Version)
+	assert.Equal(t,
+		strings.ToLower(crypto.PubKeyToAddress(&validatorKey.PublicKey).String()[2:]),
+		keystore.Address,
+	)
+	assert.Len(t, keystore.Crypto.CipherText, 64)
+
+	// the secrets are readable by another instance with the same passphrase only
+	secret, err := newTestManager(t, path, testPassphrase).GetSecret(secrets.ValidatorKey)
+	require.NoError(t, err)
+	assert.Equal(t, validatorKeyEncoded, secret)
+
+	_, err = newTestManager(t, path, "wrong passphrase").GetSecret(secrets.ValidatorKey)
+	require.ErrorIs(t, err, errDecrypt)
+
+	// unknown secrets are not supported
+	_, err = manager.GetSecret("unknown")
+	require.ErrorIs(t, err, secrets.ErrSecretNotFound)
+
+	require.NoError(t, manager.RemoveSecret(secrets.NetworkKey))
+	assert.False(t, manager.HasSecret(secrets.NetworkKey))
+}
+
+func TestEncryptedLocalSecretsManager_SecretMismatch(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	manager := newTestManager(t, path, testPassphrase)
+
+	_, blsKeyEncoded, err := crypto.GenerateAndEncodeBLSSecretKey()
+	require.NoError(t, err)
+
+	require.NoError(t, manager.SetSecret(secrets.ValidatorBLSKey, blsKeyEncoded))
+
+	// the keystore file of one secret can not be used as another secret
+	raw, err := os.ReadFile(filepath.Join(path, secrets.ConsensusFolderLocal, secrets.ValidatorBLSKeyEncryptedLocal))
+	require.NoError(t, err)
+
+	_, err = decryptSecret(secrets.NetworkKey, raw, manager.deriveKey)
+	require.Error(t, err)
+}
+
+func TestEncryptedLocalSecretsManager_DerivedKeyCache(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	manager := newTestManager(t, path, testPassphrase)
+
+	_, blsKeyEncoded, err := crypto.GenerateAndEncodeBLSSecretKey()
+	require.NoError(t, err)
+
+	_, networkKeyEncoded, err := network.GenerateAndEncodeLibp2pKey()
+	require.NoError(t, err)
+
+	require.NoError(t, manager.SetSecret(secrets.ValidatorBLSKey, blsKeyEncoded))
+	require.NoError(t, manager.SetSecret(secrets.NetworkKey, networkKeyEncoded))
+
+	// the secrets written by the manager share the derived key
+	assert.Len(t, manager.derivedKeys, 1)
+
+	// the secrets written by another manager are decrypted with their own derived key
+	reopened := newTestManager(t, path, testPassphrase)
+
+	for i := 0; i < 2; i++ {
+		_, err = reopened.GetSecret(secrets.ValidatorBLSKey)
+		require.NoError(t, err)
+
+		_, err = reopened.GetSecret(secrets.NetworkKey)
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, reopened.derivedKeys, 1)
+}
+
+func TestReadPassphrase(t *testing.T) {
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("from file\n"), 0600))
+
+	t.Setenv(secrets.DefaultPassphraseEnv, "from default env")
+	t.Setenv("CUSTOM_PASSPHRASE", "from custom env")
+
+	passphrase, err := ReadPassphrase(map[string]interface{}{
+		secrets.PassphraseFile: passphraseFile,
+		secrets.PassphraseEnv:  "CUSTOM_PASSPHRASE",
+	}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "from file", passphrase)
+
+	passphrase, err = ReadPassphrase(map[string]interface{}{
+		secrets.PassphraseEnv: "CUSTOM_PASSPHRASE",
+	}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "from custom env", passphrase)
+
+	passphrase, err = ReadPassphrase(nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, "from default env", passphrase)
+
+	t.Setenv(secrets.DefaultPassphraseEnv, "")
+
+	_, err = ReadPassphrase(nil, false)
+	require.ErrorIs(t, err, errEmptyPassphrase)
+}
@@ -0,0 +1,232 @@
+package encryptedlocal
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+const (
+	// keystoreVersion is the version of the Web3 Secret Storage format
+	keystoreVersion = 3
+
+	// StandardScryptN is the N parameter of scrypt using 256MB memory and taking approximately 1s CPU time
+	StandardScryptN = 1 << 18
+
+	// StandardScryptP is the P parameter of scrypt using 256MB memory and taking approximately 1s CPU time
+	StandardScryptP = 1
+
+	// LightScryptN is the N parameter of scrypt using 4MB memory and taking approximately 100ms CPU time
+	LightScryptN = 1 << 12
+
+	// LightScryptP is the P parameter of scrypt using 4MB memory and taking approximately 100ms CPU time
+	LightScryptP = 6
+
+	scryptR     = 8
+	scryptDKLen = 32
+
+	keystoreCipher = "aes-128-ctr"
+	keystoreKDF    = "scrypt"
+)
+
+var (
+	errDecrypt            = errors.New("could not decrypt key with the given passphrase")
+	errUnsupportedVersion = errors.New("unsupported keystore version")
+	errUnsupportedCipher  = errors.New("unsupported keystore cipher")
+	errUnsupportedKDF     = errors.New("unsupported keystore key derivation function")
+)
+
+// keystoreJSON is the Web3 Secret Storage (v3) keystore file.
+// In addition to the standard fields, it holds the name of the stored secret
+type keystoreJSON struct {
+	Address string     `json:"address,omitempty"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+	Secret  string     `json:"secret"`
+}
+
+type cryptoJSON struct {
+	Cipher       string           `json:"cipher"`
+	CipherText   string           `json:"ciphertext"`
+	CipherParams cipherParamsJSON `json:"cipherparams"`
+	KDF          string           `json:"kdf"`
+	KDFParams    scryptParamsJSON `json:"kdfparams"`
+	MAC          string           `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+type scryptParamsJSON struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// newScryptParams returns the scrypt parameters with a new random salt
+func newScryptParams(scryptN, scryptP int) (scryptParamsJSON, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return scryptParamsJSON{}, err
+	}
+
+	return scryptParamsJSON{
+		N:     scryptN,
+		R:     scryptR,
+		P:     scryptP,
+		DKLen: scryptDKLen,
+		Salt:  hex.EncodeToString(salt),
+	}, nil
+}
+
+// deriveKey derives the encryption key from the passphrase using the given scrypt parameters
+func deriveKey(passphrase string, params scryptParamsJSON) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+}
+
+// encryptSecret encrypts the secret value into the keystore file, using the key derived with the given params.
+// The validator ECDSA key is stored as the raw private key along with its address,
+// so the keystore file is compatible with the other Web3 Secret Storage tools.
+// The remaining secrets are stored in their encoded form
+func encryptSecret(name string, value []byte, derivedKey []byte, params scryptParamsJSON) ([]byte, error) {
+	keystore := &keystoreJSON{
+		ID:      uuid.New().String(),
+		Version: keystoreVersion,
+		Secret:  name,
+	}
+
+	plaintext := value
+
+	if name == secrets.ValidatorKey {
+		privateKey, err := crypto.BytesToECDSAPrivateKey(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator key: %w", err)
+		}
+
+		if plaintext, err = crypto.MarshalECDSAPrivateKey(privateKey); err != nil {
+			return nil, err
+		}
+
+		keystore.Address = hex.EncodeToString(crypto.PubKeyToAddress(&privateKey.PublicKey).Bytes())
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := aesCTRXOR(derivedKey[:16], plaintext, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	keystore.Crypto = cryptoJSON{
+		Cipher:     keystoreCipher,
+		CipherText: hex.EncodeToString(ciphertext),
+		CipherParams: cipherParamsJSON{
+			IV: hex.EncodeToString(iv),
+		},
+		KDF:       keystoreKDF,
+		KDFParams: params,
+		MAC:       hex.EncodeToString(crypto.Keccak256(derivedKey[16:32], ciphertext)),
+	}
+
+	return json.MarshalIndent(keystore, "", "  ")
+}
+
+// decryptSecret decrypts the secret value from the keystore file, using the key derived with its params.
+// Returns the secret in the same form it has been encrypted in
+func decryptSecret(
+	name string,
+	keystoreBytes []byte,
+	deriveKey func(params scryptParamsJSON) ([]byte, error),
+) ([]byte, error) {
+	keystore := &keystoreJSON{}
+	if err := json.Unmarshal(keystoreBytes, keystore); err != nil {
+		return nil, fmt.Errorf("invalid keystore file: %w", err)
+	}
+
+	switch {
+	case keystore.Version != keystoreVersion:
+		return nil, errUnsupportedVersion
+	case keystore.Crypto.Cipher != keystoreCipher:
+		return nil, errUnsupportedCipher
+	case keystore.Crypto.KDF != keystoreKDF:
+		return nil, errUnsupportedKDF
+	case keystore.Secret != name:
+		return nil, fmt.Errorf("keystore holds %q instead of %q secret", keystore.Secret, name)
+	}
+
+	iv, err := hex.DecodeString(keystore.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := hex.DecodeString(keystore.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := hex.DecodeString(keystore.Crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := deriveKey(keystore.Crypto.KDFParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(derivedKey) < 32 {
+		return nil, errDecrypt
+	}
+
+	if !bytes.Equal(crypto.Keccak256(derivedKey[16:32], ciphertext), mac) {
+		return nil, errDecrypt
+	}
+
+	plaintext, err := aesCTRXOR(derivedKey[:16], ciphertext, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == secrets.ValidatorKey {
+		// the secrets manager users expect the hex encoded key
+		return []byte(hex.EncodeToString(plaintext)), nil
+	}
+
+	return plaintext, nil
+}
+
+func aesCTRXOR(key, input, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(output, input)
+
+	return output, nil
+}
@@ -0,0 +1,89 @@
+package encryptedlocal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+var (
+	errEmptyPassphrase    = errors.New("empty passphrase")
+	errPassphraseMismatch = errors.New("passphrases do not match")
+	errNotTerminal        = errors.New("standard input is not a terminal")
+)
+
+// ReadPassphrase reads the passphrase of the encrypted local secrets from the sources configured in extra.
+// The passphrase is read from the file set by secrets.PassphraseFile if present,
+// otherwise from the environment variable set by secrets.PassphraseEnv (secrets.DefaultPassphraseEnv by default),
+// otherwise the user is prompted for it on the terminal (twice, if confirm is set)
+func ReadPassphrase(extra map[string]interface{}, confirm bool) (string, error) {
+	if path, ok := extra[secrets.PassphraseFile].(string); ok && path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("unable to read passphrase file (%s), %w", path, err)
+		}
+
+		return nonEmpty(strings.TrimRight(string(raw), "\r\n"))
+	}
+
+	envName := secrets.DefaultPassphraseEnv
+	if name, ok := extra[secrets.PassphraseEnv].(string); ok && name != "" {
+		envName = name
+	}
+
+	if passphrase, ok := os.LookupEnv(envName); ok {
+		return nonEmpty(passphrase)
+	}
+
+	passphrase, err := promptPassphrase("Secrets passphrase: ")
+	if err != nil {
+		return "", fmt.Errorf("unable to prompt for passphrase "+
+			"(set the %s environment variable or the passphrase file instead), %w", envName, err)
+	}
+
+	if confirm {
+		repeated, err := promptPassphrase("Repeat secrets passphrase: ")
+		if err != nil {
+			return "", err
+		}
+
+		if repeated != passphrase {
+			return "", errPassphraseMismatch
+		}
+	}
+
+	return nonEmpty(passphrase)
+}
+
+// promptPassphrase prompts for the passphrase on the terminal without echoing it
+func promptPassphrase(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", errNotTerminal
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+
+	passphrase, err := term.ReadPassword(fd)
+
+	fmt.Fprintln(os.Stderr)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(passphrase), "\r\n"), nil
+}
+
+func nonEmpty(passphrase string) (string, error) {
+	if passphrase == "" {
+		return "", errEmptyPassphrase
+	}
+
+	return passphrase, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/secrets/awsssm"
+	"github.com/0xPolygon/polygon-edge/secrets/encryptedlocal"
 	"github.com/0xPolygon/polygon-edge/secrets/gcpssm"
 	"github.com/0xPolygon/polygon-edge/secrets/hashicorpvault"
 	"github.com/0xPolygon/polygon-edge/secrets/local"
@@ -34,6 +35,39 @@ func SetupLocalSecretsManager(dataDir string) (secrets.SecretsManager, error) {
 	)
 }
 
+// SetupEncryptedLocalSecretsManager is a helper method for boilerplate encrypted local secrets manager setup.
+// The passphrase is read from the sources set in extra (see encryptedlocal.ReadPassphrase)
+func SetupEncryptedLocalSecretsManager(dataDir string, extra map[string]interface{}) (secrets.SecretsManager, error) {
+	params := map[string]interface{}{
+		secrets.Path: dataDir,
+	}
+
+	for key, value := range extra {
+		params[key] = value
+	}
+
+	return encryptedlocal.SecretsManagerFactory(
+		nil,
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra:  params,
+		},
+	)
+}
+
+// setupEncryptedLocal is a helper method for boilerplate encrypted local secrets manager setup
+// from the config, which holds the data directory and the passphrase sources in the extra data
+func setupEncryptedLocal(
+	secretsConfig *secrets.SecretsManagerConfig,
+) (secrets.SecretsManager, error) {
+	return encryptedlocal.SecretsManagerFactory(
+		secretsConfig,
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+		},
+	)
+}
+
 // setupHashicorpVault is a helper method for boilerplate hashicorp vault secrets manager setup
 func setupHashicorpVault(
 	secretsConfig *secrets.SecretsManagerConfig,
@@ -235,6 +269,13 @@ func InitCloudSecretsManager(secretsConfig *secrets.SecretsManagerConfig) (secre
 		}
 
 		secretsManager = GCPSSM
+	case secrets.EncryptedLocal:
+		encryptedLocal, err := setupEncryptedLocal(secretsConfig)
+		if err != nil {
+			return secretsManager, err
+		}
+
+		secretsManager = encryptedLocal
 	default:
 		return secretsManager, errors.New("unsupported secrets manager")
 	}
@@ -19,8 +19,17 @@ const (
 
 	// Name is the name of the current node
 	Name = "name"
+
+	// PassphraseFile is the path to the file holding the passphrase of the encrypted local secrets
+	PassphraseFile = "passphrase-file"
+
+	// PassphraseEnv is the name of the environment variable holding the passphrase of the encrypted local secrets
+	PassphraseEnv = "passphrase-env"
 )
 
+// DefaultPassphraseEnv is the default environment variable holding the passphrase of the encrypted local secrets
+const DefaultPassphraseEnv = "EDGE_SECRETS_PASSPHRASE"
+
 // Define constant names for available secrets
 const (
 	// ValidatorKey is the private key secret of the validator node
@@ -40,6 +49,13 @@ const (
 	NetworkKeyLocal      = "libp2p.key"
 )
 
+// Define constant file names for the encrypted local StorageManager
+const (
+	ValidatorKeyEncryptedLocal    = "validator.keystore.json"
+	ValidatorBLSKeyEncryptedLocal = "validator-bls.keystore.json"
+	NetworkKeyEncryptedLocal      = "libp2p.keystore.json"
+)
+
 // Define constant folder names for the local StorageManager
 const (
 	ConsensusFolderLocal = "consensus"
@@ -57,6 +73,9 @@ const (
 	// Local pertains to the local FS [Default]
 	Local SecretsManagerType = "local"
 
+	// EncryptedLocal pertains to the local FS, with secrets encrypted by a passphrase
+	EncryptedLocal SecretsManagerType = "encrypted-local"
+
 	// HashicorpVault pertains to the Hashicorp Vault server
 	HashicorpVault SecretsManagerType = "hashicorp-vault"
 
@@ -110,5 +129,5 @@ type SecretsManagerFactory func(
 // SupportedServiceManager checks if the passed in service manager type is supported
 func SupportedServiceManager(service SecretsManagerType) bool {
 	return service == HashicorpVault || service == AWSSSM ||
-		service == Local || service == GCPSSM
+		service == Local || service == GCPSSM || service == EncryptedLocal
 }
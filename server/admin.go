@@ -0,0 +1,118 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errBlockProductionNotSupported = errors.New("the block production of the consensus can not be paused")
+	errInvalidHash                 = errors.New("invalid transaction hash")
+)
+
+// compactableStorage is implemented by the databases which can be compacted
+type compactableStorage interface {
+	Compact() error
+}
+
+// SetLogLevel sets the log level of the module, or the default level if the module is empty.
+// The empty level resets the module to the default level
+func (s *Server) SetLogLevel(module string, level string) error {
+	if err := s.logLevels.SetLevel(module, level); err != nil {
+		return err
+	}
+
+	s.logger.Info("log level changed", "module", module, "level", level)
+
+	return nil
+}
+
+// LogLevels returns the default log level and the log levels of the modules
+func (s *Server) LogLevels() (string, map[string]string) {
+	return s.logLevels.Get()
+}
+
+// DropPeer disconnects the peer
+func (s *Server) DropPeer(id string) error {
+	return s.network.DropPeer(id, "dropped by the operator")
+}
+
+// BanPeer bans the peer ID, IP address or CIDR range for the given duration, or permanently if it is zero
+func (s *Server) BanPeer(target string, duration time.Duration, reason string) error {
+	_, err := s.network.BanPeer(target, duration, reason)
+
+	return err
+}
+
+// RemoveTx removes the transaction and the following transactions of its sender from the pool
+func (s *Server) RemoveTx(hash types.Hash) ([]types.Hash, error) {
+	return s.txpool.RemoveTx(hash)
+}
+
+// RebroadcastTx gossips the pool transaction to the peers again
+func (s *Server) RebroadcastTx(hash types.Hash) error {
+	return s.txpool.RebroadcastTx(hash)
+}
+
+// FlushAccount drops all the transactions of the account from the pool
+func (s *Server) FlushAccount(addr types.Address) []types.Hash {
+	return s.txpool.FlushAccount(addr)
+}
+
+// SetBlockProductionPaused pauses or resumes the block production of the node
+func (s *Server) SetBlockProductionPaused(paused bool) error {
+	controller, ok := s.consensus.(consensus.BlockProductionController)
+	if !ok {
+		return errBlockProductionNotSupported
+	}
+
+	controller.SetBlockProductionPaused(paused)
+
+	return nil
+}
+
+// IsBlockProductionPaused returns true if the block production of the node is paused
+func (s *Server) IsBlockProductionPaused() bool {
+	controller, ok := s.consensus.(consensus.BlockProductionController)
+
+	return ok && controller.IsBlockProductionPaused()
+}
+
+// CompactDatabases compacts the blockchain and the state databases of the node,
+// and returns the names of the compacted ones
+func (s *Server) CompactDatabases() ([]string, error) {
+	databases := []struct {
+		name string
+		db   interface{}
+	}{
+		{"blockchain", s.chainDB},
+		{"trie", s.stateDB},
+	}
+
+	compacted := make([]string, 0, len(databases))
+
+	for _, database := range databases {
+		db, ok := database.db.(compactableStorage)
+		if !ok {
+			continue
+		}
+
+		s.logger.Info("compacting database", "name", database.name)
+
+		start := time.Now()
+
+		if err := db.Compact(); err != nil {
+			return compacted, fmt.Errorf("failed to compact the %s database: %w", database.name, err)
+		}
+
+		s.logger.Info("database compacted", "name", database.name, "duration", time.Since(start))
+
+		compacted = append(compacted, database.name)
+	}
+
+	return compacted, nil
+}
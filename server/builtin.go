@@ -1,6 +1,7 @@
 package server
 
 import (
+	"github.com/0xPolygon/polygon-edge/blocksink"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	consensusDev "github.com/0xPolygon/polygon-edge/consensus/dev"
@@ -10,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/secrets/awsssm"
+	"github.com/0xPolygon/polygon-edge/secrets/encryptedlocal"
 	"github.com/0xPolygon/polygon-edge/secrets/gcpssm"
 	"github.com/0xPolygon/polygon-edge/secrets/hashicorpvault"
 	"github.com/0xPolygon/polygon-edge/secrets/local"
@@ -42,11 +44,18 @@ var consensusBackends = map[ConsensusType]consensus.Factory{
 // secret management solutions
 var secretsManagerBackends = map[secrets.SecretsManagerType]secrets.SecretsManagerFactory{
 	secrets.Local:          local.SecretsManagerFactory,
+	secrets.EncryptedLocal: encryptedlocal.SecretsManagerFactory,
 	secrets.HashicorpVault: hashicorpvault.SecretsManagerFactory,
 	secrets.AWSSSM:         awsssm.SecretsManagerFactory,
 	secrets.GCPSSM:         gcpssm.SecretsManagerFactory,
 }
 
+// blockSinkBackends defines the factories of the sinks the finalized blocks are published to
+var blockSinkBackends = map[blocksink.SinkType]blocksink.Factory{
+	blocksink.Stdout: blocksink.NewStdoutSink,
+	blocksink.File:   blocksink.NewFileSink,
+}
+
 var genesisCreationFactory = map[ConsensusType]GenesisFactoryHook{
 	PolyBFTConsensus: consensusPolyBFT.GenesisPostHookFactory,
 }
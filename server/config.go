@@ -6,7 +6,12 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blocksink"
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/health"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 )
@@ -32,20 +37,40 @@ type Config struct {
 	DataDir     string
 	RestoreFile *string
 
+	LogIndex bool
+
+	Preimages bool
+
 	Seal bool
 
 	SecretsManager *secrets.SecretsManagerConfig
 
+	RemoteSignerURL string
+
 	LogLevel hclog.Level
 
 	JSONLogFormat bool
 
 	LogFilePath string
 
+	Light bool
+
+	Checkpoint *blockchain.Checkpoint
+
+	ForkURL string
+	// ForkBlock is the number of the forked block, the latest one is forked if it is nil
+	ForkBlock *uint64
+
 	Relayer bool
 
 	NumBlockConfirmations      uint64
 	RelayerTrackerPollInterval time.Duration
+
+	Health *health.Config
+
+	Tracing *tracing.Config
+
+	BlockSink *blocksink.Config
 }
 
 // Telemetry holds the config details for metric services
@@ -56,9 +81,13 @@ type Telemetry struct {
 // JSONRPC holds the config details for the JSON-RPC server
 type JSONRPC struct {
 	JSONRPCAddr              *net.TCPAddr
+	IPCPath                  string
+	Admin                    bool
 	AccessControlAllowOrigin []string
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+	IndexedBlockRangeLimit   uint64
 	ConcurrentRequestsDebug  uint64
 	WebSocketReadLimit       uint64
+	RateLimit                *jsonrpc.RateLimitConfig
 }
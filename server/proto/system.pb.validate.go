@@ -360,6 +360,113 @@ func (m *Peer) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Score
+
+	// no validation rules for Direction
+
+	// no validation rules for ConnectedAt
+
+	// no validation rules for Latency
+
+	// no validation rules for Distance
+
+	if all {
+		switch v := interface{}(m.GetHead()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PeerValidationError{
+					field:  "Head",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PeerValidationError{
+					field:  "Head",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHead()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PeerValidationError{
+				field:  "Head",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBandwidth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PeerValidationError{
+						field:  fmt.Sprintf("Bandwidth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PeerValidationError{
+						field:  fmt.Sprintf("Bandwidth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PeerValidationError{
+					field:  fmt.Sprintf("Bandwidth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetGossipMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PeerValidationError{
+						field:  fmt.Sprintf("GossipMessages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PeerValidationError{
+						field:  fmt.Sprintf("GossipMessages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PeerValidationError{
+					field:  fmt.Sprintf("GossipMessages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PeerMultiError(errors)
 	}
@@ -903,44 +1010,46 @@ var _ interface {
 	ErrorName() string
 } = PeersListResponseValidationError{}
 
-// Validate checks the field values on BlockByNumberRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BlockByNumberRequest) Validate() error {
+// Validate checks the field values on Ban with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Ban) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BlockByNumberRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// BlockByNumberRequestMultiError, or nil if none found.
-func (m *BlockByNumberRequest) ValidateAll() error {
+// ValidateAll checks the field values on Ban with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BanMultiError, or nil if none found.
+func (m *Ban) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BlockByNumberRequest) validate(all bool) error {
+func (m *Ban) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Number
+	// no validation rules for Target
+
+	// no validation rules for Reason
+
+	// no validation rules for ExpiresAt
 
 	if len(errors) > 0 {
-		return BlockByNumberRequestMultiError(errors)
+		return BanMultiError(errors)
 	}
 
 	return nil
 }
 
-// BlockByNumberRequestMultiError is an error wrapping multiple validation
-// errors returned by BlockByNumberRequest.ValidateAll() if the designated
-// constraints aren't met.
-type BlockByNumberRequestMultiError []error
+// BanMultiError is an error wrapping multiple validation errors returned by
+// Ban.ValidateAll() if the designated constraints aren't met.
+type BanMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BlockByNumberRequestMultiError) Error() string {
+func (m BanMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -949,11 +1058,11 @@ func (m BlockByNumberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BlockByNumberRequestMultiError) AllErrors() []error { return m }
+func (m BanMultiError) AllErrors() []error { return m }
 
-// BlockByNumberRequestValidationError is the validation error returned by
-// BlockByNumberRequest.Validate if the designated constraints aren't met.
-type BlockByNumberRequestValidationError struct {
+// BanValidationError is the validation error returned by Ban.Validate if the
+// designated constraints aren't met.
+type BanValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -961,24 +1070,24 @@ type BlockByNumberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e BlockByNumberRequestValidationError) Field() string { return e.field }
+func (e BanValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BlockByNumberRequestValidationError) Reason() string { return e.reason }
+func (e BanValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BlockByNumberRequestValidationError) Cause() error { return e.cause }
+func (e BanValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BlockByNumberRequestValidationError) Key() bool { return e.key }
+func (e BanValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BlockByNumberRequestValidationError) ErrorName() string {
-	return "BlockByNumberRequestValidationError"
+func (e BanValidationError) ErrorName() string {
+	return "BanValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BlockByNumberRequestValidationError) Error() string {
+func (e BanValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -990,14 +1099,14 @@ func (e BlockByNumberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBlockByNumberRequest.%s: %s%s",
+		"invalid %sBan.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BlockByNumberRequestValidationError{}
+var _ error = BanValidationError{}
 
 var _ interface {
 	Field() string
@@ -1005,46 +1114,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BlockByNumberRequestValidationError{}
+} = BanValidationError{}
 
-// Validate checks the field values on BlockResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BlockResponse) Validate() error {
+// Validate checks the field values on PeersBanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PeersBanRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BlockResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BlockResponseMultiError, or
-// nil if none found.
-func (m *BlockResponse) ValidateAll() error {
+// ValidateAll checks the field values on PeersBanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PeersBanRequestMultiError, or nil if none found.
+func (m *PeersBanRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BlockResponse) validate(all bool) error {
+func (m *PeersBanRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	if utf8.RuneCountInString(m.GetTarget()) < 1 {
+		err := PeersBanRequestValidationError{
+			field:  "Target",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Duration
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return BlockResponseMultiError(errors)
+		return PeersBanRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BlockResponseMultiError is an error wrapping multiple validation errors
-// returned by BlockResponse.ValidateAll() if the designated constraints
+// PeersBanRequestMultiError is an error wrapping multiple validation errors
+// returned by PeersBanRequest.ValidateAll() if the designated constraints
 // aren't met.
-type BlockResponseMultiError []error
+type PeersBanRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BlockResponseMultiError) Error() string {
+func (m PeersBanRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1053,11 +1175,11 @@ func (m BlockResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BlockResponseMultiError) AllErrors() []error { return m }
+func (m PeersBanRequestMultiError) AllErrors() []error { return m }
 
-// BlockResponseValidationError is the validation error returned by
-// BlockResponse.Validate if the designated constraints aren't met.
-type BlockResponseValidationError struct {
+// PeersBanRequestValidationError is the validation error returned by
+// PeersBanRequest.Validate if the designated constraints aren't met.
+type PeersBanRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1065,22 +1187,24 @@ type BlockResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e BlockResponseValidationError) Field() string { return e.field }
+func (e PeersBanRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BlockResponseValidationError) Reason() string { return e.reason }
+func (e PeersBanRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BlockResponseValidationError) Cause() error { return e.cause }
+func (e PeersBanRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BlockResponseValidationError) Key() bool { return e.key }
+func (e PeersBanRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BlockResponseValidationError) ErrorName() string { return "BlockResponseValidationError" }
+func (e PeersBanRequestValidationError) ErrorName() string {
+	return "PeersBanRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BlockResponseValidationError) Error() string {
+func (e PeersBanRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1092,14 +1216,14 @@ func (e BlockResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBlockResponse.%s: %s%s",
+		"invalid %sPeersBanRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BlockResponseValidationError{}
+var _ error = PeersBanRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1107,48 +1231,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BlockResponseValidationError{}
+} = PeersBanRequestValidationError{}
 
-// Validate checks the field values on ExportRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ExportRequest) Validate() error {
+// Validate checks the field values on PeersBanListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PeersBanListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExportRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExportRequestMultiError, or
-// nil if none found.
-func (m *ExportRequest) ValidateAll() error {
+// ValidateAll checks the field values on PeersBanListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PeersBanListResponseMultiError, or nil if none found.
+func (m *PeersBanListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExportRequest) validate(all bool) error {
+func (m *PeersBanListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for From
+	for idx, item := range m.GetBans() {
+		_, _ = idx, item
 
-	// no validation rules for To
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PeersBanListResponseValidationError{
+						field:  fmt.Sprintf("Bans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PeersBanListResponseValidationError{
+						field:  fmt.Sprintf("Bans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PeersBanListResponseValidationError{
+					field:  fmt.Sprintf("Bans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ExportRequestMultiError(errors)
+		return PeersBanListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExportRequestMultiError is an error wrapping multiple validation errors
-// returned by ExportRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ExportRequestMultiError []error
+// PeersBanListResponseMultiError is an error wrapping multiple validation
+// errors returned by PeersBanListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PeersBanListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExportRequestMultiError) Error() string {
+func (m PeersBanListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1157,11 +1311,11 @@ func (m ExportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExportRequestMultiError) AllErrors() []error { return m }
+func (m PeersBanListResponseMultiError) AllErrors() []error { return m }
 
-// ExportRequestValidationError is the validation error returned by
-// ExportRequest.Validate if the designated constraints aren't met.
-type ExportRequestValidationError struct {
+// PeersBanListResponseValidationError is the validation error returned by
+// PeersBanListResponse.Validate if the designated constraints aren't met.
+type PeersBanListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1169,22 +1323,24 @@ type ExportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExportRequestValidationError) Field() string { return e.field }
+func (e PeersBanListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExportRequestValidationError) Reason() string { return e.reason }
+func (e PeersBanListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExportRequestValidationError) Cause() error { return e.cause }
+func (e PeersBanListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExportRequestValidationError) Key() bool { return e.key }
+func (e PeersBanListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExportRequestValidationError) ErrorName() string { return "ExportRequestValidationError" }
+func (e PeersBanListResponseValidationError) ErrorName() string {
+	return "PeersBanListResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExportRequestValidationError) Error() string {
+func (e PeersBanListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1196,14 +1352,14 @@ func (e ExportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExportRequest.%s: %s%s",
+		"invalid %sPeersBanListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExportRequestValidationError{}
+var _ error = PeersBanListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1211,51 +1367,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExportRequestValidationError{}
+} = PeersBanListResponseValidationError{}
 
-// Validate checks the field values on ExportEvent with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ExportEvent) Validate() error {
+// Validate checks the field values on PeersUnbanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PeersUnbanRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExportEvent with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExportEventMultiError, or
-// nil if none found.
-func (m *ExportEvent) ValidateAll() error {
+// ValidateAll checks the field values on PeersUnbanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PeersUnbanRequestMultiError, or nil if none found.
+func (m *PeersUnbanRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExportEvent) validate(all bool) error {
+func (m *PeersUnbanRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for From
-
-	// no validation rules for To
-
-	// no validation rules for Latest
-
-	// no validation rules for Data
+	if utf8.RuneCountInString(m.GetTarget()) < 1 {
+		err := PeersUnbanRequestValidationError{
+			field:  "Target",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ExportEventMultiError(errors)
+		return PeersUnbanRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExportEventMultiError is an error wrapping multiple validation errors
-// returned by ExportEvent.ValidateAll() if the designated constraints aren't met.
-type ExportEventMultiError []error
+// PeersUnbanRequestMultiError is an error wrapping multiple validation errors
+// returned by PeersUnbanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PeersUnbanRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExportEventMultiError) Error() string {
+func (m PeersUnbanRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1264,9 +1424,543 @@ func (m ExportEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExportEventMultiError) AllErrors() []error { return m }
+func (m PeersUnbanRequestMultiError) AllErrors() []error { return m }
 
-// ExportEventValidationError is the validation error returned by
+// PeersUnbanRequestValidationError is the validation error returned by
+// PeersUnbanRequest.Validate if the designated constraints aren't met.
+type PeersUnbanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeersUnbanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeersUnbanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeersUnbanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeersUnbanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeersUnbanRequestValidationError) ErrorName() string {
+	return "PeersUnbanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeersUnbanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeersUnbanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeersUnbanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeersUnbanRequestValidationError{}
+
+// Validate checks the field values on PeersTrustRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PeersTrustRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PeersTrustRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PeersTrustRequestMultiError, or nil if none found.
+func (m *PeersTrustRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PeersTrustRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PeersTrustRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := PeersTrustRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9]{1,}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PeersTrustRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PeersTrustRequestMultiError is an error wrapping multiple validation errors
+// returned by PeersTrustRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PeersTrustRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PeersTrustRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PeersTrustRequestMultiError) AllErrors() []error { return m }
+
+// PeersTrustRequestValidationError is the validation error returned by
+// PeersTrustRequest.Validate if the designated constraints aren't met.
+type PeersTrustRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PeersTrustRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PeersTrustRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PeersTrustRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PeersTrustRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PeersTrustRequestValidationError) ErrorName() string {
+	return "PeersTrustRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PeersTrustRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeersTrustRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PeersTrustRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PeersTrustRequestValidationError{}
+
+var _PeersTrustRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9]{1,}$")
+
+// Validate checks the field values on BlockByNumberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BlockByNumberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockByNumberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockByNumberRequestMultiError, or nil if none found.
+func (m *BlockByNumberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockByNumberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return BlockByNumberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockByNumberRequestMultiError is an error wrapping multiple validation
+// errors returned by BlockByNumberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BlockByNumberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockByNumberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockByNumberRequestMultiError) AllErrors() []error { return m }
+
+// BlockByNumberRequestValidationError is the validation error returned by
+// BlockByNumberRequest.Validate if the designated constraints aren't met.
+type BlockByNumberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockByNumberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockByNumberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockByNumberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockByNumberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockByNumberRequestValidationError) ErrorName() string {
+	return "BlockByNumberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockByNumberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockByNumberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockByNumberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockByNumberRequestValidationError{}
+
+// Validate checks the field values on BlockResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockResponseMultiError, or
+// nil if none found.
+func (m *BlockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return BlockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockResponseMultiError is an error wrapping multiple validation errors
+// returned by BlockResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BlockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockResponseMultiError) AllErrors() []error { return m }
+
+// BlockResponseValidationError is the validation error returned by
+// BlockResponse.Validate if the designated constraints aren't met.
+type BlockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockResponseValidationError) ErrorName() string { return "BlockResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockResponseValidationError{}
+
+// Validate checks the field values on ExportRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportRequestMultiError, or
+// nil if none found.
+func (m *ExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if len(errors) > 0 {
+		return ExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportRequestMultiError) AllErrors() []error { return m }
+
+// ExportRequestValidationError is the validation error returned by
+// ExportRequest.Validate if the designated constraints aren't met.
+type ExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRequestValidationError) ErrorName() string { return "ExportRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRequestValidationError{}
+
+// Validate checks the field values on ExportEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportEventMultiError, or
+// nil if none found.
+func (m *ExportEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Latest
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return ExportEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportEventMultiError is an error wrapping multiple validation errors
+// returned by ExportEvent.ValidateAll() if the designated constraints aren't met.
+type ExportEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportEventMultiError) AllErrors() []error { return m }
+
+// ExportEventValidationError is the validation error returned by
 // ExportEvent.Validate if the designated constraints aren't met.
 type ExportEventValidationError struct {
 	field  string
@@ -1531,3 +2225,320 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ServerStatus_BlockValidationError{}
+
+// Validate checks the field values on Peer_Head with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Peer_Head) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Peer_Head with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Peer_HeadMultiError, or nil
+// if none found.
+func (m *Peer_Head) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Peer_Head) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return Peer_HeadMultiError(errors)
+	}
+
+	return nil
+}
+
+// Peer_HeadMultiError is an error wrapping multiple validation errors returned
+// by Peer_Head.ValidateAll() if the designated constraints aren't met.
+type Peer_HeadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Peer_HeadMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Peer_HeadMultiError) AllErrors() []error { return m }
+
+// Peer_HeadValidationError is the validation error returned by
+// Peer_Head.Validate if the designated constraints aren't met.
+type Peer_HeadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Peer_HeadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Peer_HeadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Peer_HeadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Peer_HeadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Peer_HeadValidationError) ErrorName() string {
+	return "Peer_HeadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Peer_HeadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeer_Head.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Peer_HeadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Peer_HeadValidationError{}
+
+// Validate checks the field values on Peer_ProtocolBandwidth with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Peer_ProtocolBandwidth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Peer_ProtocolBandwidth with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Peer_ProtocolBandwidthMultiError, or nil if none found.
+func (m *Peer_ProtocolBandwidth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Peer_ProtocolBandwidth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Protocol
+
+	// no validation rules for BytesIn
+
+	// no validation rules for BytesOut
+
+	if len(errors) > 0 {
+		return Peer_ProtocolBandwidthMultiError(errors)
+	}
+
+	return nil
+}
+
+// Peer_ProtocolBandwidthMultiError is an error wrapping multiple validation
+// errors returned by Peer_ProtocolBandwidth.ValidateAll() if the designated
+// constraints aren't met.
+type Peer_ProtocolBandwidthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Peer_ProtocolBandwidthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Peer_ProtocolBandwidthMultiError) AllErrors() []error { return m }
+
+// Peer_ProtocolBandwidthValidationError is the validation error returned by
+// Peer_ProtocolBandwidth.Validate if the designated constraints aren't met.
+type Peer_ProtocolBandwidthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Peer_ProtocolBandwidthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Peer_ProtocolBandwidthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Peer_ProtocolBandwidthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Peer_ProtocolBandwidthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Peer_ProtocolBandwidthValidationError) ErrorName() string {
+	return "Peer_ProtocolBandwidthValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Peer_ProtocolBandwidthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeer_ProtocolBandwidth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Peer_ProtocolBandwidthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Peer_ProtocolBandwidthValidationError{}
+
+// Validate checks the field values on Peer_TopicMessages with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Peer_TopicMessages) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Peer_TopicMessages with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Peer_TopicMessagesMultiError, or nil if none found.
+func (m *Peer_TopicMessages) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Peer_TopicMessages) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Topic
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return Peer_TopicMessagesMultiError(errors)
+	}
+
+	return nil
+}
+
+// Peer_TopicMessagesMultiError is an error wrapping multiple validation errors
+// returned by Peer_TopicMessages.ValidateAll() if the designated constraints
+// aren't met.
+type Peer_TopicMessagesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Peer_TopicMessagesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Peer_TopicMessagesMultiError) AllErrors() []error { return m }
+
+// Peer_TopicMessagesValidationError is the validation error returned by
+// Peer_TopicMessages.Validate if the designated constraints aren't met.
+type Peer_TopicMessagesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Peer_TopicMessagesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Peer_TopicMessagesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Peer_TopicMessagesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Peer_TopicMessagesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Peer_TopicMessagesValidationError) ErrorName() string {
+	return "Peer_TopicMessagesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Peer_TopicMessagesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPeer_TopicMessages.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Peer_TopicMessagesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Peer_TopicMessagesValidationError{}
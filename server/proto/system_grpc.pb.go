@@ -31,12 +31,40 @@ type SystemClient interface {
 	PeersList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersListResponse, error)
 	// PeersInfo returns the info of a peer
 	PeersStatus(ctx context.Context, in *PeersStatusRequest, opts ...grpc.CallOption) (*Peer, error)
+	// PeersBan bans a peer ID, IP address or CIDR range
+	PeersBan(ctx context.Context, in *PeersBanRequest, opts ...grpc.CallOption) (*Ban, error)
+	// PeersBanList returns the list of active bans
+	PeersBanList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersBanListResponse, error)
+	// PeersUnban lifts the ban of a peer ID, IP address or CIDR range
+	PeersUnban(ctx context.Context, in *PeersUnbanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PeersTrust marks a peer as trusted
+	PeersTrust(ctx context.Context, in *PeersTrustRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Subscribe subscribes to blockchain events
 	Subscribe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribeClient, error)
 	// Export returns blockchain data
 	BlockByNumber(ctx context.Context, in *BlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// Export returns blockchain data
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (System_ExportClient, error)
+	// ExportSnapshot returns the state snapshot
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (System_ExportSnapshotClient, error)
+	// DumpState returns the accounts of the state in the genesis alloc format
+	DumpState(ctx context.Context, in *DumpStateRequest, opts ...grpc.CallOption) (System_DumpStateClient, error)
+	// AdminSetLogLevel sets the log level of a module, or the default log level
+	AdminSetLogLevel(ctx context.Context, in *AdminSetLogLevelRequest, opts ...grpc.CallOption) (*AdminLogLevels, error)
+	// AdminLogLevels returns the default log level and the log levels of the modules
+	AdminLogLevels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AdminLogLevels, error)
+	// AdminDropPeer disconnects a peer
+	AdminDropPeer(ctx context.Context, in *AdminDropPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AdminRemoveTx removes a transaction and the following transactions of its sender from the pool
+	AdminRemoveTx(ctx context.Context, in *AdminTxRequest, opts ...grpc.CallOption) (*AdminTxsResponse, error)
+	// AdminRebroadcastTx gossips a pool transaction to the peers again
+	AdminRebroadcastTx(ctx context.Context, in *AdminTxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AdminFlushAccount drops all the transactions of an account from the pool
+	AdminFlushAccount(ctx context.Context, in *AdminFlushAccountRequest, opts ...grpc.CallOption) (*AdminTxsResponse, error)
+	// AdminSetBlockProduction pauses or resumes the block production
+	AdminSetBlockProduction(ctx context.Context, in *AdminSetBlockProductionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AdminCompact compacts the databases of the node
+	AdminCompact(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AdminCompactResponse, error)
 }
 
 type systemClient struct {
@@ -83,6 +111,42 @@ func (c *systemClient) PeersStatus(ctx context.Context, in *PeersStatusRequest,
 	return out, nil
 }
 
+func (c *systemClient) PeersBan(ctx context.Context, in *PeersBanRequest, opts ...grpc.CallOption) (*Ban, error) {
+	out := new(Ban)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersBanList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersBanListResponse, error) {
+	out := new(PeersBanListResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersBanList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersUnban(ctx context.Context, in *PeersUnbanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersUnban", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) PeersTrust(ctx context.Context, in *PeersTrustRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/PeersTrust", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *systemClient) Subscribe(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (System_SubscribeClient, error) {
 	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[0], "/v1.System/Subscribe", opts...)
 	if err != nil {
@@ -156,6 +220,142 @@ func (x *systemExportClient) Recv() (*ExportEvent, error) {
 	return m, nil
 }
 
+func (c *systemClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (System_ExportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[2], "/v1.System/ExportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systemExportSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type System_ExportSnapshotClient interface {
+	Recv() (*SnapshotChunk, error)
+	grpc.ClientStream
+}
+
+type systemExportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *systemExportSnapshotClient) Recv() (*SnapshotChunk, error) {
+	m := new(SnapshotChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *systemClient) DumpState(ctx context.Context, in *DumpStateRequest, opts ...grpc.CallOption) (System_DumpStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[3], "/v1.System/DumpState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systemDumpStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type System_DumpStateClient interface {
+	Recv() (*DumpStateChunk, error)
+	grpc.ClientStream
+}
+
+type systemDumpStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *systemDumpStateClient) Recv() (*DumpStateChunk, error) {
+	m := new(DumpStateChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *systemClient) AdminSetLogLevel(ctx context.Context, in *AdminSetLogLevelRequest, opts ...grpc.CallOption) (*AdminLogLevels, error) {
+	out := new(AdminLogLevels)
+	err := c.cc.Invoke(ctx, "/v1.System/AdminSetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) AdminLogLevels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AdminLogLevels, error) {
+	out := new(AdminLogLevels)
+	err := c.cc.Invoke(ctx, "/v1.System/AdminLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) AdminDropPeer(ctx context.Context, in *AdminDropPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/AdminDropPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) AdminRemoveTx(ctx context.Context, in *AdminTxRequest, opts ...grpc.CallOption) (*AdminTxsResponse, error) {
+	out := new(AdminTxsResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/AdminRemoveTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) AdminRebroadcastTx(ctx context.Context, in *AdminTxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/AdminRebroadcastTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) AdminFlushAccount(ctx context.Context, in *AdminFlushAccountRequest, opts ...grpc.CallOption) (*AdminTxsResponse, error) {
+	out := new(AdminTxsResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/AdminFlushAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) AdminSetBlockProduction(ctx context.Context, in *AdminSetBlockProductionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/v1.System/AdminSetBlockProduction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) AdminCompact(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AdminCompactResponse, error) {
+	out := new(AdminCompactResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/AdminCompact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemServer is the server API for System service.
 // All implementations must embed UnimplementedSystemServer
 // for forward compatibility
@@ -168,12 +368,40 @@ type SystemServer interface {
 	PeersList(context.Context, *emptypb.Empty) (*PeersListResponse, error)
 	// PeersInfo returns the info of a peer
 	PeersStatus(context.Context, *PeersStatusRequest) (*Peer, error)
+	// PeersBan bans a peer ID, IP address or CIDR range
+	PeersBan(context.Context, *PeersBanRequest) (*Ban, error)
+	// PeersBanList returns the list of active bans
+	PeersBanList(context.Context, *emptypb.Empty) (*PeersBanListResponse, error)
+	// PeersUnban lifts the ban of a peer ID, IP address or CIDR range
+	PeersUnban(context.Context, *PeersUnbanRequest) (*emptypb.Empty, error)
+	// PeersTrust marks a peer as trusted
+	PeersTrust(context.Context, *PeersTrustRequest) (*emptypb.Empty, error)
 	// Subscribe subscribes to blockchain events
 	Subscribe(*emptypb.Empty, System_SubscribeServer) error
 	// Export returns blockchain data
 	BlockByNumber(context.Context, *BlockByNumberRequest) (*BlockResponse, error)
 	// Export returns blockchain data
 	Export(*ExportRequest, System_ExportServer) error
+	// ExportSnapshot returns the state snapshot
+	ExportSnapshot(*ExportSnapshotRequest, System_ExportSnapshotServer) error
+	// DumpState returns the accounts of the state in the genesis alloc format
+	DumpState(*DumpStateRequest, System_DumpStateServer) error
+	// AdminSetLogLevel sets the log level of a module, or the default log level
+	AdminSetLogLevel(context.Context, *AdminSetLogLevelRequest) (*AdminLogLevels, error)
+	// AdminLogLevels returns the default log level and the log levels of the modules
+	AdminLogLevels(context.Context, *emptypb.Empty) (*AdminLogLevels, error)
+	// AdminDropPeer disconnects a peer
+	AdminDropPeer(context.Context, *AdminDropPeerRequest) (*emptypb.Empty, error)
+	// AdminRemoveTx removes a transaction and the following transactions of its sender from the pool
+	AdminRemoveTx(context.Context, *AdminTxRequest) (*AdminTxsResponse, error)
+	// AdminRebroadcastTx gossips a pool transaction to the peers again
+	AdminRebroadcastTx(context.Context, *AdminTxRequest) (*emptypb.Empty, error)
+	// AdminFlushAccount drops all the transactions of an account from the pool
+	AdminFlushAccount(context.Context, *AdminFlushAccountRequest) (*AdminTxsResponse, error)
+	// AdminSetBlockProduction pauses or resumes the block production
+	AdminSetBlockProduction(context.Context, *AdminSetBlockProductionRequest) (*emptypb.Empty, error)
+	// AdminCompact compacts the databases of the node
+	AdminCompact(context.Context, *emptypb.Empty) (*AdminCompactResponse, error)
 	mustEmbedUnimplementedSystemServer()
 }
 
@@ -193,6 +421,18 @@ func (UnimplementedSystemServer) PeersList(context.Context, *emptypb.Empty) (*Pe
 func (UnimplementedSystemServer) PeersStatus(context.Context, *PeersStatusRequest) (*Peer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeersStatus not implemented")
 }
+func (UnimplementedSystemServer) PeersBan(context.Context, *PeersBanRequest) (*Ban, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersBan not implemented")
+}
+func (UnimplementedSystemServer) PeersBanList(context.Context, *emptypb.Empty) (*PeersBanListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersBanList not implemented")
+}
+func (UnimplementedSystemServer) PeersUnban(context.Context, *PeersUnbanRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersUnban not implemented")
+}
+func (UnimplementedSystemServer) PeersTrust(context.Context, *PeersTrustRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeersTrust not implemented")
+}
 func (UnimplementedSystemServer) Subscribe(*emptypb.Empty, System_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
@@ -202,6 +442,36 @@ func (UnimplementedSystemServer) BlockByNumber(context.Context, *BlockByNumberRe
 func (UnimplementedSystemServer) Export(*ExportRequest, System_ExportServer) error {
 	return status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSystemServer) ExportSnapshot(*ExportSnapshotRequest, System_ExportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
+func (UnimplementedSystemServer) DumpState(*DumpStateRequest, System_DumpStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method DumpState not implemented")
+}
+func (UnimplementedSystemServer) AdminSetLogLevel(context.Context, *AdminSetLogLevelRequest) (*AdminLogLevels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSetLogLevel not implemented")
+}
+func (UnimplementedSystemServer) AdminLogLevels(context.Context, *emptypb.Empty) (*AdminLogLevels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLogLevels not implemented")
+}
+func (UnimplementedSystemServer) AdminDropPeer(context.Context, *AdminDropPeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDropPeer not implemented")
+}
+func (UnimplementedSystemServer) AdminRemoveTx(context.Context, *AdminTxRequest) (*AdminTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRemoveTx not implemented")
+}
+func (UnimplementedSystemServer) AdminRebroadcastTx(context.Context, *AdminTxRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRebroadcastTx not implemented")
+}
+func (UnimplementedSystemServer) AdminFlushAccount(context.Context, *AdminFlushAccountRequest) (*AdminTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminFlushAccount not implemented")
+}
+func (UnimplementedSystemServer) AdminSetBlockProduction(context.Context, *AdminSetBlockProductionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSetBlockProduction not implemented")
+}
+func (UnimplementedSystemServer) AdminCompact(context.Context, *emptypb.Empty) (*AdminCompactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCompact not implemented")
+}
 func (UnimplementedSystemServer) mustEmbedUnimplementedSystemServer() {}
 
 // UnsafeSystemServer may be embedded to opt out of forward compatibility for this service.
@@ -287,6 +557,78 @@ func _System_PeersStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _System_PeersBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersBan(ctx, req.(*PeersBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersBanList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersBanList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersBanList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersBanList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersUnban_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersUnbanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersUnban(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersUnban",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersUnban(ctx, req.(*PeersUnbanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_PeersTrust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersTrustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).PeersTrust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/PeersTrust",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).PeersTrust(ctx, req.(*PeersTrustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _System_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(emptypb.Empty)
 	if err := stream.RecvMsg(m); err != nil {
@@ -347,6 +689,192 @@ func (x *systemExportServer) Send(m *ExportEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _System_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemServer).ExportSnapshot(m, &systemExportSnapshotServer{stream})
+}
+
+type System_ExportSnapshotServer interface {
+	Send(*SnapshotChunk) error
+	grpc.ServerStream
+}
+
+type systemExportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *systemExportSnapshotServer) Send(m *SnapshotChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _System_DumpState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DumpStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemServer).DumpState(m, &systemDumpStateServer{stream})
+}
+
+type System_DumpStateServer interface {
+	Send(*DumpStateChunk) error
+	grpc.ServerStream
+}
+
+type systemDumpStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *systemDumpStateServer) Send(m *DumpStateChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _System_AdminSetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).AdminSetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/AdminSetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).AdminSetLogLevel(ctx, req.(*AdminSetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_AdminLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).AdminLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/AdminLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).AdminLogLevels(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_AdminDropPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDropPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).AdminDropPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/AdminDropPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).AdminDropPeer(ctx, req.(*AdminDropPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_AdminRemoveTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).AdminRemoveTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/AdminRemoveTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).AdminRemoveTx(ctx, req.(*AdminTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_AdminRebroadcastTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).AdminRebroadcastTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/AdminRebroadcastTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).AdminRebroadcastTx(ctx, req.(*AdminTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_AdminFlushAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminFlushAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).AdminFlushAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/AdminFlushAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).AdminFlushAccount(ctx, req.(*AdminFlushAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_AdminSetBlockProduction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSetBlockProductionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).AdminSetBlockProduction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/AdminSetBlockProduction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).AdminSetBlockProduction(ctx, req.(*AdminSetBlockProductionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_AdminCompact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).AdminCompact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/AdminCompact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).AdminCompact(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // System_ServiceDesc is the grpc.ServiceDesc for System service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -370,10 +898,58 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PeersStatus",
 			Handler:    _System_PeersStatus_Handler,
 		},
+		{
+			MethodName: "PeersBan",
+			Handler:    _System_PeersBan_Handler,
+		},
+		{
+			MethodName: "PeersBanList",
+			Handler:    _System_PeersBanList_Handler,
+		},
+		{
+			MethodName: "PeersUnban",
+			Handler:    _System_PeersUnban_Handler,
+		},
+		{
+			MethodName: "PeersTrust",
+			Handler:    _System_PeersTrust_Handler,
+		},
 		{
 			MethodName: "BlockByNumber",
 			Handler:    _System_BlockByNumber_Handler,
 		},
+		{
+			MethodName: "AdminSetLogLevel",
+			Handler:    _System_AdminSetLogLevel_Handler,
+		},
+		{
+			MethodName: "AdminLogLevels",
+			Handler:    _System_AdminLogLevels_Handler,
+		},
+		{
+			MethodName: "AdminDropPeer",
+			Handler:    _System_AdminDropPeer_Handler,
+		},
+		{
+			MethodName: "AdminRemoveTx",
+			Handler:    _System_AdminRemoveTx_Handler,
+		},
+		{
+			MethodName: "AdminRebroadcastTx",
+			Handler:    _System_AdminRebroadcastTx_Handler,
+		},
+		{
+			MethodName: "AdminFlushAccount",
+			Handler:    _System_AdminFlushAccount_Handler,
+		},
+		{
+			MethodName: "AdminSetBlockProduction",
+			Handler:    _System_AdminSetBlockProduction_Handler,
+		},
+		{
+			MethodName: "AdminCompact",
+			Handler:    _System_AdminCompact_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -386,6 +962,16 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _System_Export_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportSnapshot",
+			Handler:       _System_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DumpState",
+			Handler:       _System_DumpState_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "server/proto/system.proto",
 }
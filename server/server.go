@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-hclog"
+	libp2pNetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/umbracle/ethgo"
@@ -23,8 +25,10 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/blocksink"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
+	consensusDev "github.com/0xPolygon/polygon-edge/consensus/dev"
 	polyCommon "github.com/0xPolygon/polygon-edge/consensus/polybft/common"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/statesyncrelayer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
@@ -32,10 +36,15 @@ import (
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/gasprice"
+	"github.com/0xPolygon/polygon-edge/health"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/logging"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/0xPolygon/polygon-edge/light"
 	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/remotesigner"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/state"
@@ -43,11 +52,15 @@ import (
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validate"
 )
 
+// checkpointBootstrapTimeout is the time the peers have to provide the headers up to the checkpoint
+const checkpointBootstrapTimeout = 2 * time.Minute
+
 var (
 	errBlockTimeMissing = errors.New("block time configuration is missing")
 	errBlockTimeInvalid = errors.New("block time configuration is invalid")
@@ -56,10 +69,15 @@ var (
 // Server is the central manager of the blockchain client
 type Server struct {
 	logger       hclog.Logger
+	logLevels    *logging.Levels
 	config       *Config
 	state        state.State
 	stateStorage itrie.Storage
 
+	// the local databases of the blockchain and the state, compacted on the operator request
+	chainDB storage.Storage
+	stateDB itrie.Storage
+
 	consensus consensus.Consensus
 
 	// blockchain stack
@@ -82,10 +100,26 @@ type Server struct {
 	txpool *txpool.TxPool
 
 	prometheusServer *http.Server
+	prometheusMux    *http.ServeMux
+
+	// health checks the health and the readiness of the node for the orchestrators
+	health *health.Checker
+
+	// blockTime is the expected block time of the chain, zero if the blocks are not produced regularly
+	blockTime time.Duration
+
+	// shutdownTracing flushes the remaining spans to the exporter
+	shutdownTracing func(context.Context) error
+
+	// blockSink publishes the finalized blocks to the external indexers
+	blockSink *blocksink.Stream
 
 	// secrets manager
 	secretsManager secrets.SecretsManager
 
+	// remote signer holding the validator keys, if set
+	remoteSigner *remotesigner.Client
+
 	// restore
 	restoreProgression *progress.ProgressionWrapper
 
@@ -94,27 +128,41 @@ type Server struct {
 
 	// gasHelper is providing functions regarding gas and fees
 	gasHelper *gasprice.GasHelper
+
+	// lightClient fetches the block bodies, receipts and state from the full peers (light node exclusive)
+	lightClient *light.Client
+
+	// lightSyncer syncs the block headers (light node exclusive)
+	lightSyncer syncer.LightSyncer
+
+	// lightService serves the block bodies, receipts and state to the light nodes (full node exclusive)
+	lightService *light.Service
+
+	// forkClient reads the state of the forked chain (dev fork mode exclusive)
+	forkClient *itrie.JSONRPCForkClient
 }
 
 // newFileLogger returns logger instance that writes all logs to a specified file.
 // If log file can't be created, it returns an error
-func newFileLogger(config *Config) (hclog.Logger, error) {
+func newFileLogger(config *Config) (hclog.Logger, *logging.Levels, error) {
 	logFileWriter, err := os.Create(config.LogFilePath)
 	if err != nil {
-		return nil, fmt.Errorf("could not create log file, %w", err)
+		return nil, nil, fmt.Errorf("could not create log file, %w", err)
 	}
 
-	return hclog.New(&hclog.LoggerOptions{
+	logger, levels := logging.NewLogger(&hclog.LoggerOptions{
 		Name:       "polygon",
 		Level:      config.LogLevel,
 		Output:     logFileWriter,
 		JSONFormat: config.JSONLogFormat,
-	}), nil
+	})
+
+	return logger, levels, nil
 }
 
 // newCLILogger returns minimal logger instance that sends all logs to standard output
-func newCLILogger(config *Config) hclog.Logger {
-	return hclog.New(&hclog.LoggerOptions{
+func newCLILogger(config *Config) (hclog.Logger, *logging.Levels) {
+	return logging.NewLogger(&hclog.LoggerOptions{
 		Name:       "polygon",
 		Level:      config.LogLevel,
 		JSONFormat: config.JSONLogFormat,
@@ -123,29 +171,28 @@ func newCLILogger(config *Config) hclog.Logger {
 
 // newLoggerFromConfig creates a new logger which logs to a specified file.
 // If log file is not set it outputs to standard output ( console ).
-// If log file is specified, and it can't be created the server command will error out
-func newLoggerFromConfig(config *Config) (hclog.Logger, error) {
+// If log file is specified, and it can't be created the server command will error out.
+// The log levels of the logger modules can be changed with the returned levels
+func newLoggerFromConfig(config *Config) (hclog.Logger, *logging.Levels, error) {
 	if config.LogFilePath != "" {
-		fileLoggerInstance, err := newFileLogger(config)
-		if err != nil {
-			return nil, err
-		}
-
-		return fileLoggerInstance, nil
+		return newFileLogger(config)
 	}
 
-	return newCLILogger(config), nil
+	logger, levels := newCLILogger(config)
+
+	return logger, levels, nil
 }
 
 // NewServer creates a new Minimal server, using the passed in configuration
 func NewServer(config *Config) (*Server, error) {
-	logger, err := newLoggerFromConfig(config)
+	logger, logLevels, err := newLoggerFromConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("could not setup new logger instance, %w", err)
 	}
 
 	m := &Server{
 		logger:             logger.Named("server"),
+		logLevels:          logLevels,
 		config:             config,
 		chain:              config.Chain,
 		grpcServer:         grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor)),
@@ -183,6 +230,17 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, fmt.Errorf("failed to set up the secrets manager: %w", err)
 	}
 
+	// Set up the remote signer
+	if m.config.RemoteSignerURL != "" {
+		remoteSigner, err := remotesigner.NewClient(m.config.RemoteSignerURL, remotesigner.DefaultRequestTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the remote signer: %w", err)
+		}
+
+		m.logger.Info("using remote signer", "url", m.config.RemoteSignerURL, "address", remoteSigner.Address())
+		m.remoteSigner = remoteSigner
+	}
+
 	// start libp2p
 	{
 		netConfig := config.Network
@@ -197,48 +255,48 @@ func NewServer(config *Config) (*Server, error) {
 		m.network = network
 	}
 
+	if err := m.setupTracing(); err != nil {
+		return nil, err
+	}
+
+	if config.Light {
+		m.lightClient = light.NewClient(logger, m.network)
+	}
+
 	// start blockchain object
-	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(m.config.DataDir, "trie"), logger)
+	var stateStorage itrie.Storage
+
+	stateStorage, err = itrie.NewLevelDBStorage(filepath.Join(m.config.DataDir, "trie"), logger)
 	if err != nil {
 		return nil, err
 	}
 
+	m.stateDB = stateStorage
+
+	if config.Light {
+		// the state missing locally is fetched from the full peers
+		stateStorage = light.NewStateStorage(logger, stateStorage, m.lightClient)
+	}
+
 	m.stateStorage = stateStorage
 
 	st := itrie.NewState(stateStorage)
-	m.state = st
 
-	m.executor = state.NewExecutor(config.Chain.Params, st, logger)
-
-	// custom write genesis hook per consensus engine
-	engineName := m.config.Chain.Params.GetEngine()
-	if factory, exists := genesisCreationFactory[ConsensusType(engineName)]; exists {
-		m.executor.GenesisPostHook = factory(m.config.Chain, engineName)
+	if config.ForkURL != "" {
+		if st, err = m.setupForkState(stateStorage); err != nil {
+			return nil, fmt.Errorf("failed to set up the fork mode: %w", err)
+		}
 	}
 
-	// apply allow list contracts deployer genesis data
-	addresslist.ApplyGenesisAllocs(m.config.Chain.Genesis, contracts.AllowListContractsAddr,
-		m.config.Chain.Params.ContractDeployerAllowList, m.config.Chain.Params.AccessListsOwner)
-
-	// apply block list contracts deployer genesis data
-	addresslist.ApplyGenesisAllocs(m.config.Chain.Genesis, contracts.BlockListContractsAddr,
-		m.config.Chain.Params.ContractDeployerBlockList, m.config.Chain.Params.AccessListsOwner)
-
-	// apply transactions execution allow list genesis data
-	addresslist.ApplyGenesisAllocs(m.config.Chain.Genesis, contracts.AllowListTransactionsAddr,
-		m.config.Chain.Params.TransactionsAllowList, m.config.Chain.Params.AccessListsOwner)
+	if config.Preimages {
+		st.EnablePreimages()
+	}
 
-	// apply transactions execution block list genesis data
-	addresslist.ApplyGenesisAllocs(m.config.Chain.Genesis, contracts.BlockListTransactionsAddr,
-		m.config.Chain.Params.TransactionsBlockList, m.config.Chain.Params.AccessListsOwner)
+	m.state = st
 
-	// apply bridge allow list genesis data (owner is omitted for bridge allow list)
-	addresslist.ApplyGenesisAllocs(m.config.Chain.Genesis, contracts.AllowListBridgeAddr,
-		m.config.Chain.Params.BridgeAllowList, m.config.Chain.Params.AccessListsOwner)
+	m.executor = NewGenesisExecutor(config.Chain, st, logger)
 
-	// apply bridge block list genesis data (owner is omitted for bridge block list)
-	addresslist.ApplyGenesisAllocs(m.config.Chain.Genesis, contracts.BlockListBridgeAddr,
-		m.config.Chain.Params.BridgeBlockList, m.config.Chain.Params.AccessListsOwner)
+	engineName := m.config.Chain.Params.GetEngine()
 
 	var initialStateRoot = types.ZeroHash
 
@@ -303,6 +361,13 @@ func NewServer(config *Config) (*Server, error) {
 				return nil, err
 			}
 		}
+
+		m.chainDB = db
+
+		if config.Light {
+			// the block bodies and receipts missing locally are fetched from the full peers
+			db = light.NewChainStorage(logger, db, m.lightClient)
+		}
 	}
 
 	// blockchain object
@@ -361,6 +426,9 @@ func NewServer(config *Config) (*Server, error) {
 		m.blockchain.SetConsensus(m.consensus)
 	}
 
+	// the genesis is written to the empty storage
+	genesisWritten := m.blockchain.Header() == nil
+
 	// after consensus is done, we can mine the genesis block in blockchain
 	// This is done because consensus might use a custom Hash function so we need
 	// to wait for consensus because we do any block hashing like genesis
@@ -368,6 +436,34 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	if m.config.Checkpoint != nil {
+		if err := m.blockchain.SetCheckpoint(m.config.Checkpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	checkpointFrom, bootstrap := m.checkpointHeadersFrom()
+
+	if config.Light || bootstrap {
+		// the light node needs the peers to initialize the consensus layer from the state it does not have,
+		// and the empty chain started from the checkpoint needs them to fetch the checkpoint
+		if err := m.network.Start(); err != nil {
+			return nil, err
+		}
+	}
+
+	if bootstrap {
+		if err := m.bootstrapCheckpoint(checkpointFrom); err != nil {
+			return nil, err
+		}
+	}
+
+	m.setupPreimages(genesisWritten, bootstrap)
+
+	if m.config.LogIndex {
+		m.blockchain.EnableLogIndex()
+	}
+
 	// initialize data in consensus layer
 	if err := m.consensus.Initialize(); err != nil {
 		return nil, err
@@ -378,7 +474,15 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
-	if err := m.network.Start(); err != nil {
+	if !config.Light && !bootstrap {
+		if err := m.network.Start(); err != nil {
+			return nil, err
+		}
+	}
+
+	m.setupHealth()
+
+	if err := m.setupBlockSink(); err != nil {
 		return nil, err
 	}
 
@@ -392,11 +496,24 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	if config.Light {
+		// the light node only syncs the headers, without running the consensus nor the txpool
+		if err := m.startLightSyncer(); err != nil {
+			return nil, err
+		}
+
+		return m, nil
+	}
+
 	// start consensus
 	if err := m.consensus.Start(); err != nil {
 		return nil, err
 	}
 
+	// serve the light nodes
+	m.lightService = light.NewService(m.network, m.blockchain, m.stateStorage)
+	m.lightService.Start()
+
 	// start relayer
 	if config.Relayer {
 		if err := m.setupRelayer(); err != nil {
@@ -407,6 +524,13 @@ func NewServer(config *Config) (*Server, error) {
 	m.txpool.SetBaseFee(m.blockchain.Header())
 	m.txpool.Start()
 
+	// publish the finalized blocks, once the node is running
+	if m.blockSink != nil {
+		if err := m.blockSink.Start(); err != nil {
+			return nil, fmt.Errorf("failed to start the block sink: %w", err)
+		}
+	}
+
 	return m, nil
 }
 
@@ -499,9 +623,9 @@ func (s *Server) setupSecretsManager() error {
 		Logger: s.logger,
 	}
 
-	if secretsManagerType == secrets.Local {
+	if secretsManagerType == secrets.Local || secretsManagerType == secrets.EncryptedLocal {
 		// Only the base directory is required for
-		// the local secrets manager
+		// the local secrets managers
 		secretsManagerParams.Extra = map[string]interface{}{
 			secrets.Path: s.config.DataDir,
 		}
@@ -571,6 +695,7 @@ func (s *Server) setupConsensus() error {
 			Grpc:                  s.grpcServer,
 			Logger:                s.logger,
 			SecretsManager:        s.secretsManager,
+			RemoteSigner:          s.remoteSigner,
 			BlockTime:             uint64(blockTime.Seconds()),
 			NumBlockConfirmations: s.config.NumBlockConfirmations,
 		},
@@ -581,10 +706,149 @@ func (s *Server) setupConsensus() error {
 	}
 
 	s.consensus = consensus
+	s.blockTime = blockTime.Duration
+
+	if s.config.Light {
+		s.lightSyncer = syncer.NewLightSyncer(
+			s.logger,
+			s.network,
+			s.blockchain,
+			blockTime.Duration*3,
+		)
+	}
 
 	return nil
 }
 
+// startLightSyncer starts syncing the headers from the peers in the background
+func (s *Server) startLightSyncer() error {
+	if err := s.lightSyncer.Start(); err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.lightSyncer.Sync(func(*types.Header) bool {
+			return false
+		}); err != nil {
+			s.logger.Error("light syncer stopped", "err", err)
+		}
+	}()
+
+	return nil
+}
+
+// NewGenesisExecutor creates the executor writing the genesis state of the chain the way the node does on start,
+// with the genesis hook of its consensus. The storage of the address lists is added to the alloc of the genesis
+func NewGenesisExecutor(config *chain.Chain, st state.State, logger hclog.Logger) *state.Executor {
+	executor := state.NewExecutor(config.Params, st, logger)
+
+	// custom write genesis hook per consensus engine
+	engineName := config.Params.GetEngine()
+	if factory, exists := genesisCreationFactory[ConsensusType(engineName)]; exists {
+		executor.GenesisPostHook = factory(config, engineName)
+	}
+
+	// apply allow list contracts deployer genesis data
+	addresslist.ApplyGenesisAllocs(config.Genesis, contracts.AllowListContractsAddr,
+		config.Params.ContractDeployerAllowList, config.Params.AccessListsOwner)
+
+	// apply block list contracts deployer genesis data
+	addresslist.ApplyGenesisAllocs(config.Genesis, contracts.BlockListContractsAddr,
+		config.Params.ContractDeployerBlockList, config.Params.AccessListsOwner)
+
+	// apply transactions execution allow list genesis data
+	addresslist.ApplyGenesisAllocs(config.Genesis, contracts.AllowListTransactionsAddr,
+		config.Params.TransactionsAllowList, config.Params.AccessListsOwner)
+
+	// apply transactions execution block list genesis data
+	addresslist.ApplyGenesisAllocs(config.Genesis, contracts.BlockListTransactionsAddr,
+		config.Params.TransactionsBlockList, config.Params.AccessListsOwner)
+
+	// apply bridge allow list genesis data (owner is omitted for bridge allow list)
+	addresslist.ApplyGenesisAllocs(config.Genesis, contracts.AllowListBridgeAddr,
+		config.Params.BridgeAllowList, config.Params.AccessListsOwner)
+
+	// apply bridge block list genesis data (owner is omitted for bridge block list)
+	addresslist.ApplyGenesisAllocs(config.Genesis, contracts.BlockListBridgeAddr,
+		config.Params.BridgeBlockList, config.Params.AccessListsOwner)
+
+	return executor
+}
+
+// setupPreimages records whether the preimages of every state since the genesis are recorded,
+// which is required to dump the state
+func (s *Server) setupPreimages(genesisWritten, bootstrapped bool) {
+	switch {
+	case !s.config.Preimages || s.config.Light || s.config.ForkURL != "" || bootstrapped:
+		// the states fetched from the peers or the forked chain have no preimages
+		itrie.SetCompletePreimages(s.stateDB, false)
+	case genesisWritten:
+		itrie.SetCompletePreimages(s.stateDB, true)
+	case !itrie.HasCompletePreimages(s.stateDB):
+		s.logger.Warn("preimages are recorded for the new states only, the state can't be dumped " +
+			"unless the chain is synced from the genesis")
+	}
+}
+
+// checkpointHeadersFrom returns the number of the first header the empty chain is started from,
+// if it is going to be started from the checkpoint. Otherwise, the chain is synced from the genesis,
+// and the peers whose chain does not contain the checkpoint are not synced from
+func (s *Server) checkpointHeadersFrom() (uint64, bool) {
+	checkpoint := s.blockchain.Checkpoint()
+	if checkpoint == nil || checkpoint.Number == 0 || s.blockchain.Header().Number != 0 {
+		return 0, false
+	}
+
+	bootstrapper, ok := s.consensus.(consensus.CheckpointBootstrapper)
+	if !ok {
+		s.logger.Warn("consensus can't start the chain from the checkpoint, syncing from the genesis")
+
+		return 0, false
+	}
+
+	from, err := bootstrapper.CheckpointHeadersFrom(checkpoint.Number)
+	if err != nil {
+		s.logger.Warn("chain can't be started from the checkpoint, syncing from the genesis", "err", err)
+
+		return 0, false
+	}
+
+	return from, true
+}
+
+// bootstrapCheckpoint starts the empty chain from the checkpoint. The headers up to the checkpoint
+// are fetched from the peers, and the full node downloads the whole state of the checkpoint before,
+// which is resumed on the restart if interrupted
+func (s *Server) bootstrapCheckpoint(from uint64) error {
+	checkpoint := s.blockchain.Checkpoint()
+
+	s.logger.Info("fetching the headers of the checkpoint", "from", from, "checkpoint", checkpoint)
+
+	headers, err := syncer.FetchCheckpointHeaders(
+		s.logger,
+		s.network,
+		s.blockchain,
+		from,
+		checkpointBootstrapTimeout,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the headers of the checkpoint: %w", err)
+	}
+
+	if !s.config.Light {
+		// the light node fetches the state on demand
+		root := headers[len(headers)-1].StateRoot
+
+		s.logger.Info("downloading the state of the checkpoint", "root", root)
+
+		if err := light.NewClient(s.logger, s.network).SyncState(root, s.stateDB); err != nil {
+			return fmt.Errorf("failed to download the state of the checkpoint: %w", err)
+		}
+	}
+
+	return s.blockchain.WriteCheckpointHeaders(headers)
+}
+
 // extractBlockTime extracts blockTime parameter from consensus engine configuration.
 // If it is missing or invalid, an appropriate error is returned.
 func extractBlockTime(engineConfig map[string]interface{}) (common.Duration, error) {
@@ -613,9 +877,17 @@ func extractBlockTime(engineConfig map[string]interface{}) (common.Duration, err
 
 // setupRelayer sets up the relayer
 func (s *Server) setupRelayer() error {
-	account, err := wallet.NewAccountFromSecret(s.secretsManager)
-	if err != nil {
-		return fmt.Errorf("failed to create account from secret: %w", err)
+	var key *wallet.Key
+
+	if s.remoteSigner != nil {
+		key = wallet.NewKeyFromSigner(s.remoteSigner)
+	} else {
+		account, err := wallet.NewAccountFromSecret(s.secretsManager)
+		if err != nil {
+			return fmt.Errorf("failed to create account from secret: %w", err)
+		}
+
+		key = wallet.NewKey(account)
 	}
 
 	polyBFTConfig, err := polyCommon.GetPolyBFTConfig(s.config.Chain.Params)
@@ -634,7 +906,7 @@ func (s *Server) setupRelayer() error {
 		ethgo.Address(contracts.StateReceiverContract),
 		trackerStartBlockConfig[contracts.StateReceiverContract],
 		s.logger.Named("relayer"),
-		wallet.NewEcdsaSigner(wallet.NewKey(account)),
+		wallet.NewEcdsaSigner(key),
 		s.config.RelayerTrackerPollInterval,
 	)
 
@@ -663,6 +935,58 @@ func (j *jsonRPCHub) GetPeers() int {
 	return len(j.Server.Peers())
 }
 
+// GetPeersInfo returns the diagnostics of the connected peers which are not private,
+// along with their latest blocks known by the consensus syncer
+func (j *jsonRPCHub) GetPeersInfo() []*jsonrpc.PeerInfo {
+	diagnostics := j.Server.PublicPeersDiagnostics()
+
+	peers := make([]*jsonrpc.PeerInfo, len(diagnostics))
+	for i, d := range diagnostics {
+		info := &jsonrpc.PeerInfo{
+			ID:             d.ID.String(),
+			Addrs:          make([]string, len(d.Addrs)),
+			Protocols:      d.Protocols,
+			Inbound:        d.Direction == libp2pNetwork.DirInbound,
+			ConnectedAt:    d.ConnectedAt,
+			Latency:        d.Latency,
+			Distance:       d.Distance,
+			Score:          d.Score,
+			Bandwidth:      make([]jsonrpc.PeerProtocolBandwidth, len(d.Bandwidth)),
+			GossipMessages: make([]jsonrpc.PeerTopicMessages, len(d.GossipMessages)),
+		}
+
+		for k, addr := range d.Addrs {
+			info.Addrs[k] = addr.String()
+		}
+
+		if head, ok := syncPeerHead(j.Consensus, d.ID); ok {
+			info.HeadBlock = &head
+		}
+
+		for k, bandwidth := range d.Bandwidth {
+			info.Bandwidth[k] = jsonrpc.PeerProtocolBandwidth(bandwidth)
+		}
+
+		for k, messages := range d.GossipMessages {
+			info.GossipMessages[k] = jsonrpc.PeerTopicMessages(messages)
+		}
+
+		peers[i] = info
+	}
+
+	return peers
+}
+
+// syncPeerHead returns the latest block number reported by the peer to the consensus syncer, if known
+func syncPeerHead(engine consensus.Consensus, peerID peer.ID) (uint64, bool) {
+	provider, ok := engine.(consensus.SyncPeerHeadProvider)
+	if !ok {
+		return 0, false
+	}
+
+	return provider.GetSyncPeerHead(peerID)
+}
+
 func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.Account, error) {
 	acct, err := getAccountImpl(j.state, root, addr)
 	if err != nil {
@@ -693,7 +1017,10 @@ func (j *jsonRPCHub) GetStorage(stateRoot types.Hash, addr types.Address, slot t
 		return nil, err
 	}
 
-	res := snap.GetStorage(addr, account.Root, slot)
+	res, err := snap.GetStorage(addr, account.Root, slot)
+	if err != nil {
+		return nil, err
+	}
 
 	return res.Bytes(), nil
 }
@@ -738,6 +1065,61 @@ func (j *jsonRPCHub) ApplyTxn(
 	return
 }
 
+// SimulateBlocks executes the simulated blocks one after another on top of the state of the given header.
+// State changes of the calls are carried over to the subsequent calls and blocks
+func (j *jsonRPCHub) SimulateBlocks(
+	header *types.Header,
+	blocks []*jsonrpc.SimulatedBlock,
+) ([][]*jsonrpc.SimulatedCall, error) {
+	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]*jsonrpc.SimulatedCall, len(blocks))
+
+	for i, block := range blocks {
+		// the fee recipient override applies to its own block only
+		transition.WithBlockOverride(&types.BlockOverride{Coinbase: &blockCreator})
+		transition.WithBlockOverride(block.BlockOverride)
+
+		if block.StateOverride != nil {
+			if err := transition.WithStateOverride(block.StateOverride); err != nil {
+				return nil, err
+			}
+		}
+
+		results[i] = make([]*jsonrpc.SimulatedCall, len(block.Txns))
+
+		for k, txn := range block.Txns {
+			// calls depend on each other, so the nonce is always taken from the simulated state
+			txn.Nonce = transition.GetNonce(txn.From)
+
+			// if the caller didn't supply the gas limit, use the gas left in the simulated block
+			if txn.Gas == 0 {
+				txn.Gas = transition.GasPool()
+			}
+
+			result, err := transition.Apply(txn)
+			if err != nil {
+				return nil, fmt.Errorf("failed to apply call %d in block %d: %w", k, i, err)
+			}
+
+			results[i][k] = &jsonrpc.SimulatedCall{
+				Result: result,
+				Logs:   transition.Txn().Logs(),
+			}
+		}
+	}
+
+	return results, nil
+}
+
 // TraceBlock traces all transactions in the given block and returns all results
 func (j *jsonRPCHub) TraceBlock(
 	block *types.Block,
@@ -874,6 +1256,37 @@ func (j *jsonRPCHub) GetSyncProgression() *progress.Progression {
 
 // SETUP //
 
+// setupForkState sets up the state forked from the given block of the remote chain.
+// The accounts and storage missing locally are read from the remote chain's JSON-RPC endpoint
+func (s *Server) setupForkState(storage itrie.Storage) (*itrie.State, error) {
+	client, err := itrie.NewJSONRPCForkClient(s.config.ForkURL)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := client.BlockNumber()
+	if err != nil {
+		_ = client.Close()
+
+		return nil, fmt.Errorf("failed to get the latest block of the forked chain: %w", err)
+	}
+
+	number := latest
+	if s.config.ForkBlock != nil {
+		if number = *s.config.ForkBlock; number > latest {
+			_ = client.Close()
+
+			return nil, fmt.Errorf("fork block %d is above the latest block %d of the forked chain", number, latest)
+		}
+	}
+
+	s.forkClient = client
+
+	s.logger.Info("forking the chain", "url", s.config.ForkURL, "block", number)
+
+	return itrie.NewForkState(storage, client, number, s.logger), nil
+}
+
 // setupJSONRCP sets up the JSONRPC server, using the set configuration
 func (s *Server) setupJSONRPC() error {
 	hub := &jsonRPCHub{
@@ -891,14 +1304,30 @@ func (s *Server) setupJSONRPC() error {
 	conf := &jsonrpc.Config{
 		Store:                    hub,
 		Addr:                     s.config.JSONRPC.JSONRPCAddr,
+		IPCPath:                  s.config.JSONRPC.IPCPath,
 		ChainID:                  uint64(s.config.Chain.Params.ChainID),
 		ChainName:                s.chain.Name,
 		AccessControlAllowOrigin: s.config.JSONRPC.AccessControlAllowOrigin,
 		PriceLimit:               s.config.PriceLimit,
 		BatchLengthLimit:         s.config.JSONRPC.BatchLengthLimit,
 		BlockRangeLimit:          s.config.JSONRPC.BlockRangeLimit,
+		IndexedBlockRangeLimit:   s.config.JSONRPC.IndexedBlockRangeLimit,
 		ConcurrentRequestsDebug:  s.config.JSONRPC.ConcurrentRequestsDebug,
 		WebSocketReadLimit:       s.config.JSONRPC.WebSocketReadLimit,
+		RateLimit:                s.config.JSONRPC.RateLimit,
+	}
+
+	if devConsensus, ok := s.consensus.(*consensusDev.Dev); ok {
+		// the dev mode controls are served only by the dev consensus
+		conf.DevStore = devConsensus
+	}
+
+	if s.config.JSONRPC.Admin {
+		conf.AdminStore = s
+	}
+
+	if s.health != nil {
+		conf.HTTPHandlers = s.health.Handlers()
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -911,6 +1340,92 @@ func (s *Server) setupJSONRPC() error {
 	return nil
 }
 
+// setupTracing sets up the export of the OpenTelemetry spans of the node, identified by its libp2p ID
+func (s *Server) setupTracing() error {
+	if s.config.Tracing == nil {
+		return nil
+	}
+
+	config := *s.config.Tracing
+	config.NodeName = s.network.AddrInfo().ID.String()
+
+	shutdown, err := tracing.Setup(context.Background(), &config)
+	if err != nil {
+		return fmt.Errorf("failed to set up the tracing: %w", err)
+	}
+
+	if config.Exporter == tracing.ExporterOTLP {
+		s.logger.Info("exporting the tracing spans", "endpoint", config.Endpoint, "sample_ratio", config.SampleRatio)
+	}
+
+	s.shutdownTracing = shutdown
+
+	return nil
+}
+
+// setupBlockSink sets up the stream publishing the finalized blocks to the configured sink,
+// which is started once the node is running. The cursor of the stream is persisted in the data directory
+func (s *Server) setupBlockSink() error {
+	if s.config.BlockSink == nil || s.config.BlockSink.Type == "" || s.config.BlockSink.Type == blocksink.None {
+		return nil
+	}
+
+	factory, ok := blockSinkBackends[s.config.BlockSink.Type]
+	if !ok {
+		return fmt.Errorf("unknown block sink: %s", s.config.BlockSink.Type)
+	}
+
+	sink, err := factory(s.config.BlockSink.Target)
+	if err != nil {
+		return fmt.Errorf("failed to create the block sink: %w", err)
+	}
+
+	config := *s.config.BlockSink
+	config.CursorPath = filepath.Join(s.config.DataDir, blocksink.CursorFileName)
+
+	// the call traces replay the transactions of the published blocks
+	hub := &jsonRPCHub{
+		Blockchain: s.blockchain,
+		Executor:   s.executor,
+		Consensus:  s.consensus,
+	}
+
+	s.blockSink = blocksink.NewStream(s.logger, &config, s.blockchain, hub, sink)
+
+	s.logger.Info("publishing the blocks", "sink", config.Type, "target", config.Target, "traces", config.Traces)
+
+	return nil
+}
+
+// setupHealth sets up the health checker of the node,
+// whose endpoints are served on the json-rpc and the prometheus listeners
+func (s *Server) setupHealth() {
+	if s.config.Health == nil {
+		return
+	}
+
+	config := *s.config.Health
+	config.BlockTime = s.blockTime
+
+	hub := &jsonRPCHub{
+		restoreProgression: s.restoreProgression,
+		Blockchain:         s.blockchain,
+		Server:             s.network,
+		Consensus:          s.consensus,
+	}
+
+	// the participation is checked only if the consensus reports it
+	participation, _ := s.consensus.(consensus.ParticipationReporter)
+
+	s.health = health.NewChecker(s.logger, &config, hub, participation)
+
+	if s.prometheusMux != nil {
+		for path, handler := range s.health.Handlers() {
+			s.prometheusMux.Handle(path, handler)
+		}
+	}
+}
+
 // setupGRPC sets up the grpc server and listens on tcp
 func (s *Server) setupGRPC() error {
 	proto.RegisterSystemServer(s.grpcServer, &systemService{server: s})
@@ -944,11 +1459,25 @@ func (s *Server) JoinPeer(rawPeerMultiaddr string) error {
 
 // Close closes the Minimal server (blockchain, networking, consensus)
 func (s *Server) Close() {
+	// Stop publishing the blocks, before the blockchain is closed
+	if s.blockSink != nil {
+		if err := s.blockSink.Close(); err != nil {
+			s.logger.Error("failed to close block sink", "err", err.Error())
+		}
+	}
+
 	// Close the blockchain layer
 	if err := s.blockchain.Close(); err != nil {
 		s.logger.Error("failed to close blockchain", "err", err.Error())
 	}
 
+	// Close the JSON-RPC IPC endpoint
+	if s.jsonrpcServer != nil {
+		if err := s.jsonrpcServer.Close(); err != nil {
+			s.logger.Error("failed to close json-rpc ipc endpoint", "err", err.Error())
+		}
+	}
+
 	// Close the networking layer
 	if err := s.network.Close(); err != nil {
 		s.logger.Error("failed to close networking", "err", err.Error())
@@ -959,6 +1488,25 @@ func (s *Server) Close() {
 		s.logger.Error("failed to close consensus", "err", err.Error())
 	}
 
+	// Close the light protocol
+	if s.lightSyncer != nil {
+		if err := s.lightSyncer.Close(); err != nil {
+			s.logger.Error("failed to close light syncer", "err", err.Error())
+		}
+	}
+
+	if s.lightService != nil {
+		if err := s.lightService.Close(); err != nil {
+			s.logger.Error("failed to close light service", "err", err.Error())
+		}
+	}
+
+	if s.forkClient != nil {
+		if err := s.forkClient.Close(); err != nil {
+			s.logger.Error("failed to close fork client", "err", err.Error())
+		}
+	}
+
 	// Close the state storage
 	if err := s.stateStorage.Close(); err != nil {
 		s.logger.Error("failed to close storage for trie", "err", err.Error())
@@ -980,6 +1528,13 @@ func (s *Server) Close() {
 
 	// Close DataDog profiler
 	s.closeDataDogProfiler()
+
+	// Flush the remaining spans
+	if s.shutdownTracing != nil {
+		if err := s.shutdownTracing(context.Background()); err != nil {
+			s.logger.Error("failed to flush the tracing spans", "err", err)
+		}
+	}
 }
 
 // Entry is a consensus configuration entry
@@ -989,14 +1544,18 @@ type Entry struct {
 }
 
 func (s *Server) startPrometheusServer(listenAddr *net.TCPAddr) *http.Server {
-	srv := &http.Server{
-		Addr: listenAddr.String(),
-		Handler: promhttp.InstrumentMetricHandler(
-			prometheus.DefaultRegisterer, promhttp.HandlerFor(
-				prometheus.DefaultGatherer,
-				promhttp.HandlerOpts{},
-			),
+	// the health endpoints are registered on the mux once the node is set up
+	s.prometheusMux = http.NewServeMux()
+	s.prometheusMux.Handle("/", promhttp.InstrumentMetricHandler(
+		prometheus.DefaultRegisterer, promhttp.HandlerFor(
+			prometheus.DefaultGatherer,
+			promhttp.HandlerOpts{},
 		),
+	))
+
+	srv := &http.Server{
+		Addr:              listenAddr.String(),
+		Handler:           s.prometheusMux,
 		ReadHeaderTimeout: 60 * time.Second,
 	}
 
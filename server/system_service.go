@@ -1,12 +1,20 @@
 package server
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/0xPolygon/polygon-edge/archive"
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/network/common"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -108,35 +116,57 @@ func (s *systemService) PeersStatus(ctx context.Context, req *proto.PeersStatusR
 		return nil, err
 	}
 
-	peer, err := s.getPeer(peerID)
+	diagnostics, err := s.server.network.PeerDiagnostics(ctx, peerID)
 	if err != nil {
 		return nil, err
 	}
 
-	return peer, nil
+	return s.toProtoPeer(diagnostics), nil
 }
 
-// getPeer returns a specific proto.Peer using the peer ID
-func (s *systemService) getPeer(id peer.ID) (*proto.Peer, error) {
-	protocols, err := s.server.network.GetProtocols(id)
-	if err != nil {
-		return nil, err
+// toProtoPeer converts the peer diagnostics to proto.Peer,
+// adding the latest block of the peer known by the consensus syncer
+func (s *systemService) toProtoPeer(diagnostics *network.PeerDiagnostics) *proto.Peer {
+	peer := &proto.Peer{
+		Id:             diagnostics.ID.String(),
+		Protocols:      diagnostics.Protocols,
+		Addrs:          make([]string, len(diagnostics.Addrs)),
+		Score:          diagnostics.Score,
+		Direction:      strings.ToLower(diagnostics.Direction.String()),
+		ConnectedAt:    diagnostics.ConnectedAt.Unix(),
+		Latency:        diagnostics.Latency.Microseconds(),
+		Bandwidth:      make([]*proto.Peer_ProtocolBandwidth, len(diagnostics.Bandwidth)),
+		GossipMessages: make([]*proto.Peer_TopicMessages, len(diagnostics.GossipMessages)),
 	}
 
-	info := s.server.network.GetPeerInfo(id)
+	for i, addr := range diagnostics.Addrs {
+		peer.Addrs[i] = addr.String()
+	}
 
-	addrs := []string{}
-	for _, addr := range info.Addrs {
-		addrs = append(addrs, addr.String())
+	if diagnostics.Distance != nil {
+		peer.Distance = "0x" + diagnostics.Distance.Text(16)
 	}
 
-	peer := &proto.Peer{
-		Id:        id.String(),
-		Protocols: protocols,
-		Addrs:     addrs,
+	if head, ok := syncPeerHead(s.server.consensus, diagnostics.ID); ok {
+		peer.Head = &proto.Peer_Head{Number: head}
 	}
 
-	return peer, nil
+	for i, bandwidth := range diagnostics.Bandwidth {
+		peer.Bandwidth[i] = &proto.Peer_ProtocolBandwidth{
+			Protocol: bandwidth.Protocol,
+			BytesIn:  bandwidth.BytesIn,
+			BytesOut: bandwidth.BytesOut,
+		}
+	}
+
+	for i, messages := range diagnostics.GossipMessages {
+		peer.GossipMessages[i] = &proto.Peer_TopicMessages{
+			Topic: messages.Topic,
+			Count: messages.Count,
+		}
+	}
+
+	return peer
 }
 
 // PeersList implements the 'peers list' operator service
@@ -148,19 +178,67 @@ func (s *systemService) PeersList(
 		Peers: []*proto.Peer{},
 	}
 
-	peers := s.server.network.Peers()
-	for _, p := range peers {
-		peer, err := s.getPeer(p.Info.ID)
-		if err != nil {
-			return nil, err
-		}
+	for _, diagnostics := range s.server.network.PeersDiagnostics(ctx) {
+		resp.Peers = append(resp.Peers, s.toProtoPeer(diagnostics))
+	}
 
-		resp.Peers = append(resp.Peers, peer)
+	return resp, nil
+}
+
+// PeersBan implements the 'peers ban' operator service
+func (s *systemService) PeersBan(_ context.Context, req *proto.PeersBanRequest) (*proto.Ban, error) {
+	ban, err := s.server.network.BanPeer(
+		req.Target,
+		time.Duration(req.Duration)*time.Second,
+		req.Reason,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoBan(ban), nil
+}
+
+// PeersBanList implements the 'peers ban --list' operator service
+func (s *systemService) PeersBanList(_ context.Context, _ *empty.Empty) (*proto.PeersBanListResponse, error) {
+	resp := &proto.PeersBanListResponse{
+		Bans: []*proto.Ban{},
+	}
+
+	for _, ban := range s.server.network.BannedPeers() {
+		resp.Bans = append(resp.Bans, toProtoBan(ban))
 	}
 
 	return resp, nil
 }
 
+// PeersUnban implements the 'peers unban' operator service
+func (s *systemService) PeersUnban(_ context.Context, req *proto.PeersUnbanRequest) (*empty.Empty, error) {
+	if err := s.server.network.UnbanPeer(req.Target); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// PeersTrust implements the 'peers trust' operator service
+func (s *systemService) PeersTrust(_ context.Context, req *proto.PeersTrustRequest) (*empty.Empty, error) {
+	if err := s.server.network.TrustPeer(req.Id); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// toProtoBan converts the ban list entry to its proto representation
+func toProtoBan(ban *network.BanEntry) *proto.Ban {
+	return &proto.Ban{
+		Target:    ban.Target,
+		Reason:    ban.Reason,
+		ExpiresAt: ban.ExpiresAt,
+	}
+}
+
 // BlockByNumber implements the BlockByNumber operator service
 func (s *systemService) BlockByNumber(
 	ctx context.Context,
@@ -227,6 +305,229 @@ func (s *systemService) Export(req *proto.ExportRequest, stream proto.System_Exp
 	return nil
 }
 
+// ExportSnapshot streams the state snapshot at the requested block
+func (s *systemService) ExportSnapshot(
+	req *proto.ExportSnapshotRequest,
+	stream proto.System_ExportSnapshotServer,
+) error {
+	// the state of the light node and the dev fork mode is not stored locally
+	if s.server.lightClient != nil || s.server.forkClient != nil {
+		return errors.New("state snapshot is not available on this node")
+	}
+
+	blocks := req.Blocks
+	if blocks == 0 {
+		blocks = archive.DefaultSnapshotBlocks
+	}
+
+	writer := bufio.NewWriterSize(&chunkWriter{send: func(data []byte) error {
+		return stream.Send(&proto.SnapshotChunk{Data: data})
+	}}, int(defaultMaxGRPCPayloadSize))
+
+	header, err := archive.ExportSnapshot(s.server.blockchain, s.server.stateStorage, req.Number, blocks, writer)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	s.server.logger.Info("Exported state snapshot", "number", header.Number, "hash", header.Hash)
+
+	return nil
+}
+
+// DumpState streams the accounts of the state at the requested block in the genesis alloc format
+func (s *systemService) DumpState(req *proto.DumpStateRequest, stream proto.System_DumpStateServer) error {
+	// the state of the light node and the dev fork mode is not stored locally
+	if s.server.lightClient != nil || s.server.forkClient != nil {
+		return errors.New("state dump is not available on this node")
+	}
+
+	var number *uint64
+	if !req.Latest {
+		number = &req.Number
+	}
+
+	writer := bufio.NewWriterSize(&chunkWriter{send: func(data []byte) error {
+		return stream.Send(&proto.DumpStateChunk{Data: data})
+	}}, int(defaultMaxGRPCPayloadSize))
+
+	dump, err := archive.DumpState(s.server.blockchain, s.server.stateStorage, number, chain.AllocFormat(req.Format), writer)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	s.server.logger.Info("Dumped state", "number", dump.Number, "accounts", dump.Accounts)
+
+	return stream.Send(&proto.DumpStateChunk{
+		Summary: &proto.StateDumpSummary{
+			Number:    dump.Number,
+			Hash:      dump.Hash.String(),
+			StateRoot: dump.StateRoot.String(),
+			Accounts:  dump.Accounts,
+		},
+	})
+}
+
+// AdminSetLogLevel sets the log level of the module, or the default log level
+func (s *systemService) AdminSetLogLevel(
+	_ context.Context,
+	req *proto.AdminSetLogLevelRequest,
+) (*proto.AdminLogLevels, error) {
+	if err := s.server.SetLogLevel(req.Module, req.Level); err != nil {
+		return nil, err
+	}
+
+	return s.AdminLogLevels(context.Background(), &empty.Empty{})
+}
+
+// AdminLogLevels returns the default log level and the log levels of the modules
+func (s *systemService) AdminLogLevels(_ context.Context, _ *empty.Empty) (*proto.AdminLogLevels, error) {
+	defaultLevel, modules := s.server.LogLevels()
+
+	resp := &proto.AdminLogLevels{
+		Default: defaultLevel,
+		Modules: make([]*proto.AdminLogLevels_ModuleLevel, 0, len(modules)),
+	}
+
+	for module, level := range modules {
+		resp.Modules = append(resp.Modules, &proto.AdminLogLevels_ModuleLevel{
+			Module: module,
+			Level:  level,
+		})
+	}
+
+	sort.Slice(resp.Modules, func(i, j int) bool {
+		return resp.Modules[i].Module < resp.Modules[j].Module
+	})
+
+	return resp, nil
+}
+
+// AdminDropPeer disconnects the peer
+func (s *systemService) AdminDropPeer(_ context.Context, req *proto.AdminDropPeerRequest) (*empty.Empty, error) {
+	if err := s.server.DropPeer(req.Id); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// AdminRemoveTx removes the transaction and the following transactions of its sender from the pool
+func (s *systemService) AdminRemoveTx(_ context.Context, req *proto.AdminTxRequest) (*proto.AdminTxsResponse, error) {
+	hash, err := parseHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.server.RemoveTx(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAdminTxsResponse(removed), nil
+}
+
+// AdminRebroadcastTx gossips the pool transaction to the peers again
+func (s *systemService) AdminRebroadcastTx(_ context.Context, req *proto.AdminTxRequest) (*empty.Empty, error) {
+	hash, err := parseHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.server.RebroadcastTx(hash); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// AdminFlushAccount drops all the transactions of the account from the pool
+func (s *systemService) AdminFlushAccount(
+	_ context.Context,
+	req *proto.AdminFlushAccountRequest,
+) (*proto.AdminTxsResponse, error) {
+	if err := types.IsValidAddress(req.Address); err != nil {
+		return nil, err
+	}
+
+	return toAdminTxsResponse(s.server.FlushAccount(types.StringToAddress(req.Address))), nil
+}
+
+// AdminSetBlockProduction pauses or resumes the block production
+func (s *systemService) AdminSetBlockProduction(
+	_ context.Context,
+	req *proto.AdminSetBlockProductionRequest,
+) (*empty.Empty, error) {
+	if err := s.server.SetBlockProductionPaused(req.Paused); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// AdminCompact compacts the databases of the node
+func (s *systemService) AdminCompact(_ context.Context, _ *empty.Empty) (*proto.AdminCompactResponse, error) {
+	compacted, err := s.server.CompactDatabases()
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.AdminCompactResponse{Databases: compacted}, nil
+}
+
+// parseHash parses the hex encoded transaction hash
+func parseHash(raw string) (types.Hash, error) {
+	buf, err := hex.DecodeHex(raw)
+	if err != nil || len(buf) != types.HashLength {
+		return types.ZeroHash, fmt.Errorf("%w: %s", errInvalidHash, raw)
+	}
+
+	return types.BytesToHash(buf), nil
+}
+
+func toAdminTxsResponse(hashes []types.Hash) *proto.AdminTxsResponse {
+	resp := &proto.AdminTxsResponse{
+		Hashes: make([]string, 0, len(hashes)),
+	}
+
+	for _, hash := range hashes {
+		resp.Hashes = append(resp.Hashes, hash.String())
+	}
+
+	return resp
+}
+
+// chunkWriter sends the written data in the chunks of the maximum payload size
+type chunkWriter struct {
+	send func(data []byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+
+	for written < len(p) {
+		size := len(p) - written
+		if size > int(defaultMaxGRPCPayloadSize) {
+			size = int(defaultMaxGRPCPayloadSize)
+		}
+
+		if err := w.send(p[written : written+size]); err != nil {
+			return written, err
+		}
+
+		written += size
+	}
+
+	return written, nil
+}
+
 const (
 	defaultMaxGRPCPayloadSize uint64 = 512 * 1024 // 4MB
 
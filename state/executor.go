@@ -1,16 +1,20 @@
 package state
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
 	"math/big"
 
 	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
@@ -27,6 +31,8 @@ const (
 	TxGasContractCreation uint64 = 53000 // Per transaction that creates a contract
 )
 
+var spanTracer = tracing.Tracer("state")
+
 // GetHashByNumber returns the hash function of a block number
 type GetHashByNumber = func(i uint64) types.Hash
 
@@ -133,6 +139,24 @@ func (e *Executor) ProcessBlock(
 	block *types.Block,
 	blockCreator types.Address,
 ) (*Transition, error) {
+	return e.ProcessBlockWithContext(context.Background(), parentRoot, block, blockCreator)
+}
+
+// ProcessBlockWithContext is ProcessBlock traced within the given context
+func (e *Executor) ProcessBlockWithContext(
+	ctx context.Context,
+	parentRoot types.Hash,
+	block *types.Block,
+	blockCreator types.Address,
+) (_ *Transition, err error) {
+	_, span := spanTracer.Start(ctx, "state.process_block", trace.WithAttributes(
+		attribute.Int64("block.number", int64(block.Number())),
+		attribute.Int("block.txs", len(block.Transactions)),
+	))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	txn, err := e.BeginTxn(parentRoot, block.Header, blockCreator)
 	if err != nil {
 		return nil, err
@@ -328,6 +352,40 @@ func (t *Transition) WithStateOverride(override types.StateOverride) error {
 	return nil
 }
 
+// WithBlockOverride overrides the block context of the transition.
+// Overriding the gas limit refills the gas pool of the block
+func (t *Transition) WithBlockOverride(override *types.BlockOverride) {
+	if override == nil {
+		return
+	}
+
+	if override.Number != nil {
+		t.ctx.Number = int64(*override.Number)
+	}
+
+	if override.Timestamp != nil {
+		t.ctx.Timestamp = int64(*override.Timestamp)
+	}
+
+	if override.GasLimit != nil {
+		t.ctx.GasLimit = int64(*override.GasLimit)
+		t.gasPool = *override.GasLimit
+	}
+
+	if override.Coinbase != nil {
+		t.ctx.Coinbase = *override.Coinbase
+	}
+
+	if override.BaseFee != nil {
+		t.ctx.BaseFee = new(big.Int).Set(override.BaseFee)
+	}
+}
+
+// GasPool returns the gas still available in the block
+func (t *Transition) GasPool() uint64 {
+	return t.gasPool
+}
+
 func (t *Transition) TotalGas() uint64 {
 	return t.totalGas
 }
@@ -400,6 +458,18 @@ func (t *Transition) Write(txn *types.Transaction) error {
 
 // Commit commits the final result
 func (t *Transition) Commit() (Snapshot, types.Hash, error) {
+	return t.CommitWithContext(context.Background())
+}
+
+// CommitWithContext is Commit traced within the given context
+func (t *Transition) CommitWithContext(ctx context.Context) (_ Snapshot, _ types.Hash, err error) {
+	_, span := spanTracer.Start(ctx, "state.commit", trace.WithAttributes(
+		attribute.Int64("block.number", t.ctx.Number),
+	))
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	objs, err := t.state.Commit(t.config.EIP155)
 	if err != nil {
 		return nil, types.ZeroHash, err
@@ -66,6 +66,43 @@ func TestOverride(t *testing.T) {
 	require.Equal(t, types.Hash{0x1}, tt.state.GetState(types.Address{0x1}, types.Hash{0x1}))
 }
 
+func TestBlockOverride(t *testing.T) {
+	t.Parallel()
+
+	state := newStateWithPreState(map[types.Address]*PreState{})
+
+	tt := NewTransition(chain.ForksInTime{}, state, newTxn(state))
+	tt.ctx = runtime.TxContext{
+		Number:    1,
+		Timestamp: 10,
+		GasLimit:  100,
+		BaseFee:   big.NewInt(1),
+	}
+	tt.gasPool = 50
+
+	// nil override keeps the context intact
+	tt.WithBlockOverride(nil)
+	require.Equal(t, int64(1), tt.ctx.Number)
+
+	number, timestamp, gasLimit := uint64(5), uint64(20), uint64(200)
+	coinbase := types.Address{0x1}
+
+	tt.WithBlockOverride(&types.BlockOverride{
+		Number:    &number,
+		Timestamp: &timestamp,
+		GasLimit:  &gasLimit,
+		Coinbase:  &coinbase,
+		BaseFee:   big.NewInt(7),
+	})
+
+	require.Equal(t, int64(number), tt.ctx.Number)
+	require.Equal(t, int64(timestamp), tt.ctx.Timestamp)
+	require.Equal(t, int64(gasLimit), tt.ctx.GasLimit)
+	require.Equal(t, coinbase, tt.ctx.Coinbase)
+	require.Equal(t, big.NewInt(7), tt.ctx.BaseFee)
+	require.Equal(t, gasLimit, tt.GasPool())
+}
+
 func Test_Transition_checkDynamicFees(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,122 @@
+package itrie
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+// forkTombstone is written instead of deleting the accounts and the storage slots in the fork mode,
+// so the deleted entries are not read from the forked chain again.
+// It is the RLP encoding of the empty bytes, which reads as the zero storage value
+var forkTombstone = []byte{0x80}
+
+// ForkClient reads the state of the forked chain
+type ForkClient interface {
+	// GetAccount returns the account at the given block, or nil if it does not exist
+	GetAccount(addr types.Address, number uint64) (*state.Account, error)
+
+	// GetCode returns the code of the account at the given block
+	GetCode(addr types.Address, number uint64) ([]byte, error)
+
+	// GetStorageAt returns the value of the account's storage slot at the given block
+	GetStorageAt(addr types.Address, key types.Hash, number uint64) (types.Hash, error)
+}
+
+type forkSlot struct {
+	addr types.Address
+	key  types.Hash
+}
+
+// fork is the lazy-loading backend of the state forked from a remote chain.
+// The accounts and the storage slots missing in the local trie are read from the forked block.
+// The state of the forked block never changes, so the responses are cached for the lifetime of the node
+type fork struct {
+	logger hclog.Logger
+	client ForkClient
+	number uint64
+
+	lock     sync.Mutex
+	accounts map[types.Address]*state.Account
+	slots    map[forkSlot]types.Hash
+}
+
+// NewForkState creates the state which reads the accounts and the storage slots missing locally
+// from the given block of the forked chain
+func NewForkState(storage Storage, client ForkClient, number uint64, logger hclog.Logger) *State {
+	s := NewState(storage)
+	s.fork = &fork{
+		logger:   logger.Named("fork"),
+		client:   client,
+		number:   number,
+		accounts: map[types.Address]*state.Account{},
+		slots:    map[forkSlot]types.Hash{},
+	}
+
+	return s
+}
+
+// getAccount returns the account of the forked chain and stores its code locally
+func (f *fork) getAccount(addr types.Address, storage Storage) (*state.Account, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	account, ok := f.accounts[addr]
+	if !ok {
+		var err error
+
+		if account, err = f.client.GetAccount(addr, f.number); err != nil {
+			return nil, err
+		}
+
+		if account != nil {
+			codeHash := types.BytesToHash(account.CodeHash)
+			if _, ok := storage.GetCode(codeHash); !ok && codeHash != types.EmptyCodeHash {
+				code, err := f.client.GetCode(addr, f.number)
+				if err != nil {
+					return nil, err
+				}
+
+				storage.SetCode(codeHash, code)
+			}
+		}
+
+		f.accounts[addr] = account
+
+		f.logger.Debug("account loaded", "address", addr, "exists", account != nil)
+	}
+
+	if account == nil {
+		return nil, nil
+	}
+
+	return account.Copy(), nil
+}
+
+// getStorage returns the value of the storage slot of the forked chain
+func (f *fork) getStorage(addr types.Address, key types.Hash) (types.Hash, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	slot := forkSlot{addr: addr, key: key}
+
+	value, ok := f.slots[slot]
+	if !ok {
+		var err error
+
+		if value, err = f.client.GetStorageAt(addr, key, f.number); err != nil {
+			return types.Hash{}, err
+		}
+
+		f.slots[slot] = value
+	}
+
+	return value, nil
+}
+
+func isForkTombstone(data []byte) bool {
+	return bytes.Equal(data, forkTombstone)
+}
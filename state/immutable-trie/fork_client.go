@@ -0,0 +1,162 @@
+package itrie
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo/jsonrpc"
+	"github.com/umbracle/ethgo/jsonrpc/codec"
+)
+
+const methodNotFoundErrorCode = -32601
+
+// unknownStorageRoot is the storage root of the contracts read without eth_getProof.
+// It is not available locally, so all their storage slots are read from the forked chain
+var unknownStorageRoot = types.BytesToHash(crypto.Keccak256([]byte("fork storage")))
+
+// JSONRPCForkClient reads the state of the forked chain from its JSON-RPC endpoint
+type JSONRPCForkClient struct {
+	client *jsonrpc.Client
+}
+
+// NewJSONRPCForkClient creates the client of the forked chain's JSON-RPC endpoint
+func NewJSONRPCForkClient(url string) (*JSONRPCForkClient, error) {
+	client, err := jsonrpc.NewClient(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONRPCForkClient{client: client}, nil
+}
+
+// BlockNumber returns the latest block number of the forked chain
+func (c *JSONRPCForkClient) BlockNumber() (uint64, error) {
+	var number string
+	if err := c.client.Call("eth_blockNumber", &number); err != nil {
+		return 0, err
+	}
+
+	return hex.DecodeUint64(number)
+}
+
+type forkAccountProof struct {
+	Balance     string     `json:"balance"`
+	Nonce       string     `json:"nonce"`
+	CodeHash    types.Hash `json:"codeHash"`
+	StorageHash types.Hash `json:"storageHash"`
+}
+
+// GetAccount returns the account at the given block, or nil if it does not exist.
+// The account is read with eth_getProof, which returns all its fields at once.
+// The endpoints not supporting it are queried for the account's balance, nonce and code separately
+func (c *JSONRPCForkClient) GetAccount(addr types.Address, number uint64) (*state.Account, error) {
+	var proof forkAccountProof
+	if err := c.client.Call("eth_getProof", &proof, addr, []types.Hash{}, hex.EncodeUint64(number)); err != nil {
+		var rpcErr *codec.ErrorObject
+		if errors.As(err, &rpcErr) && rpcErr.Code == methodNotFoundErrorCode {
+			return c.getAccountWithoutProof(addr, number)
+		}
+
+		return nil, fmt.Errorf("failed to get the account %s: %w", addr, err)
+	}
+
+	return proof.account(addr)
+}
+
+// account returns the account of the proof, or nil if the account does not exist
+func (p *forkAccountProof) account(addr types.Address) (*state.Account, error) {
+	balance, err := hex.DecodeHexToBig(p.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("invalid balance of the account %s: %w", addr, err)
+	}
+
+	nonce, err := hex.DecodeUint64(p.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nonce of the account %s: %w", addr, err)
+	}
+
+	// some clients return the zero hashes for the accounts which do not exist
+	if p.CodeHash == types.ZeroHash {
+		p.CodeHash = types.EmptyCodeHash
+	}
+
+	if p.StorageHash == types.ZeroHash {
+		p.StorageHash = types.EmptyRootHash
+	}
+
+	if nonce == 0 && balance.Sign() == 0 &&
+		p.CodeHash == types.EmptyCodeHash && p.StorageHash == types.EmptyRootHash {
+		return nil, nil
+	}
+
+	return &state.Account{
+		Nonce:    nonce,
+		Balance:  balance,
+		Root:     p.StorageHash,
+		CodeHash: p.CodeHash.Bytes(),
+	}, nil
+}
+
+func (c *JSONRPCForkClient) getAccountWithoutProof(addr types.Address, number uint64) (*state.Account, error) {
+	var balance, nonce string
+
+	if err := c.client.Call("eth_getBalance", &balance, addr, hex.EncodeUint64(number)); err != nil {
+		return nil, fmt.Errorf("failed to get the balance of the account %s: %w", addr, err)
+	}
+
+	if err := c.client.Call("eth_getTransactionCount", &nonce, addr, hex.EncodeUint64(number)); err != nil {
+		return nil, fmt.Errorf("failed to get the nonce of the account %s: %w", addr, err)
+	}
+
+	code, err := c.GetCode(addr, number)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := forkAccountProof{
+		Balance:     balance,
+		Nonce:       nonce,
+		CodeHash:    types.BytesToHash(crypto.Keccak256(code)),
+		StorageHash: types.EmptyRootHash,
+	}
+
+	if len(code) > 0 {
+		proof.StorageHash = unknownStorageRoot
+	}
+
+	return proof.account(addr)
+}
+
+// GetCode returns the code of the account at the given block
+func (c *JSONRPCForkClient) GetCode(addr types.Address, number uint64) ([]byte, error) {
+	var code string
+	if err := c.client.Call("eth_getCode", &code, addr, hex.EncodeUint64(number)); err != nil {
+		return nil, fmt.Errorf("failed to get the code of the account %s: %w", addr, err)
+	}
+
+	return hex.DecodeHex(code)
+}
+
+// GetStorageAt returns the value of the account's storage slot at the given block
+func (c *JSONRPCForkClient) GetStorageAt(addr types.Address, key types.Hash, number uint64) (types.Hash, error) {
+	var value string
+	if err := c.client.Call("eth_getStorageAt", &value, addr, key, hex.EncodeUint64(number)); err != nil {
+		return types.Hash{}, fmt.Errorf("failed to get the storage of the account %s: %w", addr, err)
+	}
+
+	data, err := hex.DecodeHex(value)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	return types.BytesToHash(data), nil
+}
+
+// Close closes the connection to the forked chain
+func (c *JSONRPCForkClient) Close() error {
+	return c.client.Close()
+}
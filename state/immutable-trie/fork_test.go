@@ -0,0 +1,401 @@
+package itrie
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testForkBlock = uint64(10)
+
+type forkTestAccount struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[types.Hash]types.Hash
+}
+
+// forkTestServer is the stand-in JSON-RPC endpoint of the forked chain
+type forkTestServer struct {
+	t        *testing.T
+	accounts map[types.Address]*forkTestAccount
+	noProof  bool
+
+	lock  sync.Mutex
+	calls map[string]int
+}
+
+func newForkTestServer(t *testing.T, accounts map[types.Address]*forkTestAccount) (*forkTestServer, string) {
+	t.Helper()
+
+	s := &forkTestServer{t: t, accounts: accounts, calls: map[string]int{}}
+
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	return s, srv.URL
+}
+
+func (s *forkTestServer) callCount(method string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.calls[method]
+}
+
+func (s *forkTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+
+	require.NoError(s.t, json.NewDecoder(r.Body).Decode(&req))
+
+	s.lock.Lock()
+	s.calls[req.Method]++
+	s.lock.Unlock()
+
+	var addr types.Address
+	if len(req.Params) > 0 {
+		require.NoError(s.t, json.Unmarshal(req.Params[0], &addr))
+	}
+
+	account, ok := s.accounts[addr]
+	if !ok {
+		account = &forkTestAccount{balance: big.NewInt(0)}
+	}
+
+	var result interface{}
+
+	if req.Method == "eth_getProof" && s.noProof {
+		require.NoError(s.t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"error":   map[string]interface{}{"code": -32601, "message": "method not found"},
+		}))
+
+		return
+	}
+
+	switch req.Method {
+	case "eth_blockNumber":
+		result = hex.EncodeUint64(testForkBlock)
+
+	case "eth_getProof":
+		s.requireBlock(req.Params[2])
+
+		codeHash, storageHash := types.ZeroHash, types.ZeroHash
+		if ok {
+			codeHash = types.BytesToHash(crypto.Keccak256(account.code))
+			storageHash = types.EmptyRootHash
+
+			if len(account.storage) > 0 {
+				storageHash = types.StringToHash("0x1234")
+			}
+		}
+
+		result = map[string]interface{}{
+			"balance":     hex.EncodeBig(account.balance),
+			"nonce":       hex.EncodeUint64(account.nonce),
+			"codeHash":    codeHash,
+			"storageHash": storageHash,
+		}
+
+	case "eth_getBalance":
+		s.requireBlock(req.Params[1])
+
+		result = hex.EncodeBig(account.balance)
+
+	case "eth_getTransactionCount":
+		s.requireBlock(req.Params[1])
+
+		result = hex.EncodeUint64(account.nonce)
+
+	case "eth_getCode":
+		s.requireBlock(req.Params[1])
+
+		result = hex.EncodeToHex(account.code)
+
+	case "eth_getStorageAt":
+		s.requireBlock(req.Params[2])
+
+		var key types.Hash
+		require.NoError(s.t, json.Unmarshal(req.Params[1], &key))
+
+		result = account.storage[key]
+
+	default:
+		s.t.Errorf("unexpected method %s", req.Method)
+	}
+
+	require.NoError(s.t, json.NewEncoder(w).Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      req.ID,
+		"result":  result,
+	}))
+}
+
+func (s *forkTestServer) requireBlock(param json.RawMessage) {
+	var number string
+
+	require.NoError(s.t, json.Unmarshal(param, &number))
+	require.Equal(s.t, hex.EncodeUint64(testForkBlock), number)
+}
+
+func newTestForkState(t *testing.T, accounts map[types.Address]*forkTestAccount) (*State, *forkTestServer) {
+	t.Helper()
+
+	server, url := newForkTestServer(t, accounts)
+
+	client, err := NewJSONRPCForkClient(url)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return NewForkState(NewMemoryStorage(), client, testForkBlock, hclog.NewNullLogger()), server
+}
+
+// getTestStorage returns the value of the storage slot, which has to be read
+func getTestStorage(t *testing.T, snap state.Snapshot, addr types.Address, root, key types.Hash) types.Hash {
+	t.Helper()
+
+	value, err := snap.GetStorage(addr, root, key)
+	require.NoError(t, err)
+
+	return value
+}
+
+func TestForkState_ReadsForkedChain(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr  = types.StringToAddress("0x1001")
+		slot  = types.StringToHash("0x1")
+		value = types.StringToHash("0x5")
+		code  = []byte{0x60, 0x00}
+	)
+
+	st, server := newTestForkState(t, map[types.Address]*forkTestAccount{
+		addr: {
+			balance: big.NewInt(100),
+			nonce:   3,
+			code:    code,
+			storage: map[types.Hash]types.Hash{slot: value},
+		},
+	})
+
+	client, ok := st.fork.client.(*JSONRPCForkClient)
+	require.True(t, ok)
+
+	number, err := client.BlockNumber()
+	require.NoError(t, err)
+	assert.Equal(t, testForkBlock, number)
+
+	snap := st.NewSnapshot()
+
+	// the reads are served from the cache the second time
+	for i := 0; i < 2; i++ {
+		account, err := snap.GetAccount(addr)
+		require.NoError(t, err)
+		require.NotNil(t, account)
+
+		assert.Equal(t, big.NewInt(100), account.Balance)
+		assert.Equal(t, uint64(3), account.Nonce)
+
+		localCode, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+		require.True(t, ok)
+		assert.Equal(t, code, localCode)
+
+		assert.Equal(t, value, getTestStorage(t, snap, addr, account.Root, slot))
+		assert.Equal(t, types.ZeroHash, getTestStorage(t, snap, addr, account.Root, types.StringToHash("0x2")))
+
+		missing, err := snap.GetAccount(types.StringToAddress("0x1002"))
+		require.NoError(t, err)
+		assert.Nil(t, missing)
+	}
+
+	assert.Equal(t, 2, server.callCount("eth_getProof"))
+	assert.Equal(t, 1, server.callCount("eth_getCode"))
+	assert.Equal(t, 2, server.callCount("eth_getStorageAt"))
+}
+
+func TestForkState_LocalChanges(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr      = types.StringToAddress("0x1001")
+		deleted   = types.StringToAddress("0x1002")
+		local     = types.StringToAddress("0x1003")
+		zeroed    = types.StringToHash("0x1")
+		untouched = types.StringToHash("0x2")
+		added     = types.StringToHash("0x3")
+	)
+
+	st, _ := newTestForkState(t, map[types.Address]*forkTestAccount{
+		addr: {
+			balance: big.NewInt(100),
+			storage: map[types.Hash]types.Hash{
+				zeroed:    types.StringToHash("0x5"),
+				untouched: types.StringToHash("0x6"),
+			},
+		},
+		deleted: {balance: big.NewInt(1)},
+		local:   {balance: big.NewInt(1000)},
+	})
+
+	// the local genesis accounts take precedence over the forked chain
+	snap, genesisRoot := st.NewSnapshot().Commit([]*state.Object{
+		{Address: local, Balance: big.NewInt(7), Root: types.EmptyRootHash, CodeHash: types.EmptyCodeHash},
+	})
+
+	account, err := snap.GetAccount(addr)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+
+	_, root := snap.Commit([]*state.Object{
+		{
+			Address:  addr,
+			Balance:  big.NewInt(50),
+			Root:     account.Root,
+			CodeHash: types.BytesToHash(account.CodeHash),
+			Storage: []*state.StorageObject{
+				{Key: zeroed.Bytes(), Deleted: true},
+				{Key: added.Bytes(), Val: types.StringToHash("0x7").Bytes()},
+			},
+		},
+		{Address: deleted, Deleted: true},
+	})
+
+	snap, err = st.NewSnapshotAt(types.BytesToHash(root))
+	require.NoError(t, err)
+
+	account, err = snap.GetAccount(addr)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	assert.Equal(t, big.NewInt(50), account.Balance)
+
+	assert.Equal(t, types.ZeroHash, getTestStorage(t, snap, addr, account.Root, zeroed))
+	assert.Equal(t, types.StringToHash("0x6"), getTestStorage(t, snap, addr, account.Root, untouched))
+	assert.Equal(t, types.StringToHash("0x7"), getTestStorage(t, snap, addr, account.Root, added))
+
+	account, err = snap.GetAccount(deleted)
+	require.NoError(t, err)
+	assert.Nil(t, account)
+
+	account, err = snap.GetAccount(local)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+	assert.Equal(t, big.NewInt(7), account.Balance)
+
+	// the earlier state still reads the forked chain
+	snap, err = st.NewSnapshotAt(types.BytesToHash(genesisRoot))
+	require.NoError(t, err)
+
+	account, err = snap.GetAccount(deleted)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+
+	account, err = snap.GetAccount(addr)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), account.Balance)
+	assert.Equal(t, types.StringToHash("0x5"), getTestStorage(t, snap, addr, account.Root, zeroed))
+}
+
+// failingForkClient is the ForkClient of the forked chain which can't be reached
+type failingForkClient struct {
+	err error
+}
+
+func (c *failingForkClient) GetAccount(types.Address, uint64) (*state.Account, error) {
+	return nil, c.err
+}
+
+func (c *failingForkClient) GetCode(types.Address, uint64) ([]byte, error) {
+	return nil, c.err
+}
+
+func (c *failingForkClient) GetStorageAt(types.Address, types.Hash, uint64) (types.Hash, error) {
+	return types.Hash{}, c.err
+}
+
+func TestForkState_ClientError(t *testing.T) {
+	t.Parallel()
+
+	errUnreachable := errors.New("unreachable")
+
+	st := NewForkState(NewMemoryStorage(), &failingForkClient{err: errUnreachable}, testForkBlock, hclog.NewNullLogger())
+	snap := st.NewSnapshot()
+
+	_, err := snap.GetAccount(types.StringToAddress("0x1"))
+	assert.ErrorIs(t, err, errUnreachable)
+
+	// the slot is not read as empty
+	_, err = snap.GetStorage(types.StringToAddress("0x1"), types.StringToHash("0x1234"), types.StringToHash("0x1"))
+	assert.ErrorIs(t, err, errUnreachable)
+}
+
+func TestJSONRPCForkClient_WithoutProof(t *testing.T) {
+	t.Parallel()
+
+	var (
+		contract = types.StringToAddress("0x1001")
+		eoa      = types.StringToAddress("0x1002")
+		slot     = types.StringToHash("0x1")
+		code     = []byte{0x60, 0x00}
+	)
+
+	server, url := newForkTestServer(t, map[types.Address]*forkTestAccount{
+		contract: {
+			balance: big.NewInt(0),
+			nonce:   1,
+			code:    code,
+			storage: map[types.Hash]types.Hash{slot: types.StringToHash("0x5")},
+		},
+		eoa: {balance: big.NewInt(100), nonce: 2},
+	})
+	server.noProof = true
+
+	client, err := NewJSONRPCForkClient(url)
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	account, err := client.GetAccount(contract, testForkBlock)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+
+	assert.Equal(t, uint64(1), account.Nonce)
+	assert.Equal(t, crypto.Keccak256(code), account.CodeHash)
+	// the storage of the contracts is read from the forked chain
+	assert.Equal(t, unknownStorageRoot, account.Root)
+
+	account, err = client.GetAccount(eoa, testForkBlock)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+
+	assert.Equal(t, big.NewInt(100), account.Balance)
+	assert.Equal(t, uint64(2), account.Nonce)
+	assert.Equal(t, types.EmptyCodeHash.Bytes(), account.CodeHash)
+	assert.Equal(t, types.EmptyRootHash, account.Root)
+
+	account, err = client.GetAccount(types.StringToAddress("0x1003"), testForkBlock)
+	require.NoError(t, err)
+	assert.Nil(t, account)
+
+	assert.Equal(t, 3, server.callCount("eth_getBalance"))
+}
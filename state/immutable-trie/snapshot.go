@@ -16,7 +16,7 @@ type Snapshot struct {
 
 var emptyStateHash = types.StringToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
 
-func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.Hash) types.Hash {
+func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.Hash) (types.Hash, error) {
 	var (
 		err  error
 		trie *Trie
@@ -25,9 +25,9 @@ func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.
 	if root == emptyStateHash {
 		trie = s.state.newTrie()
 	} else {
-		trie, err = s.state.newTrieAt(root)
+		trie, err = s.state.newStorageTrieAt(root)
 		if err != nil {
-			return types.Hash{}
+			return types.Hash{}, err
 		}
 	}
 
@@ -35,22 +35,26 @@ func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.
 
 	val, ok := trie.Get(key, s.state.storage)
 	if !ok {
-		return types.Hash{}
+		if s.state.fork != nil && root != emptyStateHash {
+			return s.state.fork.getStorage(addr, rawkey)
+		}
+
+		return types.Hash{}, nil
 	}
 
 	p := &fastrlp.Parser{}
 
 	v, err := p.Parse(val)
 	if err != nil {
-		return types.Hash{}
+		return types.Hash{}, err
 	}
 
 	res := []byte{}
 	if res, err = v.GetBytes(res[:0]); err != nil {
-		return types.Hash{}
+		return types.Hash{}, err
 	}
 
-	return types.BytesToHash(res)
+	return types.BytesToHash(res), nil
 }
 
 func (s *Snapshot) GetAccount(addr types.Address) (*state.Account, error) {
@@ -58,6 +62,14 @@ func (s *Snapshot) GetAccount(addr types.Address) (*state.Account, error) {
 
 	data, ok := s.trie.Get(key, s.state.storage)
 	if !ok {
+		if s.state.fork != nil {
+			return s.state.fork.getAccount(addr, s.state.storage)
+		}
+
+		return nil, nil
+	}
+
+	if s.state.fork != nil && isForkTombstone(data) {
 		return nil, nil
 	}
 
@@ -84,7 +96,11 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte) {
 
 	for _, obj := range objs {
 		if obj.Deleted {
-			tt.Delete(hashit(obj.Address.Bytes()))
+			if s.state.fork != nil {
+				tt.Insert(hashit(obj.Address.Bytes()), forkTombstone)
+			} else {
+				tt.Delete(hashit(obj.Address.Bytes()))
+			}
 		} else {
 			account := state.Account{
 				Balance:  obj.Balance,
@@ -94,7 +110,7 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte) {
 			}
 
 			if len(obj.Storage) != 0 {
-				trie, err := s.state.newTrieAt(obj.Root)
+				trie, err := s.state.newStorageTrieAt(obj.Root)
 				if err != nil {
 					panic(err) //nolint:gocritic
 				}
@@ -104,11 +120,17 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte) {
 
 				for _, entry := range obj.Storage {
 					k := hashit(entry.Key)
-					if entry.Deleted {
+					if entry.Deleted && s.state.fork != nil {
+						localTxn.Insert(k, forkTombstone)
+					} else if entry.Deleted {
 						localTxn.Delete(k)
 					} else {
 						vv := arena.NewBytes(bytes.TrimLeft(entry.Val, "\x00"))
 						localTxn.Insert(k, vv.MarshalTo(nil))
+
+						if s.state.preimages {
+							batch.Put(preimageKey(k), entry.Key)
+						}
 					}
 				}
 
@@ -128,7 +150,14 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte) {
 			vv := account.MarshalWith(arena)
 			data := vv.MarshalTo(nil)
 
-			tt.Insert(hashit(obj.Address.Bytes()), data)
+			key := hashit(obj.Address.Bytes())
+
+			tt.Insert(key, data)
+
+			if s.state.preimages {
+				batch.Put(preimageKey(key), obj.Address.Bytes())
+			}
+
 			arena.Reset()
 		}
 	}
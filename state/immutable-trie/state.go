@@ -12,6 +12,12 @@ import (
 type State struct {
 	storage Storage
 	cache   *lru.Cache
+
+	// fork is set if the state is forked from a remote chain
+	fork *fork
+
+	// preimages is set if the preimages of the hashed keys are recorded on commit
+	preimages bool
 }
 
 func NewState(storage Storage) *State {
@@ -25,6 +31,12 @@ func NewState(storage Storage) *State {
 	return s
 }
 
+// EnablePreimages makes the committed states record the addresses and the storage keys their trie keys
+// are the hashes of, so the states can be dumped
+func (s *State) EnablePreimages() {
+	s.preimages = true
+}
+
 func (s *State) NewSnapshot() state.Snapshot {
 	return &Snapshot{state: s, trie: s.newTrie()}
 }
@@ -87,6 +99,17 @@ func (s *State) newTrieAt(root types.Hash) (*Trie, error) {
 	return t, nil
 }
 
+// newStorageTrieAt returns the account's storage trie. In the fork mode the storage of the accounts
+// loaded from the forked chain is not available locally, so their local trie starts empty
+func (s *State) newStorageTrieAt(root types.Hash) (*Trie, error) {
+	t, err := s.newTrieAt(root)
+	if err != nil && s.fork != nil {
+		return s.newTrie(), nil
+	}
+
+	return t, err
+}
+
 func (s *State) AddState(root types.Hash, t *Trie) {
 	s.cache.Add(root, t)
 }
@@ -0,0 +1,279 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+var errStateMismatch = errors.New("state mismatch")
+
+// AccountKeys returns the keys of all the accounts of the state in the key order,
+// the storage of the accounts is not walked
+func AccountKeys(root types.Hash, storage Storage) ([]types.Hash, error) {
+	e := &stateExporter{storage: storage}
+
+	var keys []types.Hash
+
+	err := e.walkRoot(root, func(key types.Hash, _ []byte) error {
+		keys = append(keys, key)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// CompareAccounts checks the accounts with the given keys have the same balance, nonce, code and storage
+// in both states. It returns the number of the compared storage slots
+func CompareAccounts(
+	keys []types.Hash,
+	root types.Hash,
+	storage Storage,
+	otherRoot types.Hash,
+	otherStorage Storage,
+) (int, error) {
+	return CompareGenesisAccounts(keys, root, storage, nil, otherRoot, otherStorage)
+}
+
+// CompareGenesisAccounts checks the accounts with the given keys of the genesis state, written on top of the state
+// with the given alloc, have the balance, nonce, code and storage of the accounts of the state changed by the alloc:
+// the balances are added, and the nonces, code and storage slots set by the alloc override the existing ones.
+// It returns the number of the compared storage slots
+func CompareGenesisAccounts(
+	keys []types.Hash,
+	root types.Hash,
+	storage Storage,
+	alloc map[types.Address]*chain.GenesisAccount,
+	genesisRoot types.Hash,
+	genesisStorage Storage,
+) (int, error) {
+	overrides := make(map[types.Hash]*chain.GenesisAccount, len(alloc))
+	for addr, account := range alloc {
+		overrides[types.BytesToHash(hashit(addr.Bytes()))] = account
+	}
+
+	var slots int
+
+	for _, key := range keys {
+		account, err := getAccountByKey(root, storage, key)
+		if err != nil {
+			return 0, err
+		}
+
+		other, err := getAccountByKey(genesisRoot, genesisStorage, key)
+		if err != nil {
+			return 0, err
+		}
+
+		override := overrides[key]
+		expected := applyGenesisAccount(account, override)
+
+		switch {
+		case expected == nil || other == nil:
+			if expected != nil || other != nil {
+				return 0, fmt.Errorf("%w: account %s exists only in one of the states", errStateMismatch, key)
+			}
+
+			continue
+
+		case expected.Balance.Cmp(other.Balance) != 0:
+			return 0, fmt.Errorf("%w: balance of the account %s is %s, expected %s",
+				errStateMismatch, key, other.Balance, expected.Balance)
+
+		case expected.Nonce != other.Nonce:
+			return 0, fmt.Errorf("%w: nonce of the account %s is %d, expected %d",
+				errStateMismatch, key, other.Nonce, expected.Nonce)
+
+		case !bytes.Equal(expected.CodeHash, other.CodeHash):
+			return 0, fmt.Errorf("%w: code of the account %s differs", errStateMismatch, key)
+		}
+
+		if codeHash := types.BytesToHash(expected.CodeHash); len(expected.CodeHash) != 0 && codeHash != types.EmptyCodeHash {
+			if _, ok := genesisStorage.GetCode(codeHash); !ok {
+				return 0, fmt.Errorf("%w of the account %s: %s", errMissingCode, key, codeHash)
+			}
+		}
+
+		var slotOverrides map[types.Hash]types.Hash
+		if override != nil {
+			slotOverrides = make(map[types.Hash]types.Hash, len(override.Storage))
+			for slot, value := range override.Storage {
+				slotOverrides[types.BytesToHash(hashit(slot.Bytes()))] = value
+			}
+		}
+
+		compared, err := compareStorage(key, expected, storage, slotOverrides, other, genesisStorage)
+		if err != nil {
+			return 0, err
+		}
+
+		slots += compared
+	}
+
+	return slots, nil
+}
+
+// applyGenesisAccount returns the account changed by the genesis account the way the genesis alloc is written
+func applyGenesisAccount(account *state.Account, genesis *chain.GenesisAccount) *state.Account {
+	if genesis == nil {
+		return account
+	}
+
+	if account == nil {
+		account = &state.Account{
+			Balance:  big.NewInt(0),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.EmptyCodeHash.Bytes(),
+		}
+	} else {
+		account = account.Copy()
+	}
+
+	if genesis.Balance != nil {
+		account.Balance = new(big.Int).Add(account.Balance, genesis.Balance)
+	}
+
+	if genesis.Nonce != 0 {
+		account.Nonce = genesis.Nonce
+	}
+
+	if len(genesis.Code) != 0 {
+		account.CodeHash = hashit(genesis.Code)
+	}
+
+	return account
+}
+
+// compareStorage checks every storage slot of the account, with the values of the overridden slots replaced,
+// is the same in the other account
+func compareStorage(
+	key types.Hash,
+	account *state.Account,
+	storage Storage,
+	overrides map[types.Hash]types.Hash,
+	other *state.Account,
+	otherStorage Storage,
+) (int, error) {
+	if account.Root == types.EmptyRootHash && other.Root == types.EmptyRootHash && len(overrides) == 0 {
+		return 0, nil
+	}
+
+	otherTrie, err := getTrie(other.Root, otherStorage)
+	if err != nil {
+		return 0, err
+	}
+
+	e := &stateExporter{storage: storage}
+	slots := 0
+
+	err = e.walkRoot(account.Root, func(slot types.Hash, value []byte) error {
+		if _, ok := overrides[slot]; ok {
+			return nil
+		}
+
+		otherValue, ok := otherTrie.Get(slot.Bytes(), otherStorage)
+		if !ok || !bytes.Equal(value, otherValue) {
+			return fmt.Errorf("%w: storage slot %s of the account %s differs", errStateMismatch, slot, key)
+		}
+
+		slots++
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var arena fastrlp.Arena
+
+	for slot, value := range overrides {
+		otherValue, ok := otherTrie.Get(slot.Bytes(), otherStorage)
+
+		// the zero value deletes the slot
+		if value == types.ZeroHash {
+			if ok {
+				return 0, fmt.Errorf("%w: storage slot %s of the account %s is not deleted", errStateMismatch, slot, key)
+			}
+
+			continue
+		}
+
+		if !ok || !bytes.Equal(arena.NewBytes(bytes.TrimLeft(value.Bytes(), "\x00")).MarshalTo(nil), otherValue) {
+			return 0, fmt.Errorf("%w: storage slot %s of the account %s differs", errStateMismatch, slot, key)
+		}
+
+		slots++
+	}
+
+	// every slot of the account is in the other account, so the other one must not have any other slots
+	if len(overrides) == 0 {
+		if account.Root != other.Root {
+			return 0, fmt.Errorf("%w: storage of the account %s has extra slots", errStateMismatch, key)
+		}
+
+		return slots, nil
+	}
+
+	otherSlots := 0
+
+	if err := (&stateExporter{storage: otherStorage}).walkRoot(other.Root, func(types.Hash, []byte) error {
+		otherSlots++
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	if otherSlots != slots {
+		return 0, fmt.Errorf("%w: storage of the account %s has extra slots", errStateMismatch, key)
+	}
+
+	return slots, nil
+}
+
+// getAccountByKey returns the account with the given hashed key, or nil if it does not exist
+func getAccountByKey(root types.Hash, storage Storage, key types.Hash) (*state.Account, error) {
+	trie, err := getTrie(root, storage)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := trie.Get(key.Bytes(), storage)
+	if !ok {
+		return nil, nil
+	}
+
+	var account state.Account
+	if err := account.UnmarshalRlp(data); err != nil {
+		return nil, fmt.Errorf("can't parse account %s: %w", key, err)
+	}
+
+	return &account, nil
+}
+
+func getTrie(root types.Hash, storage Storage) (*Trie, error) {
+	if root == types.EmptyRootHash {
+		return NewTrie(), nil
+	}
+
+	node, ok, err := GetNode(root.Bytes(), storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errMissingNode, root)
+	}
+
+	return NewTrieWithRoot(node), nil
+}
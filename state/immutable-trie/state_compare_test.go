@@ -0,0 +1,180 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareAccounts(t *testing.T) {
+	t.Parallel()
+
+	st, root := buildExportTestState(t)
+
+	keys, err := AccountKeys(root, st.storage)
+	require.NoError(t, err)
+	require.Len(t, keys, 20)
+
+	// the copied state is the same
+	copied := NewMemoryStorage()
+	require.NoError(t, CopyTrie(root.Bytes(), st.storage, copied, nil, false))
+
+	slots, err := CompareAccounts(keys, root, st.storage, root, copied)
+	require.NoError(t, err)
+	// 0 + 2 + ... + 18 storage slots
+	assert.Equal(t, 90, slots)
+
+	cases := []struct {
+		name string
+		obj  *state.Object
+	}{
+		{
+			name: "balance",
+			obj: &state.Object{
+				Address:  types.StringToAddress("0x1001"),
+				Balance:  big.NewInt(3),
+				Nonce:    1,
+				Root:     types.EmptyRootHash,
+				CodeHash: types.EmptyCodeHash,
+			},
+		},
+		{
+			name: "nonce",
+			obj: &state.Object{
+				Address:  types.StringToAddress("0x1001"),
+				Balance:  big.NewInt(2),
+				Nonce:    2,
+				Root:     types.EmptyRootHash,
+				CodeHash: types.EmptyCodeHash,
+			},
+		},
+		{
+			name: "storage",
+			obj: &state.Object{
+				Address:  types.StringToAddress("0x1002"),
+				Balance:  big.NewInt(3),
+				Nonce:    2,
+				Root:     types.EmptyRootHash,
+				CodeHash: types.BytesToHash(crypto.Keccak256([]byte{0x60, 0x01, 0x60, 0x00, 0x55})),
+				Storage: []*state.StorageObject{
+					{Key: types.ZeroHash.Bytes(), Val: types.StringToHash("0x1").Bytes()},
+				},
+			},
+		},
+		{
+			name: "new account",
+			obj: &state.Object{
+				Address:  types.StringToAddress("0x2000"),
+				Balance:  big.NewInt(1),
+				Root:     types.EmptyRootHash,
+				CodeHash: types.EmptyCodeHash,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			snap, err := st.NewSnapshotAt(root)
+			require.NoError(t, err)
+
+			_, changed := snap.Commit([]*state.Object{c.obj})
+
+			newKeys, err := AccountKeys(types.BytesToHash(changed), st.storage)
+			require.NoError(t, err)
+
+			_, err = CompareAccounts(newKeys, types.BytesToHash(changed), st.storage, root, st.storage)
+			assert.ErrorIs(t, err, errStateMismatch)
+		})
+	}
+
+	// the missing state is reported
+	_, err = CompareAccounts(keys, root, st.storage, types.StringToHash("0x1"), copied)
+	assert.ErrorIs(t, err, errMissingNode)
+}
+
+func TestCompareGenesisAccounts(t *testing.T) {
+	t.Parallel()
+
+	st, root := buildExportTestState(t)
+
+	keys, err := AccountKeys(root, st.storage)
+	require.NoError(t, err)
+
+	alloc := map[types.Address]*chain.GenesisAccount{
+		types.StringToAddress("0x1004"): {
+			Balance: big.NewInt(10),
+			Storage: map[types.Hash]types.Hash{
+				types.BytesToHash(big.NewInt(0).Bytes()):  types.ZeroHash,
+				types.BytesToHash(big.NewInt(1).Bytes()):  types.StringToHash("0x99"),
+				types.BytesToHash(big.NewInt(50).Bytes()): types.StringToHash("0x5"),
+			},
+		},
+		types.StringToAddress("0x1001"): {
+			Nonce: 7,
+			Code:  []byte{0x60, 0x00},
+		},
+		types.StringToAddress("0x3000"): {
+			Balance: big.NewInt(1),
+		},
+	}
+
+	executor := state.NewExecutor(&chain.Params{Forks: chain.AllForksEnabled}, st, hclog.NewNullLogger())
+
+	genesisRoot, err := executor.WriteGenesis(alloc, root)
+	require.NoError(t, err)
+
+	// 0 + 2 + ... + 18 storage slots, one of them deleted and another one added
+	slots, err := CompareGenesisAccounts(keys, root, st.storage, alloc, genesisRoot, st.storage)
+	require.NoError(t, err)
+	assert.Equal(t, 90, slots)
+
+	// the changed accounts differ from the state before the genesis
+	_, err = CompareAccounts(keys, root, st.storage, genesisRoot, st.storage)
+	assert.ErrorIs(t, err, errStateMismatch)
+
+	cases := []struct {
+		name    string
+		account *chain.GenesisAccount
+	}{
+		{
+			name:    "balance",
+			account: &chain.GenesisAccount{Balance: big.NewInt(11)},
+		},
+		{
+			name:    "nonce",
+			account: &chain.GenesisAccount{Balance: big.NewInt(10), Nonce: 1},
+		},
+		{
+			name: "storage",
+			account: &chain.GenesisAccount{
+				Balance: big.NewInt(10),
+				Storage: map[types.Hash]types.Hash{
+					types.BytesToHash(big.NewInt(1).Bytes()): types.StringToHash("0x99"),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		changed := map[types.Address]*chain.GenesisAccount{}
+		for addr, account := range alloc {
+			changed[addr] = account
+		}
+
+		changed[types.StringToAddress("0x1004")] = c.account
+
+		_, err = CompareGenesisAccounts(keys, root, st.storage, changed, genesisRoot, st.storage)
+		assert.ErrorIs(t, err, errStateMismatch, c.name)
+	}
+}
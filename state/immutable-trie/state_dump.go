@@ -0,0 +1,116 @@
+package itrie
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+var (
+	errMissingPreimage = errors.New("missing preimage")
+
+	ErrNoPreimages = errors.New("the preimages of the states committed since the genesis are not recorded")
+)
+
+// DumpState walks the state with the given root and passes every account, with its code and storage, to the callback.
+// The addresses and the storage keys are resolved from the preimages recorded when the state was committed,
+// so the preimages of every state since the genesis have to be recorded
+func DumpState(root types.Hash, storage Storage, fn func(types.Address, *chain.GenesisAccount) error) error {
+	if !HasCompletePreimages(storage) {
+		return ErrNoPreimages
+	}
+
+	d := &stateDumper{
+		storage: storage,
+		fn:      fn,
+	}
+
+	if err := ExportState(root, storage, d); err != nil {
+		return err
+	}
+
+	return d.flush()
+}
+
+// stateDumper collects the storage of every account before passing the account to the callback
+type stateDumper struct {
+	storage Storage
+	fn      func(types.Address, *chain.GenesisAccount) error
+	parser  fastrlp.Parser
+
+	addr    types.Address
+	account *chain.GenesisAccount
+}
+
+func (d *stateDumper) VisitAccount(key types.Hash, account *state.Account) error {
+	if err := d.flush(); err != nil {
+		return err
+	}
+
+	preimage, ok := GetPreimage(d.storage, key)
+	if !ok || len(preimage) != types.AddressLength {
+		return fmt.Errorf("%w of the account %s", errMissingPreimage, key)
+	}
+
+	d.addr = types.BytesToAddress(preimage)
+	d.account = &chain.GenesisAccount{
+		Balance: account.Balance,
+		Nonce:   account.Nonce,
+	}
+
+	if codeHash := types.BytesToHash(account.CodeHash); len(account.CodeHash) != 0 && codeHash != types.EmptyCodeHash {
+		code, ok := d.storage.GetCode(codeHash)
+		if !ok {
+			return fmt.Errorf("%w of the account %s: %s", errMissingCode, d.addr, codeHash)
+		}
+
+		d.account.Code = code
+	}
+
+	return nil
+}
+
+func (d *stateDumper) VisitStorage(key types.Hash, value []byte) error {
+	preimage, ok := GetPreimage(d.storage, key)
+	if !ok || len(preimage) != types.HashLength {
+		return fmt.Errorf("%w of the storage slot %s of the account %s", errMissingPreimage, key, d.addr)
+	}
+
+	v, err := d.parser.Parse(value)
+	if err != nil {
+		return err
+	}
+
+	data, err := v.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if d.account.Storage == nil {
+		d.account.Storage = map[types.Hash]types.Hash{}
+	}
+
+	d.account.Storage[types.BytesToHash(preimage)] = types.BytesToHash(data)
+
+	return nil
+}
+
+func (d *stateDumper) VisitCode(types.Hash, []byte) error {
+	// the code is read for every account using it
+	return nil
+}
+
+func (d *stateDumper) flush() error {
+	if d.account == nil {
+		return nil
+	}
+
+	account := d.account
+	d.account = nil
+
+	return d.fn(d.addr, account)
+}
@@ -0,0 +1,103 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpState(t *testing.T) {
+	t.Parallel()
+
+	st, root := buildExportTestState(t)
+
+	accounts := map[types.Address]*chain.GenesisAccount{}
+
+	require.NoError(t, DumpState(root, st.storage, func(addr types.Address, account *chain.GenesisAccount) error {
+		accounts[addr] = account
+
+		return nil
+	}))
+
+	require.Len(t, accounts, 20)
+
+	account, ok := accounts[types.StringToAddress("0x1004")]
+	require.True(t, ok)
+
+	assert.Equal(t, big.NewInt(5), account.Balance)
+	assert.Equal(t, uint64(4), account.Nonce)
+	assert.Equal(t, []byte{0x60, 0x01, 0x60, 0x00, 0x55}, account.Code)
+	require.Len(t, account.Storage, 4)
+	assert.Equal(t, types.BytesToHash(big.NewInt(103).Bytes()), account.Storage[types.BytesToHash(big.NewInt(3).Bytes())])
+
+	account, ok = accounts[types.StringToAddress("0x1001")]
+	require.True(t, ok)
+
+	assert.Empty(t, account.Code)
+	assert.Empty(t, account.Storage)
+}
+
+func TestDumpState_MissingPreimage(t *testing.T) {
+	t.Parallel()
+
+	st, root := buildExportTestState(t)
+
+	// the imported state has no preimages
+	importer := NewStateImporter(NewMemoryStorage())
+	require.NoError(t, ExportState(root, st.storage, importer))
+
+	_, err := importer.Commit()
+	require.NoError(t, err)
+
+	err = DumpState(root, importer.storage, func(types.Address, *chain.GenesisAccount) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrNoPreimages)
+
+	SetCompletePreimages(importer.storage, true)
+
+	err = DumpState(root, importer.storage, func(types.Address, *chain.GenesisAccount) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, errMissingPreimage)
+}
+
+func TestState_Preimages(t *testing.T) {
+	t.Parallel()
+
+	addr := types.StringToAddress("0x1")
+	objs := []*state.Object{
+		{
+			Address:  addr,
+			Balance:  big.NewInt(1),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.EmptyCodeHash,
+		},
+	}
+
+	// the preimages are not recorded by default
+	st := NewState(NewMemoryStorage())
+	st.NewSnapshot().Commit(objs)
+
+	_, ok := GetPreimage(st.storage, types.BytesToHash(hashit(addr.Bytes())))
+	assert.False(t, ok)
+	assert.False(t, HasCompletePreimages(st.storage))
+
+	st.EnablePreimages()
+	st.NewSnapshot().Commit(objs)
+
+	preimage, ok := GetPreimage(st.storage, types.BytesToHash(hashit(addr.Bytes())))
+	require.True(t, ok)
+	assert.Equal(t, addr.Bytes(), preimage)
+
+	SetCompletePreimages(st.storage, true)
+	assert.True(t, HasCompletePreimages(st.storage))
+
+	SetCompletePreimages(st.storage, false)
+	assert.False(t, HasCompletePreimages(st.storage))
+}
@@ -0,0 +1,273 @@
+package itrie
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errMissingNode         = errors.New("missing trie node")
+	errStorageWithoutOwner = errors.New("storage slot without account")
+	errStorageRootMismatch = errors.New("storage root mismatch")
+	errCodeHashMismatch    = errors.New("code hash mismatch")
+	errMissingCode         = errors.New("missing code")
+)
+
+// StateVisitor receives the entries of the state trie.
+// Each account is followed by its storage slots, the keys are the hashes of the addresses and the storage keys
+type StateVisitor interface {
+	// VisitAccount receives the account with the given key
+	VisitAccount(key types.Hash, account *state.Account) error
+
+	// VisitStorage receives the RLP encoded value of the storage slot of the last visited account
+	VisitStorage(key types.Hash, value []byte) error
+
+	// VisitCode receives the code with the given hash, it is visited once before the first account using it
+	VisitCode(hash types.Hash, code []byte) error
+}
+
+// ExportState walks the state trie with the given root in the key order
+func ExportState(root types.Hash, storage Storage, visitor StateVisitor) error {
+	e := &stateExporter{
+		storage: storage,
+		visitor: visitor,
+		codes:   map[types.Hash]struct{}{},
+	}
+
+	return e.walkRoot(root, e.visitAccount)
+}
+
+type stateExporter struct {
+	storage Storage
+	visitor StateVisitor
+	codes   map[types.Hash]struct{}
+}
+
+func (e *stateExporter) walkRoot(root types.Hash, visitLeaf func(key types.Hash, value []byte) error) error {
+	if root == types.EmptyRootHash {
+		return nil
+	}
+
+	node, ok, err := GetNode(root.Bytes(), e.storage)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: %s", errMissingNode, root)
+	}
+
+	return e.walk(node, nil, visitLeaf)
+}
+
+func (e *stateExporter) walk(node Node, path []byte, visitLeaf func(key types.Hash, value []byte) error) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+
+	case *ValueNode:
+		if n.hash {
+			resolved, ok, err := GetNode(n.buf, e.storage)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				return fmt.Errorf("%w: %s", errMissingNode, types.BytesToHash(n.buf))
+			}
+
+			return e.walk(resolved, path, visitLeaf)
+		}
+
+		key, err := nibblesToHash(path)
+		if err != nil {
+			return err
+		}
+
+		return visitLeaf(key, n.buf)
+
+	case *ShortNode:
+		return e.walk(n.child, concat(path, n.key), visitLeaf)
+
+	case *FullNode:
+		for i, child := range n.children {
+			if child == nil {
+				continue
+			}
+
+			if err := e.walk(child, concat(path, []byte{byte(i)}), visitLeaf); err != nil {
+				return err
+			}
+		}
+
+		return e.walk(n.value, path, visitLeaf)
+
+	default:
+		return fmt.Errorf("unknown node type %T", node)
+	}
+}
+
+func (e *stateExporter) visitAccount(key types.Hash, value []byte) error {
+	var account state.Account
+	if err := account.UnmarshalRlp(value); err != nil {
+		return fmt.Errorf("can't parse account %s: %w", key, err)
+	}
+
+	codeHash := types.BytesToHash(account.CodeHash)
+	if _, ok := e.codes[codeHash]; !ok && codeHash != types.EmptyCodeHash && len(account.CodeHash) != 0 {
+		code, ok := e.storage.GetCode(codeHash)
+		if !ok {
+			return fmt.Errorf("%w: %s", errMissingCode, codeHash)
+		}
+
+		if err := e.visitor.VisitCode(codeHash, code); err != nil {
+			return err
+		}
+
+		e.codes[codeHash] = struct{}{}
+	}
+
+	if err := e.visitor.VisitAccount(key, &account); err != nil {
+		return err
+	}
+
+	return e.walkRoot(account.Root, e.visitor.VisitStorage)
+}
+
+// nibblesToHash packs the path of a leaf into its key
+func nibblesToHash(path []byte) (types.Hash, error) {
+	if hasTerminator(path) {
+		path = path[:len(path)-1]
+	}
+
+	if len(path) != 2*types.HashLength {
+		return types.Hash{}, fmt.Errorf("invalid leaf key length %d", len(path))
+	}
+
+	var key types.Hash
+	for i := range key {
+		key[i] = path[2*i]<<4 | path[2*i+1]
+	}
+
+	return key, nil
+}
+
+// StateImporter builds the state trie from the entries received in the order of ExportState.
+// The storage roots and the code hashes are verified against the accounts
+type StateImporter struct {
+	storage  Storage
+	batch    Batch
+	accounts *Txn
+
+	// the account whose storage slots are being imported
+	accountKey types.Hash
+	account    *state.Account
+	slots      *Txn
+	slotsBatch Batch
+}
+
+// NewStateImporter creates the importer writing the state trie to the given storage
+func NewStateImporter(storage Storage) *StateImporter {
+	batch := storage.Batch()
+
+	accounts := NewTrie().Txn(storage)
+	accounts.batch = batch
+
+	return &StateImporter{
+		storage:  storage,
+		batch:    batch,
+		accounts: accounts,
+	}
+}
+
+// VisitAccount adds the account, its storage slots have to follow it
+func (i *StateImporter) VisitAccount(key types.Hash, account *state.Account) error {
+	if err := i.finishAccount(); err != nil {
+		return err
+	}
+
+	// the storage trie of every account is written separately,
+	// so the whole state is not held in a single batch
+	i.slotsBatch = i.storage.Batch()
+	i.slots = NewTrie().Txn(i.storage)
+	i.slots.batch = i.slotsBatch
+
+	i.accountKey = key
+	i.account = account
+
+	return nil
+}
+
+// VisitStorage adds the storage slot of the last added account
+func (i *StateImporter) VisitStorage(key types.Hash, value []byte) error {
+	if i.account == nil {
+		return fmt.Errorf("%w: %s", errStorageWithoutOwner, key)
+	}
+
+	i.slots.Insert(key.Bytes(), value)
+
+	return nil
+}
+
+// VisitCode adds the code with the given hash
+func (i *StateImporter) VisitCode(hash types.Hash, code []byte) error {
+	if codeHash := types.BytesToHash(crypto.Keccak256(code)); codeHash != hash {
+		return fmt.Errorf("%w: expected %s, got %s", errCodeHashMismatch, hash, codeHash)
+	}
+
+	i.storage.SetCode(hash, code)
+
+	return nil
+}
+
+// Commit writes the state trie and returns its root
+func (i *StateImporter) Commit() (types.Hash, error) {
+	if err := i.finishAccount(); err != nil {
+		return types.Hash{}, err
+	}
+
+	root, err := i.accounts.Hash()
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	i.batch.Write()
+
+	return types.BytesToHash(root), nil
+}
+
+// finishAccount writes the storage trie of the last added account and adds the account to the state trie
+func (i *StateImporter) finishAccount() error {
+	if i.account == nil {
+		return nil
+	}
+
+	root, err := i.slots.Hash()
+	if err != nil {
+		return err
+	}
+
+	if storageRoot := types.BytesToHash(root); storageRoot != i.account.Root {
+		return fmt.Errorf("%w of the account %s: expected %s, got %s",
+			errStorageRootMismatch, i.accountKey, i.account.Root, storageRoot)
+	}
+
+	i.slotsBatch.Write()
+
+	codeHash := types.BytesToHash(i.account.CodeHash)
+	if _, ok := i.storage.GetCode(codeHash); !ok && codeHash != types.EmptyCodeHash && len(i.account.CodeHash) != 0 {
+		return fmt.Errorf("%w of the account %s: %s", errMissingCode, i.accountKey, codeHash)
+	}
+
+	arena := stateArenaPool.Get()
+	defer stateArenaPool.Put(arena)
+
+	i.accounts.Insert(i.accountKey.Bytes(), i.account.MarshalWith(arena).MarshalTo(nil))
+	i.account = nil
+
+	return nil
+}
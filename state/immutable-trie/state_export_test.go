@@ -0,0 +1,175 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingVisitor struct {
+	accounts map[types.Hash]*state.Account
+	slots    int
+	codes    []types.Hash
+}
+
+func (v *recordingVisitor) VisitAccount(key types.Hash, account *state.Account) error {
+	v.accounts[key] = account
+
+	return nil
+}
+
+func (v *recordingVisitor) VisitStorage(types.Hash, []byte) error {
+	v.slots++
+
+	return nil
+}
+
+func (v *recordingVisitor) VisitCode(hash types.Hash, _ []byte) error {
+	v.codes = append(v.codes, hash)
+
+	return nil
+}
+
+func buildExportTestState(t *testing.T) (*State, types.Hash) {
+	t.Helper()
+
+	code := []byte{0x60, 0x01, 0x60, 0x00, 0x55}
+	codeHash := types.BytesToHash(crypto.Keccak256(code))
+
+	objs := make([]*state.Object, 0, 20)
+
+	for i := 0; i < 20; i++ {
+		obj := &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(0x1000 + i)).Bytes()),
+			Balance:  big.NewInt(int64(i + 1)),
+			Nonce:    uint64(i),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.EmptyCodeHash,
+		}
+
+		// every other account is a contract sharing the same code
+		if i%2 == 0 {
+			obj.CodeHash = codeHash
+			obj.Code = code
+			obj.DirtyCode = true
+
+			for j := 0; j < i; j++ {
+				obj.Storage = append(obj.Storage, &state.StorageObject{
+					Key: types.BytesToHash(big.NewInt(int64(j)).Bytes()).Bytes(),
+					Val: types.BytesToHash(big.NewInt(int64(j + 100)).Bytes()).Bytes(),
+				})
+			}
+		}
+
+		objs = append(objs, obj)
+	}
+
+	// the preimages are recorded since the genesis, so the state can be dumped
+	st := NewState(NewMemoryStorage())
+	st.EnablePreimages()
+	SetCompletePreimages(st.storage, true)
+
+	_, root := st.NewSnapshot().Commit(objs)
+
+	return st, types.BytesToHash(root)
+}
+
+func TestExportState(t *testing.T) {
+	t.Parallel()
+
+	st, root := buildExportTestState(t)
+
+	visitor := &recordingVisitor{accounts: map[types.Hash]*state.Account{}}
+	require.NoError(t, ExportState(root, st.storage, visitor))
+
+	assert.Len(t, visitor.accounts, 20)
+	assert.Len(t, visitor.codes, 1)
+	// 0 + 2 + ... + 18 storage slots
+	assert.Equal(t, 90, visitor.slots)
+
+	account, ok := visitor.accounts[types.BytesToHash(crypto.Keccak256(types.StringToAddress("0x1001").Bytes()))]
+	require.True(t, ok)
+	assert.Equal(t, big.NewInt(2), account.Balance)
+
+	// the missing nodes are reported
+	assert.ErrorIs(t, ExportState(types.StringToHash("0x1"), st.storage, visitor), errMissingNode)
+}
+
+func TestStateImporter(t *testing.T) {
+	t.Parallel()
+
+	st, root := buildExportTestState(t)
+
+	importer := NewStateImporter(NewMemoryStorage())
+	require.NoError(t, ExportState(root, st.storage, importer))
+
+	importedRoot, err := importer.Commit()
+	require.NoError(t, err)
+	assert.Equal(t, root, importedRoot)
+
+	imported := NewState(importer.storage)
+
+	snap, err := imported.NewSnapshotAt(importedRoot)
+	require.NoError(t, err)
+
+	addr := types.StringToAddress("0x1004")
+
+	account, err := snap.GetAccount(addr)
+	require.NoError(t, err)
+	require.NotNil(t, account)
+
+	assert.Equal(t, uint64(4), account.Nonce)
+
+	value, err := snap.GetStorage(addr, account.Root, types.BytesToHash(big.NewInt(3).Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, types.BytesToHash(big.NewInt(103).Bytes()), value)
+
+	code, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+	require.True(t, ok)
+	assert.Equal(t, []byte{0x60, 0x01, 0x60, 0x00, 0x55}, code)
+
+	checked, err := HashChecker(importedRoot.Bytes(), importer.storage)
+	require.NoError(t, err)
+	assert.Equal(t, root, checked)
+}
+
+func TestStateImporter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key     = types.StringToHash("0x1")
+		account = &state.Account{
+			Balance:  big.NewInt(1),
+			Root:     types.EmptyRootHash,
+			CodeHash: types.EmptyCodeHash.Bytes(),
+		}
+	)
+
+	importer := NewStateImporter(NewMemoryStorage())
+	assert.ErrorIs(t, importer.VisitStorage(key, []byte{0x01}), errStorageWithoutOwner)
+	assert.ErrorIs(t, importer.VisitCode(key, []byte{0x01}), errCodeHashMismatch)
+
+	// the slots do not match the storage root of the account
+	require.NoError(t, importer.VisitAccount(key, account))
+	require.NoError(t, importer.VisitStorage(key, []byte{0x01}))
+
+	_, err := importer.Commit()
+	assert.ErrorIs(t, err, errStorageRootMismatch)
+
+	// the code of the account is missing
+	importer = NewStateImporter(NewMemoryStorage())
+
+	require.NoError(t, importer.VisitAccount(key, &state.Account{
+		Balance:  big.NewInt(1),
+		Root:     types.EmptyRootHash,
+		CodeHash: crypto.Keccak256([]byte{0x01}),
+	}))
+
+	_, err = importer.Commit()
+	assert.ErrorIs(t, err, errMissingCode)
+}
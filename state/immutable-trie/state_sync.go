@@ -0,0 +1,225 @@
+package itrie
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+// NodeFetcher fetches the trie nodes or the contract codes by their hashes, verifying them against the hashes.
+// The ones which could not be fetched are returned empty
+type NodeFetcher func(hashes []types.Hash) ([][]byte, error)
+
+// syncKind is the kind of the data referred by the hash
+type syncKind int
+
+const (
+	syncAccountNode syncKind = iota
+	syncStorageNode
+	syncCode
+)
+
+type syncItem struct {
+	hash types.Hash
+	kind syncKind
+}
+
+// SyncState downloads the state trie with the given root into the storage, along with the storage tries
+// and the codes of its accounts, fetching up to batchSize of the missing trie nodes and codes at once.
+// The trie nodes and codes already saved are not fetched again, so an interrupted download is resumed,
+// as the nodes are saved before their children
+func SyncState(root types.Hash, storage Storage, fetch NodeFetcher, batchSize int) error {
+	if root == types.EmptyRootHash {
+		return nil
+	}
+
+	s := &stateSync{
+		storage: storage,
+		queue:   []syncItem{{hash: root, kind: syncAccountNode}},
+		seen:    map[types.Hash]struct{}{root: {}},
+	}
+
+	for len(s.queue) > 0 {
+		missing := make([]syncItem, 0, batchSize)
+
+		// the items saved already are walked locally, the missing ones are fetched in batches
+		for len(s.queue) > 0 && len(missing) < batchSize {
+			item := s.queue[len(s.queue)-1]
+			s.queue = s.queue[:len(s.queue)-1]
+
+			data, ok := s.load(item)
+			if !ok {
+				missing = append(missing, item)
+
+				continue
+			}
+
+			if err := s.process(item, data); err != nil {
+				return err
+			}
+		}
+
+		if len(missing) > 0 {
+			if err := s.fetchMissing(missing, fetch); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type stateSync struct {
+	storage Storage
+
+	// queue holds the items to walk, the last one first, so the tries are walked depth first
+	queue []syncItem
+	seen  map[types.Hash]struct{}
+}
+
+// fetchMissing fetches the missing items and saves them, the ones not fetched are queued again
+func (s *stateSync) fetchMissing(missing []syncItem, fetch NodeFetcher) error {
+	hashes := make([]types.Hash, len(missing))
+	for i, item := range missing {
+		hashes[i] = item.hash
+	}
+
+	data, err := fetch(hashes)
+	if err != nil {
+		return err
+	}
+
+	var (
+		batch   = s.storage.Batch()
+		fetched = 0
+	)
+
+	for i, item := range missing {
+		if i >= len(data) || len(data[i]) == 0 {
+			s.queue = append(s.queue, item)
+
+			continue
+		}
+
+		if item.kind == syncCode {
+			s.storage.SetCode(item.hash, data[i])
+		} else {
+			batch.Put(item.hash.Bytes(), data[i])
+		}
+
+		if err := s.process(item, data[i]); err != nil {
+			return err
+		}
+
+		fetched++
+	}
+
+	batch.Write()
+
+	if fetched == 0 {
+		return fmt.Errorf("%w: %s", errMissingNode, missing[0].hash)
+	}
+
+	return nil
+}
+
+// load returns the item saved in the storage
+func (s *stateSync) load(item syncItem) ([]byte, bool) {
+	if item.kind == syncCode {
+		return s.storage.GetCode(item.hash)
+	}
+
+	return s.storage.Get(item.hash.Bytes())
+}
+
+// process queues the items the trie node refers to: its children,
+// and the storage root and the code of the accounts in its leaves
+func (s *stateSync) process(item syncItem, data []byte) error {
+	if item.kind == syncCode {
+		return nil
+	}
+
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	v, err := p.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	if v.Type() != fastrlp.TypeArray {
+		return fmt.Errorf("storage item should be an array")
+	}
+
+	node, err := decodeNode(v, s.storage)
+	if err != nil {
+		return err
+	}
+
+	return s.walk(node, item.kind)
+}
+
+func (s *stateSync) walk(node Node, kind syncKind) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+
+	case *ValueNode:
+		if n.hash {
+			s.add(types.BytesToHash(n.buf), kind)
+
+			return nil
+		}
+
+		if kind == syncAccountNode {
+			return s.addAccount(n.buf)
+		}
+
+		return nil
+
+	case *ShortNode:
+		return s.walk(n.child, kind)
+
+	case *FullNode:
+		for _, child := range n.children {
+			if err := s.walk(child, kind); err != nil {
+				return err
+			}
+		}
+
+		return s.walk(n.value, kind)
+
+	default:
+		return fmt.Errorf("unknown node type %T", node)
+	}
+}
+
+// addAccount queues the storage root and the code of the account
+func (s *stateSync) addAccount(value []byte) error {
+	var account state.Account
+	if err := account.UnmarshalRlp(value); err != nil {
+		return fmt.Errorf("can't parse account: %w", err)
+	}
+
+	if account.Root != types.EmptyRootHash {
+		s.add(account.Root, syncStorageNode)
+	}
+
+	if codeHash := types.BytesToHash(account.CodeHash); len(account.CodeHash) != 0 && codeHash != types.EmptyCodeHash {
+		s.add(codeHash, syncCode)
+	}
+
+	return nil
+}
+
+// add queues the item, unless it has been queued already
+func (s *stateSync) add(hash types.Hash, kind syncKind) {
+	if _, ok := s.seen[hash]; ok {
+		return
+	}
+
+	s.seen[hash] = struct{}{}
+	s.queue = append(s.queue, syncItem{hash: hash, kind: kind})
+}
@@ -0,0 +1,69 @@
+package itrie
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncState(t *testing.T) {
+	t.Parallel()
+
+	st, root := buildExportTestState(t)
+
+	var (
+		errFetch = errors.New("fetch failed")
+		fetched  = map[types.Hash]int{}
+		calls    = 0
+		failAt   = 2
+	)
+
+	fetch := func(hashes []types.Hash) ([][]byte, error) {
+		calls++
+		if calls == failAt {
+			return nil, errFetch
+		}
+
+		data := make([][]byte, len(hashes))
+
+		for i, hash := range hashes {
+			fetched[hash]++
+
+			if v, ok := st.storage.Get(hash.Bytes()); ok {
+				data[i] = v
+			} else if code, ok := st.storage.GetCode(hash); ok {
+				data[i] = code
+			}
+		}
+
+		return data, nil
+	}
+
+	synced := NewMemoryStorage()
+
+	// the download is interrupted
+	require.ErrorIs(t, SyncState(root, synced, fetch, 4), errFetch)
+
+	// and resumed without fetching again what has been saved
+	require.NoError(t, SyncState(root, synced, fetch, 4))
+
+	for hash, count := range fetched {
+		assert.Equal(t, 1, count, hash.String())
+	}
+
+	keys, err := AccountKeys(root, st.storage)
+	require.NoError(t, err)
+
+	slots, err := CompareAccounts(keys, root, st.storage, root, synced)
+	require.NoError(t, err)
+	assert.Equal(t, 90, slots)
+
+	// the nodes which can't be fetched
+	err = SyncState(root, NewMemoryStorage(), func(hashes []types.Hash) ([][]byte, error) {
+		return make([][]byte, len(hashes)), nil
+	}, 4)
+	require.ErrorIs(t, err, errMissingNode)
+}
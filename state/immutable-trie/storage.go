@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/umbracle/fastrlp"
 )
 
@@ -16,8 +17,41 @@ var parserPool fastrlp.ParserPool
 var (
 	// codePrefix is the code prefix for leveldb
 	codePrefix = []byte("code")
+
+	// preimagePrefix is the prefix of the preimages of the hashed trie keys for leveldb
+	preimagePrefix = []byte("preimage")
+
+	// completePreimagesKey marks the storage which has the preimages of every state committed since the genesis
+	completePreimagesKey = []byte("complete-preimages")
 )
 
+func preimageKey(hash []byte) []byte {
+	return append(append(make([]byte, 0, len(preimagePrefix)+len(hash)), preimagePrefix...), hash...)
+}
+
+// GetPreimage returns the address or the storage key the given trie key is the hash of.
+// The preimages are recorded when the state is committed, if they are enabled
+func GetPreimage(storage Storage, hash types.Hash) ([]byte, bool) {
+	return storage.Get(preimageKey(hash.Bytes()))
+}
+
+// SetCompletePreimages records whether the storage has the preimages of every state committed since the genesis
+func SetCompletePreimages(storage Storage, complete bool) {
+	value := []byte{0}
+	if complete {
+		value = []byte{1}
+	}
+
+	storage.Put(completePreimagesKey, value)
+}
+
+// HasCompletePreimages returns true if the storage has the preimages of every state committed since the genesis
+func HasCompletePreimages(storage Storage) bool {
+	value, ok := storage.Get(completePreimagesKey)
+
+	return ok && len(value) == 1 && value[0] == 1
+}
+
 type Batch interface {
 	Put(k, v []byte)
 	Write()
@@ -86,6 +120,11 @@ func (kv *KVStorage) Close() error {
 	return kv.db.Close()
 }
 
+// Compact compacts the whole leveldb storage, discarding the deleted and overwritten entries
+func (kv *KVStorage) Compact() error {
+	return kv.db.CompactRange(util.Range{})
+}
+
 func NewLevelDBStorage(path string, logger hclog.Logger) (Storage, error) {
 	db, err := leveldb.OpenFile(path, nil)
 	if err != nil {
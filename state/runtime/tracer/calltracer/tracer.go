@@ -0,0 +1,188 @@
+package calltracer
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Call is a single call frame of the transaction, along with the calls it made
+type Call struct {
+	Type    string        `json:"type"`
+	From    types.Address `json:"from"`
+	To      types.Address `json:"to"`
+	Value   string        `json:"value,omitempty"`
+	Gas     string        `json:"gas"`
+	GasUsed string        `json:"gasUsed,omitempty"`
+	Input   string        `json:"input"`
+	Output  string        `json:"output,omitempty"`
+	Error   string        `json:"error,omitempty"`
+	Calls   []*Call       `json:"calls,omitempty"`
+}
+
+// CallTracer traces the tree of the calls made by the transaction,
+// without capturing the executed opcodes
+type CallTracer struct {
+	cancelLock sync.RWMutex
+	reason     error
+	interrupt  bool
+
+	gasLimit    uint64
+	consumedGas uint64
+
+	root  *Call
+	stack []*Call
+}
+
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+func (t *CallTracer) Cancel(err error) {
+	t.cancelLock.Lock()
+	defer t.cancelLock.Unlock()
+
+	t.reason = err
+	t.interrupt = true
+}
+
+func (t *CallTracer) cancelled() bool {
+	t.cancelLock.RLock()
+	defer t.cancelLock.RUnlock()
+
+	return t.interrupt
+}
+
+func (t *CallTracer) Clear() {
+	t.reason = nil
+	t.interrupt = false
+	t.gasLimit = 0
+	t.consumedGas = 0
+	t.root = nil
+	t.stack = nil
+}
+
+func (t *CallTracer) TxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+func (t *CallTracer) TxEnd(gasLeft uint64) {
+	t.consumedGas = t.gasLimit - gasLeft
+}
+
+func (t *CallTracer) CallStart(
+	depth int,
+	from, to types.Address,
+	callType int,
+	gas uint64,
+	value *big.Int,
+	input []byte,
+) {
+	call := &Call{
+		Type:  callTypeName(callType),
+		From:  from,
+		To:    to,
+		Gas:   hex.EncodeUint64(gas),
+		Input: hex.EncodeToHex(input),
+	}
+
+	if value != nil {
+		call.Value = hex.EncodeBig(value)
+	}
+
+	if len(t.stack) == 0 {
+		t.root = call
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Calls = append(parent.Calls, call)
+	}
+
+	t.stack = append(t.stack, call)
+}
+
+func (t *CallTracer) CallEnd(
+	depth int,
+	output []byte,
+	err error,
+) {
+	if len(t.stack) == 0 {
+		return
+	}
+
+	call := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+
+	if len(output) > 0 {
+		call.Output = hex.EncodeToHex(output)
+	}
+
+	if err != nil {
+		call.Error = err.Error()
+	}
+}
+
+func (t *CallTracer) CaptureState(
+	memory []byte,
+	stack []*big.Int,
+	opCode int,
+	contractAddress types.Address,
+	sp int,
+	host tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if t.cancelled() {
+		state.Halt()
+	}
+}
+
+func (t *CallTracer) ExecuteState(
+	contractAddress types.Address,
+	ip uint64,
+	opCode string,
+	availableGas uint64,
+	cost uint64,
+	lastReturnData []byte,
+	depth int,
+	err error,
+	host tracer.RuntimeHost,
+) {
+}
+
+// GetResult returns the top level call of the transaction, nil if the transaction made no call
+func (t *CallTracer) GetResult() (interface{}, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+
+	if t.root == nil {
+		return nil, nil
+	}
+
+	t.root.GasUsed = hex.EncodeUint64(t.consumedGas)
+
+	return t.root, nil
+}
+
+// callTypeName returns the name of the call type passed to the tracer
+func callTypeName(callType int) string {
+	switch callType {
+	case int(runtime.Call):
+		return "CALL"
+	case int(runtime.CallCode):
+		return "CALLCODE"
+	case int(runtime.DelegateCall):
+		return "DELEGATECALL"
+	case int(runtime.StaticCall):
+		return "STATICCALL"
+	case int(runtime.Create2), evm.CREATE2:
+		return "CREATE2"
+	default:
+		// the contract creations are reported with the CREATE opcode
+		return "CREATE"
+	}
+}
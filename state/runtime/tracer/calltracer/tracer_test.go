@@ -0,0 +1,109 @@
+package calltracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	testFrom  = types.StringToAddress("1")
+	testTo    = types.StringToAddress("2")
+	testInner = types.StringToAddress("3")
+)
+
+type mockState struct {
+	halted bool
+}
+
+func (m *mockState) Halt() {
+	m.halted = true
+}
+
+func TestCallTracer_GetResult(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewCallTracer()
+
+	tracer.TxStart(100000)
+	tracer.CallStart(1, testFrom, testTo, int(runtime.Call), 90000, big.NewInt(10), []byte{0x1})
+	tracer.CallStart(2, testTo, testInner, int(runtime.StaticCall), 5000, nil, []byte{0x2})
+	tracer.CallEnd(2, []byte{0x3}, nil)
+	tracer.CallStart(2, testTo, testInner, int(evm.CREATE), 4000, big.NewInt(0), nil)
+	tracer.CallEnd(2, nil, runtime.ErrOutOfGas)
+	tracer.CallEnd(1, []byte{0x4}, nil)
+	tracer.TxEnd(40000)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	assert.Equal(t, &Call{
+		Type:    "CALL",
+		From:    testFrom,
+		To:      testTo,
+		Value:   "0xa",
+		Gas:     "0x15f90",
+		GasUsed: "0xea60",
+		Input:   "0x01",
+		Output:  "0x04",
+		Calls: []*Call{
+			{
+				Type:   "STATICCALL",
+				From:   testTo,
+				To:     testInner,
+				Gas:    "0x1388",
+				Input:  "0x02",
+				Output: "0x03",
+			},
+			{
+				Type:  "CREATE",
+				From:  testTo,
+				To:    testInner,
+				Value: "0x0",
+				Gas:   "0xfa0",
+				Input: "0x",
+				Error: runtime.ErrOutOfGas.Error(),
+			},
+		},
+	}, res)
+}
+
+func TestCallTracer_Clear(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewCallTracer()
+
+	tracer.CallStart(1, testFrom, testTo, int(runtime.Call), 21000, nil, nil)
+	tracer.CallEnd(1, nil, nil)
+	tracer.Clear()
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestCallTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tracer = NewCallTracer()
+		state  = &mockState{}
+		reason = errors.New("timeout")
+	)
+
+	tracer.CallStart(1, testFrom, testTo, int(runtime.Call), 21000, nil, nil)
+	tracer.Cancel(reason)
+	tracer.CaptureState(nil, nil, evm.ADD, testTo, 0, nil, state)
+
+	assert.True(t, state.halted)
+
+	_, err := tracer.GetResult()
+	assert.ErrorIs(t, err, reason)
+}
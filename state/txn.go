@@ -17,7 +17,7 @@ import (
 var emptyStateHash = types.StringToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
 
 type readSnapshot interface {
-	GetStorage(addr types.Address, root types.Hash, key types.Hash) types.Hash
+	GetStorage(addr types.Address, root types.Hash, key types.Hash) (types.Hash, error)
 	GetAccount(addr types.Address) (*Account, error)
 	GetCode(hash types.Hash) ([]byte, bool)
 }
@@ -332,7 +332,7 @@ func (txn *Txn) GetState(addr types.Address, key types.Hash) types.Hash {
 		return types.Hash{}
 	}
 
-	return txn.snapshot.GetStorage(addr, object.Account.Root, key)
+	return txn.getStorage(addr, object.Account.Root, key)
 }
 
 // Nonce
@@ -484,7 +484,18 @@ func (txn *Txn) GetCommittedState(addr types.Address, key types.Hash) types.Hash
 		return types.Hash{}
 	}
 
-	return txn.snapshot.GetStorage(addr, obj.Account.Root, key)
+	return txn.getStorage(addr, obj.Account.Root, key)
+}
+
+// getStorage returns the value of the storage slot in the trie.
+// The slot which can't be read is treated as empty, like the account which can't be read
+func (txn *Txn) getStorage(addr types.Address, root types.Hash, key types.Hash) types.Hash {
+	value, err := txn.snapshot.GetStorage(addr, root, key)
+	if err != nil {
+		return types.Hash{}
+	}
+
+	return value
 }
 
 // SetFullStorage is used to replace the full state of the address.
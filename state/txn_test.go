@@ -15,18 +15,18 @@ type mockSnapshot struct {
 	state map[types.Address]*PreState
 }
 
-func (m *mockSnapshot) GetStorage(addr types.Address, root types.Hash, key types.Hash) types.Hash {
+func (m *mockSnapshot) GetStorage(addr types.Address, root types.Hash, key types.Hash) (types.Hash, error) {
 	raw, ok := m.state[addr]
 	if !ok {
-		return types.Hash{}
+		return types.Hash{}, nil
 	}
 
 	res, ok := raw.State[key]
 	if !ok {
-		return types.Hash{}
+		return types.Hash{}, nil
 	}
 
-	return res
+	return res, nil
 }
 
 func (m *mockSnapshot) GetAccount(addr types.Address) (*Account, error) {
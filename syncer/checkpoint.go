@@ -0,0 +1,239 @@
+package syncer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// checkpointRequestTimeout is the time a peer has to answer a single request for the checkpoint headers
+	checkpointRequestTimeout = 30 * time.Second
+
+	// checkpointWaitInterval is the interval the peers are checked at, while none of them served the checkpoint headers
+	checkpointWaitInterval = time.Second
+)
+
+var (
+	errCheckpointRejected   = errors.New("peer chain does not contain the checkpoint")
+	errCheckpointNotReached = errors.New("peer has not reached the checkpoint")
+	errNoCheckpointPeers    = errors.New("no peer served the checkpoint headers")
+)
+
+// checkpointPeers keeps the results of verifying the peers' chains against the trusted checkpoint
+type checkpointPeers struct {
+	lock     sync.RWMutex
+	verified map[peer.ID]bool // false for the rejected peers
+}
+
+func (c *checkpointPeers) get(peerID peer.ID) (bool, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	verified, ok := c.verified[peerID]
+
+	return verified, ok
+}
+
+func (c *checkpointPeers) set(peerID peer.ID, verified bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.verified == nil {
+		c.verified = make(map[peer.ID]bool)
+	}
+
+	c.verified[peerID] = verified
+}
+
+// isRejected returns true if the peer's chain is known not to contain the checkpoint
+func (c *checkpointPeers) isRejected(peerID peer.ID) bool {
+	verified, ok := c.get(peerID)
+
+	return ok && !verified
+}
+
+// verifyCheckpoint makes sure the peer's chain contains the trusted checkpoint, while the local chain
+// has not reached it yet, by fetching the peer's header at the checkpoint height.
+// The peers having another block there are rejected for good
+func (s *syncer) verifyCheckpoint(p *NoForkPeer) error {
+	checkpoint := s.blockchain.Checkpoint()
+	if checkpoint == nil || s.blockchain.Header().Number >= checkpoint.Number {
+		// once the checkpoint is written, the local chain can only be extended
+		return nil
+	}
+
+	if verified, ok := s.checkpointPeers.get(p.ID); ok {
+		if !verified {
+			return errCheckpointRejected
+		}
+
+		return nil
+	}
+
+	if p.Number < checkpoint.Number {
+		return fmt.Errorf("%w: peer is at %d, checkpoint at %d", errCheckpointNotReached, p.Number, checkpoint.Number)
+	}
+
+	headers, err := s.syncPeerClient.GetHeaders(p.ID, checkpoint.Number, 0, 1, s.blockTimeout)
+	if err != nil {
+		return err
+	}
+
+	if len(headers) == 0 || headers[0].Number != checkpoint.Number {
+		return fmt.Errorf("%w at %d", errNoHeaders, checkpoint.Number)
+	}
+
+	if headers[0].Hash != checkpoint.Hash {
+		s.checkpointPeers.set(p.ID, false)
+
+		metrics.IncrCounter([]string{syncerMetrics, "checkpoint_rejected_peers"}, 1)
+
+		return fmt.Errorf("%w: peer has block %s at %d",
+			blockchain.ErrCheckpointMismatch, headers[0].Hash, checkpoint.Number)
+	}
+
+	s.checkpointPeers.set(p.ID, true)
+
+	s.logger.Debug("peer chain contains the checkpoint", "peer", p.ID, "checkpoint", checkpoint)
+
+	return nil
+}
+
+// FetchCheckpointHeaders fetches the headers from the given number up to the trusted checkpoint,
+// which the empty chain is started from. The connected peers are tried one after another
+// until the headers of one of them are verified against the checkpoint.
+// If all of them fail, it waits for the new ones to connect until the timeout expires
+func FetchCheckpointHeaders(
+	logger hclog.Logger,
+	network Network,
+	blockchain CheckpointBlockchain,
+	from uint64,
+	timeout time.Duration,
+) ([]*types.Header, error) {
+	// the syncer protocol is registered for the client only, until the syncer serves it
+	stream := registerClientProtocol(network)
+	defer stream.Close()
+
+	fetcher := &checkpointFetcher{
+		logger:     logger.Named("checkpoint"),
+		blockchain: blockchain,
+		client:     NewSyncPeerClient(logger, network, blockchain),
+		peers: func() []peer.ID {
+			peers := network.Peers()
+			ids := make([]peer.ID, len(peers))
+
+			for i, p := range peers {
+				ids[i] = p.Info.ID
+			}
+
+			return ids
+		},
+		waitInterval: checkpointWaitInterval,
+	}
+
+	return fetcher.fetch(from, timeout)
+}
+
+// checkpointFetcher fetches the headers up to the trusted checkpoint from the peers
+type checkpointFetcher struct {
+	logger     hclog.Logger
+	blockchain CheckpointBlockchain
+	client     SyncPeerClient
+
+	// peers returns the connected peers
+	peers func() []peer.ID
+
+	waitInterval time.Duration
+}
+
+func (f *checkpointFetcher) fetch(from uint64, timeout time.Duration) ([]*types.Header, error) {
+	var (
+		deadline = time.After(timeout)
+		tried    = make(map[peer.ID]bool)
+		lastErr  = errNoCheckpointPeers
+	)
+
+	for {
+		for _, peerID := range f.peers() {
+			if tried[peerID] {
+				continue
+			}
+
+			tried[peerID] = true
+
+			headers, err := f.fetchFromPeer(peerID, from)
+			if err == nil {
+				return headers, nil
+			}
+
+			f.logger.Warn("failed to fetch the checkpoint headers from peer, try to next one", "peer ID", peerID, "error", err)
+
+			lastErr = err
+		}
+
+		select {
+		case <-deadline:
+			return nil, lastErr
+		case <-time.After(f.waitInterval):
+		}
+	}
+}
+
+// fetchFromPeer fetches the headers from the given number up to the checkpoint from the peer, in batches
+func (f *checkpointFetcher) fetchFromPeer(peerID peer.ID, from uint64) ([]*types.Header, error) {
+	checkpoint := f.blockchain.Checkpoint()
+	if checkpoint == nil || from > checkpoint.Number {
+		return nil, blockchain.ErrNoCheckpoint
+	}
+
+	headers := make([]*types.Header, 0, checkpoint.Number-from+1)
+
+	for next := from; next <= checkpoint.Number; {
+		amount := checkpoint.Number - next + 1
+		if amount > maxHeadersAmount {
+			amount = maxHeadersAmount
+		}
+
+		batch, err := f.client.GetHeaders(peerID, next, 0, amount, checkpointRequestTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(batch) == 0 || uint64(len(batch)) > amount {
+			return nil, fmt.Errorf("%w from %d", errNoHeaders, next)
+		}
+
+		for _, header := range batch {
+			if header.Number != next {
+				return nil, fmt.Errorf("%w: expected header %d, got %d", errUnexpectedHeader, next, header.Number)
+			}
+
+			headers = append(headers, header)
+			next++
+		}
+	}
+
+	if err := f.blockchain.VerifyCheckpointHeaders(headers); err != nil {
+		return nil, err
+	}
+
+	return headers, nil
+}
+
+// registerClientProtocol registers the syncer protocol serving no requests, so the node can request the peers
+func registerClientProtocol(network Network) *grpc.GrpcStream {
+	stream := grpc.NewGrpcStream()
+	stream.Serve()
+	network.RegisterProtocol(syncerProto, stream)
+
+	return stream
+}
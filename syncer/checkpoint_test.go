@@ -0,0 +1,295 @@
+package syncer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_verifyCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks      = createChainedBlocks(10, 0)
+		forkBlocks  = createChainedBlocks(10, 1)
+		checkpoint  = &blockchain.Checkpoint{Number: 5, Hash: blocks[4].Hash()}
+		syncedPeer  = &NoForkPeer{ID: peer.ID("A"), Number: 10}
+		behindPeer  = &NoForkPeer{ID: peer.ID("A"), Number: 4}
+		peerHeaders = func(blocks []*types.Block) func(peer.ID, uint64, uint64, uint64) ([]*types.Header, error) {
+			return func(_ peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+				return headersFrom(blocks, from, skip, amount), nil
+			}
+		}
+	)
+
+	tests := []struct {
+		name        string
+		checkpoint  *blockchain.Checkpoint
+		localLatest uint64
+		peer        *NoForkPeer
+		getHeaders  func(peer.ID, uint64, uint64, uint64) ([]*types.Header, error)
+
+		err      error
+		requests int
+		verified bool
+		cached   bool
+	}{
+		{
+			name:        "no checkpoint",
+			localLatest: 0,
+			peer:        syncedPeer,
+			getHeaders:  peerHeaders(forkBlocks),
+		},
+		{
+			name:        "local chain reached the checkpoint",
+			checkpoint:  checkpoint,
+			localLatest: 5,
+			peer:        syncedPeer,
+			getHeaders:  peerHeaders(forkBlocks),
+		},
+		{
+			name:        "peer has the checkpoint",
+			checkpoint:  checkpoint,
+			localLatest: 0,
+			peer:        syncedPeer,
+			getHeaders:  peerHeaders(blocks),
+			requests:    1,
+			verified:    true,
+			cached:      true,
+		},
+		{
+			name:        "peer has another block at the checkpoint height",
+			checkpoint:  checkpoint,
+			localLatest: 0,
+			peer:        syncedPeer,
+			getHeaders:  peerHeaders(forkBlocks),
+			err:         blockchain.ErrCheckpointMismatch,
+			requests:    1,
+			cached:      true,
+		},
+		{
+			name:        "peer has not reached the checkpoint",
+			checkpoint:  checkpoint,
+			localLatest: 0,
+			peer:        behindPeer,
+			getHeaders:  peerHeaders(blocks),
+			err:         errCheckpointNotReached,
+		},
+		{
+			name:        "peer returns no header",
+			checkpoint:  checkpoint,
+			localLatest: 0,
+			peer:        syncedPeer,
+			getHeaders: func(peer.ID, uint64, uint64, uint64) ([]*types.Header, error) {
+				return nil, nil
+			},
+			err:      errNoHeaders,
+			requests: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			requests := 0
+
+			syncer := NewTestSyncer(
+				nil,
+				&mockBlockchain{
+					headerHandler: newSimpleHeaderHandler(test.localLatest),
+					checkpoint:    test.checkpoint,
+				},
+				time.Second,
+				&mockSyncPeerClient{
+					getHeadersHandler: func(id peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+						requests++
+
+						assert.Equal(t, checkpoint.Number, from)
+						assert.Equal(t, uint64(1), amount)
+
+						return test.getHeaders(id, from, skip, amount)
+					},
+				},
+				&mockProgression{},
+			)
+
+			assert.ErrorIs(t, syncer.verifyCheckpoint(test.peer), test.err)
+			assert.Equal(t, test.requests, requests)
+
+			verified, cached := syncer.checkpointPeers.get(test.peer.ID)
+			assert.Equal(t, test.cached, cached)
+			assert.Equal(t, test.verified, verified)
+			assert.Equal(t, test.cached && !test.verified, syncer.checkpointPeers.isRejected(test.peer.ID))
+
+			if !test.cached {
+				return
+			}
+
+			// the result is not requested again
+			err := syncer.verifyCheckpoint(test.peer)
+			if test.verified {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, errCheckpointRejected)
+			}
+
+			assert.Equal(t, test.requests, requests)
+		})
+	}
+}
+
+func TestSync_Checkpoint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks     = createChainedBlocks(10, 0)
+		forkBlocks = createChainedBlocks(10, 1)
+
+		syncedBlocks = make([]*types.Block, 0, len(blocks))
+		blocksPeers  = make(map[peer.ID]int)
+
+		peerBlocks = map[peer.ID][]*types.Block{
+			peer.ID("A"): forkBlocks,
+			peer.ID("B"): blocks,
+		}
+	)
+
+	syncer := NewTestSyncer(
+		nil,
+		&mockBlockchain{
+			headerHandler: newSimpleHeaderHandler(0),
+			checkpoint:    &blockchain.Checkpoint{Number: 5, Hash: blocks[4].Hash()},
+			verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
+				return &types.FullBlock{Block: b}, nil
+			},
+			writeFullBlockHandler: func(b *types.FullBlock) error {
+				syncedBlocks = append(syncedBlocks, b.Block)
+
+				return nil
+			},
+		},
+		time.Second,
+		&mockSyncPeerClient{
+			getBlocksHandler: func(id peer.ID, from, _ uint64, _ time.Duration) (<-chan *types.Block, error) {
+				blocksPeers[id]++
+
+				return blocksToCh(peerBlocks[id][from-1:], 0), nil
+			},
+			getHeadersHandler: func(id peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+				return headersFrom(peerBlocks[id], from, skip, amount), nil
+			},
+		},
+		&mockProgression{},
+	)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- syncer.Sync(func(b *types.FullBlock) bool {
+			return b.Block.Number() >= 10
+		})
+	}()
+
+	go func() {
+		// the peer on the fork is the best one
+		for _, p := range []*NoForkPeer{
+			{ID: peer.ID("A"), Number: 10, Distance: big.NewInt(0)},
+			{ID: peer.ID("B"), Number: 10, Distance: big.NewInt(1)},
+		} {
+			syncer.peerMap.Put(p)
+
+			syncer.newStatusCh <- struct{}{}
+		}
+	}()
+
+	require.NoError(t, <-errCh)
+
+	assert.Equal(t, blocks, syncedBlocks)
+	assert.Equal(t, map[peer.ID]int{peer.ID("B"): 1}, blocksPeers)
+	assert.True(t, syncer.checkpointPeers.isRejected(peer.ID("A")))
+}
+
+// mockCheckpointBlockchain verifies the checkpoint headers like the blockchain, without the genesis block
+type mockCheckpointBlockchain struct {
+	*mockBlockchain
+}
+
+func (m *mockCheckpointBlockchain) VerifyCheckpointHeaders(headers []*types.Header) error {
+	last := headers[len(headers)-1]
+	if last.Hash != m.checkpoint.Hash {
+		return blockchain.ErrCheckpointMismatch
+	}
+
+	for i := 1; i < len(headers); i++ {
+		if headers[i].ParentHash != headers[i-1].Hash {
+			return blockchain.ErrInvalidCheckpointHeaders
+		}
+	}
+
+	return nil
+}
+
+func TestCheckpointFetcher_Fetch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blocks     = createChainedBlocks(maxHeadersAmount+20, 0)
+		forkBlocks = createChainedBlocks(maxHeadersAmount+20, 1)
+		checkpoint = &blockchain.Checkpoint{Number: maxHeadersAmount + 10, Hash: blocks[maxHeadersAmount+9].Hash()}
+
+		// the peers are tried in order: the behind one, the one on the fork, and the one having the checkpoint
+		peerBlocks = map[peer.ID][]*types.Block{
+			peer.ID("A"): blocks[:maxHeadersAmount],
+			peer.ID("B"): forkBlocks,
+			peer.ID("C"): blocks,
+		}
+		requests = make(map[peer.ID]int)
+	)
+
+	newFetcher := func(peers ...peer.ID) *checkpointFetcher {
+		return &checkpointFetcher{
+			logger: hclog.NewNullLogger(),
+			blockchain: &mockCheckpointBlockchain{
+				mockBlockchain: &mockBlockchain{checkpoint: checkpoint},
+			},
+			client: &mockSyncPeerClient{
+				getHeadersHandler: func(id peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+					requests[id]++
+
+					return headersFrom(peerBlocks[id], from, skip, amount), nil
+				},
+			},
+			peers: func() []peer.ID {
+				return peers
+			},
+			waitInterval: time.Millisecond,
+		}
+	}
+
+	headers, err := newFetcher("A", "B", "C").fetch(3, time.Second)
+	require.NoError(t, err)
+
+	require.Len(t, headers, maxHeadersAmount+8)
+	assert.Equal(t, uint64(3), headers[0].Number)
+	assert.Equal(t, checkpoint.Hash, headers[len(headers)-1].Hash)
+
+	// the headers are fetched in batches
+	assert.Equal(t, map[peer.ID]int{"A": 2, "B": 2, "C": 2}, requests)
+
+	// none of the peers has the checkpoint
+	_, err = newFetcher("A", "B").fetch(3, 10*time.Millisecond)
+	assert.ErrorIs(t, err, blockchain.ErrCheckpointMismatch)
+
+	_, err = newFetcher().fetch(3, 10*time.Millisecond)
+	assert.ErrorIs(t, err, errNoCheckpointPeers)
+}
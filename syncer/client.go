@@ -190,7 +190,11 @@ func (m *syncPeerClient) GetPeerConnectionUpdateEventCh() <-chan *event.PeerEven
 
 // startGossip creates new topic and starts subscribing
 func (m *syncPeerClient) startGossip() error {
-	topic, err := m.network.NewTopic(statusTopicName, &proto.SyncPeerStatus{})
+	topic, err := m.network.NewTopic(
+		statusTopicName,
+		&proto.SyncPeerStatus{},
+		network.WithTopicValidator(validateStatusUpdate),
+	)
 	if err != nil {
 		return err
 	}
@@ -204,6 +208,21 @@ func (m *syncPeerClient) startGossip() error {
 	return nil
 }
 
+// validateStatusUpdate rejects the malformed status gossip before it is propagated,
+// and drops the genesis statuses which carry no information for the syncing peers
+func validateStatusUpdate(obj interface{}, _ peer.ID) network.ValidationResult {
+	status, ok := obj.(*proto.SyncPeerStatus)
+	if !ok {
+		return network.ValidationReject
+	}
+
+	if status.Number == 0 {
+		return network.ValidationIgnore
+	}
+
+	return network.ValidationAccept
+}
+
 // handleStatusUpdate is a handler of gossip
 func (m *syncPeerClient) handleStatusUpdate(obj interface{}, from peer.ID) {
 	status, ok := obj.(*proto.SyncPeerStatus)
@@ -292,10 +311,12 @@ func (m *syncPeerClient) CloseStream(peerID peer.ID) error {
 	return m.network.CloseProtocolStream(syncerProto, peerID)
 }
 
-// GetBlocks returns a stream of blocks from given height to peer's latest
+// GetBlocks returns a stream of blocks from given height to the given one,
+// or to peer's latest if it is zero
 func (m *syncPeerClient) GetBlocks(
 	peerID peer.ID,
 	from uint64,
+	to uint64,
 	timeoutPerBlock time.Duration,
 ) (<-chan *types.Block, error) {
 	clt, err := m.newSyncPeerClient(peerID)
@@ -307,6 +328,7 @@ func (m *syncPeerClient) GetBlocks(
 
 	stream, err := clt.GetBlocks(ctx, &proto.GetBlocksRequest{
 		From: from,
+		To:   to,
 	})
 	if err != nil {
 		cancel()
@@ -347,6 +369,50 @@ func (m *syncPeerClient) GetBlocks(
 	return blockCh, nil
 }
 
+// GetHeaders fetches the headers from given height with the given number of blocks skipped between them
+func (m *syncPeerClient) GetHeaders(
+	peerID peer.ID,
+	from uint64,
+	skip uint64,
+	amount uint64,
+	timeout time.Duration,
+) ([]*types.Header, error) {
+	// the connection is not saved, so it does not replace the one of the running block stream
+	conn, err := m.network.NewProtoConnection(syncerProto, peerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resp, err := proto.NewSyncPeerClient(conn).GetHeaders(timeoutCtx, &proto.GetHeadersRequest{
+		From:   from,
+		Skip:   skip,
+		Amount: amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make([]*types.Header, len(resp.Headers))
+
+	for i, raw := range resp.Headers {
+		header := &types.Header{}
+		if err := header.UnmarshalRLP(raw); err != nil {
+			metrics.IncrCounter([]string{syncerMetrics, "bad_message"}, 1)
+
+			return nil, err
+		}
+
+		headers[i] = header
+	}
+
+	return headers, nil
+}
+
 // newSyncPeerClient creates gRPC client
 func (m *syncPeerClient) newSyncPeerClient(peerID peer.ID) (proto.SyncPeerClient, error) {
 	conn, err := m.network.NewProtoConnection(syncerProto, peerID)
@@ -509,7 +509,7 @@ func Test_syncPeerClient_GetBlocks(t *testing.T) {
 
 	assert.NoError(t, err)
 
-	blockStream, err := client.GetBlocks(peerSrv.AddrInfo().ID, syncFrom, 5*time.Second)
+	blockStream, err := client.GetBlocks(peerSrv.AddrInfo().ID, syncFrom, 0, 5*time.Second)
 	assert.NoError(t, err)
 
 	blocks := make([]*types.Block, 0, peerLatest)
@@ -526,6 +526,46 @@ func Test_syncPeerClient_GetBlocks(t *testing.T) {
 	assert.Equal(t, expected, blocks)
 }
 
+func Test_syncPeerClient_GetHeaders(t *testing.T) {
+	t.Parallel()
+
+	clientSrv := newTestNetwork(t)
+	client := newTestSyncPeerClient(clientSrv, nil)
+
+	blocks := createMockBlocks(10)
+
+	_, peerSrv := createTestSyncerService(t, &mockBlockchain{
+		headerHandler: newSimpleHeaderHandler(10),
+		getBlockByNumberHandler: func(u uint64, b bool) (*types.Block, bool) {
+			if u == 0 || u > 10 {
+				return nil, false
+			}
+
+			return blocks[u-1], true
+		},
+	})
+
+	err := network.JoinAndWait(
+		clientSrv,
+		peerSrv,
+		network.DefaultBufferTimeout,
+		network.DefaultJoinTimeout,
+	)
+
+	assert.NoError(t, err)
+
+	headers, err := client.GetHeaders(peerSrv.AddrInfo().ID, 3, 2, 5, 5*time.Second)
+	assert.NoError(t, err)
+
+	assert.Len(t, headers, 3)
+
+	// hash is calculated on unmarshaling
+	for i, number := range []uint64{3, 6, 9} {
+		assert.Equal(t, number, headers[i].Number)
+		assert.Equal(t, blocks[number-1].Header.Copy().ComputeHash().Hash, headers[i].Hash)
+	}
+}
+
 func Test_EmitMultipleBlocks(t *testing.T) {
 	t.Parallel()
 
@@ -626,3 +666,11 @@ func Test_EmitMultipleBlocks(t *testing.T) {
 		testGossip(t, 4)
 	})
 }
+
+func Test_validateStatusUpdate(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, network.ValidationAccept, validateStatusUpdate(&proto.SyncPeerStatus{Number: 10}, ""))
+	assert.Equal(t, network.ValidationIgnore, validateStatusUpdate(&proto.SyncPeerStatus{}, ""))
+	assert.Equal(t, network.ValidationReject, validateStatusUpdate(&proto.Block{}, ""))
+}
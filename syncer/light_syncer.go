@@ -0,0 +1,186 @@
+package syncer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const lightSyncerName = "light-syncer"
+
+var (
+	errNoHeaders        = errors.New("peer returned no headers")
+	errUnexpectedHeader = errors.New("unexpected header")
+)
+
+// lightSyncer syncs the headers only, verifying the committed seals of each of them.
+// It shares the peer tracking with the block syncer, but does not serve the blocks
+// to the other peers, nor publishes its status to them
+type lightSyncer struct {
+	*syncer
+
+	lightChain LightBlockchain
+	network    Network
+}
+
+func NewLightSyncer(
+	logger hclog.Logger,
+	network Network,
+	blockchain LightBlockchain,
+	blockTimeout time.Duration,
+) LightSyncer {
+	return &lightSyncer{
+		syncer: &syncer{
+			logger:          logger.Named(lightSyncerName),
+			blockchain:      blockchain,
+			syncProgression: progress.NewProgressionWrapper(progress.ChainSyncBulk),
+			syncPeerClient:  NewSyncPeerClient(logger, network, blockchain),
+			blockTimeout:    blockTimeout,
+			newStatusCh:     make(chan struct{}),
+			peerMap:         new(PeerMap),
+			peerThroughput:  NewPeerThroughput(),
+		},
+		lightChain: blockchain,
+		network:    network,
+	}
+}
+
+// Start starts goroutine processes
+func (s *lightSyncer) Start() error {
+	// the peers can not sync from the light node
+	s.syncPeerClient.DisablePublishingPeerStatus()
+
+	// the syncer protocol is registered for the client only, serving no requests
+	registerClientProtocol(s.network)
+
+	if err := s.syncPeerClient.Start(); err != nil {
+		return err
+	}
+
+	s.initializePeerMap()
+
+	go s.startPeerStatusUpdateProcess()
+	go s.startPeerConnectionEventProcess()
+
+	return nil
+}
+
+// Close terminates goroutine processes
+func (s *lightSyncer) Close() error {
+	close(s.newStatusCh)
+
+	s.syncPeerClient.Close()
+
+	return nil
+}
+
+// Sync syncs the headers with the best peer until callback returns true, or the syncer is closed
+func (s *lightSyncer) Sync(callback func(*types.Header) bool) error {
+	skipList := make(map[peer.ID]bool)
+
+	for {
+		// Wait for a new event to arrive
+		if _, ok := <-s.newStatusCh; !ok {
+			return nil
+		}
+
+		localLatest := s.lightChain.Header().Number
+
+		// pick one best peer
+		bestPeer := s.peerMap.BestPeer(skipList)
+		if bestPeer == nil {
+			// Empty skipList map if there are no best peers
+			skipList = make(map[peer.ID]bool)
+
+			continue
+		}
+
+		// if the bestPeer does not have a new header continue
+		if bestPeer.Number <= localLatest {
+			continue
+		}
+
+		// do not sync from the peer until its chain is known to contain the checkpoint
+		if err := s.verifyCheckpoint(bestPeer); err != nil {
+			s.logger.Warn("failed to verify checkpoint with peer, try to next one", "peer ID", bestPeer.ID, "error", err)
+
+			skipList[bestPeer.ID] = true
+
+			continue
+		}
+
+		lastNumber, shouldTerminate, err := s.headerSyncWithPeer(bestPeer, callback)
+		if err != nil {
+			s.logger.Warn("failed to complete header sync with peer, try to next one", "peer ID", bestPeer.ID, "error", err)
+		}
+
+		if lastNumber < bestPeer.Number {
+			skipList[bestPeer.ID] = true
+
+			// continue to next peer
+			continue
+		}
+
+		if shouldTerminate {
+			return nil
+		}
+	}
+}
+
+// headerSyncWithPeer syncs the headers up to the given peer's latest one, in batches.
+// It returns the last synced header number, and whether the callback asked to terminate
+func (s *lightSyncer) headerSyncWithPeer(
+	p *NoForkPeer,
+	newHeaderCallback func(*types.Header) bool,
+) (uint64, bool, error) {
+	var lastNumber uint64
+
+	for {
+		from := s.lightChain.Header().Number + 1
+		if from > p.Number {
+			return lastNumber, false, nil
+		}
+
+		headers, err := s.syncPeerClient.GetHeaders(p.ID, from, 0, maxHeadersAmount, s.blockTimeout)
+		if err != nil {
+			return lastNumber, false, err
+		}
+
+		if len(headers) == 0 {
+			return lastNumber, false, fmt.Errorf("%w from %d", errNoHeaders, from)
+		}
+
+		for i, header := range headers {
+			if expected := from + uint64(i); header.Number != expected {
+				return lastNumber, false, fmt.Errorf("%w: expected header %d, got %d",
+					errUnexpectedHeader, expected, header.Number)
+			}
+
+			if err := s.lightChain.VerifyFinalizedHeader(header); err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+				return lastNumber, false, fmt.Errorf("unable to verify header, %w", err)
+			}
+
+			if err := s.lightChain.WriteHeader(header, lightSyncerName); err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+				return lastNumber, false, fmt.Errorf("failed to write header while light syncing: %w", err)
+			}
+
+			metrics.SetGauge([]string{syncerMetrics, "headers_num"}, 1)
+
+			lastNumber = header.Number
+
+			if newHeaderCallback(header) {
+				return lastNumber, true, nil
+			}
+		}
+	}
+}
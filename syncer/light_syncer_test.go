@@ -0,0 +1,235 @@
+package syncer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockLightBlockchain struct {
+	mockBlockchain
+
+	verifyFinalizedHeaderHandler func(*types.Header) error
+	writeHeaderHandler           func(*types.Header) error
+}
+
+func (m *mockLightBlockchain) VerifyFinalizedHeader(h *types.Header) error {
+	return m.verifyFinalizedHeaderHandler(h)
+}
+
+func (m *mockLightBlockchain) WriteHeader(h *types.Header, _ string) error {
+	return m.writeHeaderHandler(h)
+}
+
+func newTestLightSyncer(
+	blockchain *mockLightBlockchain,
+	mockSyncPeerClient *mockSyncPeerClient,
+) *lightSyncer {
+	return &lightSyncer{
+		syncer: &syncer{
+			logger:          hclog.NewNullLogger(),
+			blockchain:      blockchain,
+			syncProgression: &mockProgression{},
+			syncPeerClient:  mockSyncPeerClient,
+			blockTimeout:    time.Second,
+			newStatusCh:     make(chan struct{}),
+			peerMap:         new(PeerMap),
+			peerThroughput:  NewPeerThroughput(),
+		},
+		lightChain: blockchain,
+	}
+}
+
+// newHeaderChain returns the blockchain keeping the written headers,
+// which verifies the headers by the given function
+func newHeaderChain(verify func(*types.Header) error) (*mockLightBlockchain, *[]uint64) {
+	var (
+		latest  = &types.Header{}
+		written = []uint64{}
+	)
+
+	return &mockLightBlockchain{
+		mockBlockchain: mockBlockchain{
+			headerHandler: func() *types.Header {
+				return latest
+			},
+		},
+		verifyFinalizedHeaderHandler: verify,
+		writeHeaderHandler: func(h *types.Header) error {
+			latest = h
+			written = append(written, h.Number)
+
+			return nil
+		},
+	}, &written
+}
+
+func Test_headerSyncWithPeer(t *testing.T) {
+	t.Parallel()
+
+	const numHeaders = 300
+
+	var (
+		blocks   = createChainedBlocks(numHeaders, 0)
+		bestPeer = &NoForkPeer{ID: peer.ID("A"), Number: numHeaders, Distance: big.NewInt(1)}
+		verifyOK = func(*types.Header) error { return nil }
+		errSeals = errors.New("invalid committed seals")
+	)
+
+	getHeaders := func(_ peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+		return headersFrom(blocks, from, skip, amount), nil
+	}
+
+	tests := []struct {
+		name               string
+		getHeaders         func(peer.ID, uint64, uint64, uint64) ([]*types.Header, error)
+		verify             func(*types.Header) error
+		callback           func(*types.Header) bool
+		lastNumber         uint64
+		shouldTerminate    bool
+		err                error
+		numWrittenHeaders  int
+		numHeadersRequests int
+	}{
+		{
+			name:               "should sync all the headers in batches",
+			getHeaders:         getHeaders,
+			verify:             verifyOK,
+			lastNumber:         numHeaders,
+			numWrittenHeaders:  numHeaders,
+			numHeadersRequests: 2,
+		},
+		{
+			name:       "should stop at the header failing the verification",
+			getHeaders: getHeaders,
+			verify: func(h *types.Header) error {
+				if h.Number == 10 {
+					return errSeals
+				}
+
+				return nil
+			},
+			lastNumber:         9,
+			err:                errSeals,
+			numWrittenHeaders:  9,
+			numHeadersRequests: 1,
+		},
+		{
+			name:       "should stop when the callback returns true",
+			getHeaders: getHeaders,
+			verify:     verifyOK,
+			callback: func(h *types.Header) bool {
+				return h.Number == 20
+			},
+			lastNumber:         20,
+			shouldTerminate:    true,
+			numWrittenHeaders:  20,
+			numHeadersRequests: 1,
+		},
+		{
+			name: "should fail if the peer returns no headers",
+			getHeaders: func(peer.ID, uint64, uint64, uint64) ([]*types.Header, error) {
+				return nil, nil
+			},
+			verify:             verifyOK,
+			err:                errNoHeaders,
+			numHeadersRequests: 1,
+		},
+		{
+			name: "should fail if the peer returns the headers out of order",
+			getHeaders: func(_ peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+				return headersFrom(blocks, from+1, skip, amount), nil
+			},
+			verify:             verifyOK,
+			err:                errUnexpectedHeader,
+			numHeadersRequests: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			numHeadersRequests := 0
+
+			chain, written := newHeaderChain(test.verify)
+			syncer := newTestLightSyncer(chain, &mockSyncPeerClient{
+				getHeadersHandler: func(id peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+					numHeadersRequests++
+
+					assert.Equal(t, uint64(maxHeadersAmount), amount)
+					assert.Zero(t, skip)
+
+					return test.getHeaders(id, from, skip, amount)
+				},
+			})
+
+			callback := test.callback
+			if callback == nil {
+				callback = func(*types.Header) bool { return false }
+			}
+
+			lastNumber, shouldTerminate, err := syncer.headerSyncWithPeer(bestPeer, callback)
+
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.lastNumber, lastNumber)
+			assert.Equal(t, test.shouldTerminate, shouldTerminate)
+			assert.Len(t, *written, test.numWrittenHeaders)
+			assert.Equal(t, test.numHeadersRequests, numHeadersRequests)
+		})
+	}
+}
+
+func TestLightSync(t *testing.T) {
+	t.Parallel()
+
+	const numHeaders = 30
+
+	blocks := createChainedBlocks(numHeaders, 0)
+
+	t.Run("should sync the headers from the best peer", func(t *testing.T) {
+		t.Parallel()
+
+		chain, written := newHeaderChain(func(*types.Header) error { return nil })
+		syncer := newTestLightSyncer(chain, &mockSyncPeerClient{
+			getHeadersHandler: func(_ peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+				return headersFrom(blocks, from, skip, amount), nil
+			},
+		})
+
+		syncer.peerMap.Put(&NoForkPeer{ID: peer.ID("A"), Number: numHeaders, Distance: big.NewInt(1)})
+
+		assert.True(t, syncer.HasSyncPeer())
+
+		go func() {
+			syncer.newStatusCh <- struct{}{}
+		}()
+
+		err := syncer.Sync(func(h *types.Header) bool {
+			return h.Number == numHeaders
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, *written, numHeaders)
+		assert.False(t, syncer.HasSyncPeer())
+	})
+
+	t.Run("should return when the syncer is closed", func(t *testing.T) {
+		t.Parallel()
+
+		chain, _ := newHeaderChain(func(*types.Header) error { return nil })
+		syncer := newTestLightSyncer(chain, &mockSyncPeerClient{})
+
+		close(syncer.newStatusCh)
+
+		assert.NoError(t, syncer.Sync(func(*types.Header) bool { return false }))
+	})
+}
@@ -0,0 +1,326 @@
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// syncRangeSize is the number of blocks downloaded by a single range request
+	syncRangeSize = 64
+
+	// maxSkeletonHeaders is the maximum number of ranges downloaded in a single round
+	maxSkeletonHeaders = 32
+
+	// maxParallelSyncPeers is the maximum number of peers the ranges are downloaded from at once
+	maxParallelSyncPeers = 8
+
+	// minParallelSyncPeers is the minimum number of peers worth downloading the ranges in parallel
+	minParallelSyncPeers = 2
+)
+
+var (
+	errInvalidSkeleton  = errors.New("invalid skeleton headers")
+	errUnexpectedBlock  = errors.New("unexpected block in range")
+	errIncompleteRange  = errors.New("incomplete block range")
+	errSkeletonMismatch = errors.New("block range does not match skeleton")
+	errNoRangePeers     = errors.New("no peers left to download the block ranges from")
+)
+
+// blockRange is the result of a block range download
+type blockRange struct {
+	index  int
+	peerID peer.ID
+	blocks []*types.Block
+	err    error
+}
+
+// parallelSyncWithPeers syncs the blocks up to the best peer, downloading the ranges of blocks
+// from multiple peers in parallel while the node is far behind, and the rest from the best peer only.
+// The ranges are checked against the skeleton of the headers fetched from the best peer,
+// and written in order. It returns the last synced block number,
+// like bulkSyncWithPeer, falling back to it if the best peer can not provide the skeleton
+func (s *syncer) parallelSyncWithPeers(
+	bestPeer *NoForkPeer,
+	skipList map[peer.ID]bool,
+	newBlockCallback func(*types.FullBlock) bool,
+) (uint64, bool, error) {
+	var lastNumber uint64
+
+	for {
+		from := s.blockchain.Header().Number + 1
+		if bestPeer.Number < from+2*syncRangeSize-1 {
+			break
+		}
+
+		if len(s.peerMap.SyncPeers(from+2*syncRangeSize-1, skipList)) < minParallelSyncPeers {
+			break
+		}
+
+		skeleton, err := s.fetchSkeleton(bestPeer, from)
+		if err != nil {
+			s.logger.Debug("unable to fetch the skeleton, syncing from the best peer only",
+				"peer", bestPeer.ID, "err", err)
+
+			break
+		}
+
+		// the ranges are downloaded from the peers having all of them
+		peers := s.parallelSyncPeers(skeleton[len(skeleton)-1].Number, skipList)
+		if len(peers) < minParallelSyncPeers {
+			break
+		}
+
+		last, shouldTerminate, err := s.syncRanges(peers, from, skeleton, newBlockCallback)
+		if last > 0 {
+			lastNumber = last
+		}
+
+		if err != nil || shouldTerminate {
+			return lastNumber, shouldTerminate, err
+		}
+	}
+
+	last, shouldTerminate, err := s.bulkSyncWithPeer(bestPeer.ID, newBlockCallback)
+	if last > 0 {
+		lastNumber = last
+	}
+
+	return lastNumber, shouldTerminate, err
+}
+
+// parallelSyncPeers returns the peers having at least the given block,
+// the best scored ones by their throughput first. The peers rejected by the checkpoint are left out
+func (s *syncer) parallelSyncPeers(minNumber uint64, skipList map[peer.ID]bool) []*NoForkPeer {
+	peers := make([]*NoForkPeer, 0)
+
+	for _, p := range s.peerMap.SyncPeers(minNumber, skipList) {
+		if !s.checkpointPeers.isRejected(p.ID) {
+			peers = append(peers, p)
+		}
+	}
+
+	s.peerThroughput.Sort(peers)
+
+	if len(peers) > maxParallelSyncPeers {
+		peers = peers[:maxParallelSyncPeers]
+	}
+
+	return peers
+}
+
+// fetchSkeleton fetches the headers of the last blocks of the ranges from the given block
+func (s *syncer) fetchSkeleton(bestPeer *NoForkPeer, from uint64) ([]*types.Header, error) {
+	amount := (bestPeer.Number - from + 1) / syncRangeSize
+	if amount > maxSkeletonHeaders {
+		amount = maxSkeletonHeaders
+	}
+
+	skeleton, err := s.syncPeerClient.GetHeaders(
+		bestPeer.ID,
+		from+syncRangeSize-1,
+		syncRangeSize-1,
+		amount,
+		s.blockTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(skeleton) == 0 {
+		return nil, fmt.Errorf("%w: no headers", errInvalidSkeleton)
+	}
+
+	for i, header := range skeleton {
+		if expected := from + uint64(i+1)*syncRangeSize - 1; header.Number != expected {
+			return nil, fmt.Errorf("%w: expected header %d, got %d", errInvalidSkeleton, expected, header.Number)
+		}
+	}
+
+	return skeleton, nil
+}
+
+// syncRanges downloads the ranges ending at the skeleton headers from the peers in parallel,
+// and writes them in order. A peer failing a download is left out for the rest of the ranges,
+// and its range is downloaded from another peer
+func (s *syncer) syncRanges(
+	peers []*NoForkPeer,
+	from uint64,
+	skeleton []*types.Header,
+	newBlockCallback func(*types.FullBlock) bool,
+) (uint64, bool, error) {
+	var (
+		tasks   = make(chan int, len(skeleton))
+		results = make(chan *blockRange, len(skeleton)+len(peers))
+		doneCh  = make(chan struct{})
+	)
+
+	defer close(doneCh)
+
+	for i := range skeleton {
+		tasks <- i
+	}
+
+	for _, p := range peers {
+		go s.downloadRanges(p, from, skeleton, tasks, results, doneCh)
+	}
+
+	var (
+		pending     = make(map[int]*blockRange, len(skeleton))
+		activePeers = len(peers)
+		lastNumber  uint64
+	)
+
+	for next := 0; next < len(skeleton); {
+		result, ok := pending[next]
+		if !ok {
+			result = <-results
+			if result.err != nil {
+				s.logger.Debug("failed to download block range", "peer", result.peerID, "err", result.err)
+
+				if activePeers--; activePeers == 0 {
+					return lastNumber, false, errNoRangePeers
+				}
+			} else {
+				pending[result.index] = result
+			}
+
+			continue
+		}
+
+		delete(pending, next)
+
+		next++
+
+		for _, block := range result.blocks {
+			fullBlock, err := s.blockchain.VerifyFinalizedBlockWithContext(context.Background(), block)
+			if err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+				s.peerThroughput.Fail(result.peerID)
+
+				return lastNumber, false, fmt.Errorf("unable to verify block, %w", err)
+			}
+
+			if err := s.blockchain.WriteFullBlockWithContext(context.Background(), fullBlock, syncerName); err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+				return lastNumber, false, fmt.Errorf("failed to write block while parallel syncing: %w", err)
+			}
+
+			updateMetrics(fullBlock)
+
+			lastNumber = block.Number()
+
+			if newBlockCallback(fullBlock) {
+				return lastNumber, true, nil
+			}
+		}
+	}
+
+	return lastNumber, false, nil
+}
+
+// downloadRanges downloads the ranges taken from the tasks from the peer,
+// until the tasks run out, the sync is done, or a download fails,
+// in which case the range is put back for the other peers
+func (s *syncer) downloadRanges(
+	p *NoForkPeer,
+	from uint64,
+	skeleton []*types.Header,
+	tasks chan int,
+	results chan<- *blockRange,
+	doneCh <-chan struct{},
+) {
+	for {
+		var index int
+
+		select {
+		case <-doneCh:
+			return
+		case index = <-tasks:
+		}
+
+		start := from + uint64(index)*syncRangeSize
+
+		blocks, err := s.fetchRange(p.ID, start, skeleton[index])
+		if err != nil {
+			tasks <- index
+			results <- &blockRange{index: index, peerID: p.ID, err: err}
+
+			return
+		}
+
+		results <- &blockRange{index: index, peerID: p.ID, blocks: blocks}
+	}
+}
+
+// fetchRange downloads the blocks from the given one to the skeleton header,
+// checking they are linked and end at the skeleton header, and scores the peer by its throughput
+func (s *syncer) fetchRange(peerID peer.ID, start uint64, last *types.Header) ([]*types.Block, error) {
+	began := time.Now()
+
+	blocks, err := s.receiveRange(peerID, start, last)
+	if err != nil {
+		s.peerThroughput.Fail(peerID)
+
+		return nil, err
+	}
+
+	s.peerThroughput.Update(peerID, len(blocks), time.Since(began))
+
+	return blocks, nil
+}
+
+func (s *syncer) receiveRange(peerID peer.ID, start uint64, last *types.Header) ([]*types.Block, error) {
+	blockCh, err := s.syncPeerClient.GetBlocks(peerID, start, last.Number, s.blockTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := s.syncPeerClient.CloseStream(peerID); err != nil {
+			s.logger.Error("Failed to close stream: ", err)
+		}
+
+		// release the stream reader, in case the range was left early
+		go func() {
+			for range blockCh {
+			}
+		}()
+	}()
+
+	blocks := make([]*types.Block, 0, last.Number-start+1)
+
+	for block := range blockCh {
+		expected := start + uint64(len(blocks))
+		if block.Number() != expected {
+			return nil, fmt.Errorf("%w: expected block %d, got %d", errUnexpectedBlock, expected, block.Number())
+		}
+
+		if len(blocks) > 0 && block.ParentHash() != blocks[len(blocks)-1].Hash() {
+			return nil, fmt.Errorf("%w: block %d is not linked to its parent", errUnexpectedBlock, expected)
+		}
+
+		blocks = append(blocks, block)
+
+		if block.Number() == last.Number {
+			break
+		}
+	}
+
+	if uint64(len(blocks)) != last.Number-start+1 {
+		return nil, fmt.Errorf("%w: got %d blocks from %d", errIncompleteRange, len(blocks), start)
+	}
+
+	if blocks[len(blocks)-1].Hash() != last.Hash {
+		return nil, fmt.Errorf("%w: block %d", errSkeletonMismatch, last.Number)
+	}
+
+	return blocks, nil
+}
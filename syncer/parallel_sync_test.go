@@ -0,0 +1,340 @@
+package syncer
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createChainedBlocks creates the blocks from 1 to num, each linked to its parent
+func createChainedBlocks(num int, extra byte) []*types.Block {
+	blocks := make([]*types.Block, num)
+	parentHash := types.ZeroHash
+
+	for i := 0; i < num; i++ {
+		header := &types.Header{
+			Number:     uint64(i + 1),
+			ParentHash: parentHash,
+			ExtraData:  []byte{extra},
+		}
+		header.ComputeHash()
+
+		blocks[i] = &types.Block{Header: header}
+		parentHash = header.Hash
+	}
+
+	return blocks
+}
+
+// headersFrom returns the skeleton headers like the sync peer service
+func headersFrom(blocks []*types.Block, from, skip, amount uint64) []*types.Header {
+	headers := make([]*types.Header, 0, amount)
+
+	for i, number := uint64(0), from; i < amount && number <= uint64(len(blocks)); i, number = i+1, number+skip+1 {
+		headers = append(headers, blocks[number-1].Header)
+	}
+
+	return headers
+}
+
+// blocksRange returns the blocks from start to end like the sync peer service
+func blocksRange(blocks []*types.Block, start, end uint64) []*types.Block {
+	if end == 0 || end > uint64(len(blocks)) {
+		end = uint64(len(blocks))
+	}
+
+	return blocks[start-1 : end]
+}
+
+type parallelSyncTest struct {
+	syncer  *syncer
+	written []uint64
+
+	lock     sync.Mutex
+	requests map[peer.ID]int
+}
+
+func newParallelSyncTest(
+	t *testing.T,
+	peers []*NoForkPeer,
+	getHeaders func(peer.ID, uint64, uint64, uint64) ([]*types.Header, error),
+	getBlocks func(peer.ID, uint64, uint64) ([]*types.Block, error),
+) *parallelSyncTest {
+	t.Helper()
+
+	test := &parallelSyncTest{
+		requests: make(map[peer.ID]int),
+	}
+
+	latest := &types.Header{}
+
+	test.syncer = NewTestSyncer(
+		nil,
+		&mockBlockchain{
+			headerHandler: func() *types.Header {
+				return latest
+			},
+			verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
+				return &types.FullBlock{Block: b}, nil
+			},
+			writeFullBlockHandler: func(b *types.FullBlock) error {
+				// the blocks have to be written in order
+				if b.Block.Number() != latest.Number+1 {
+					return errors.New("block written out of order")
+				}
+
+				latest = b.Block.Header
+				test.written = append(test.written, b.Block.Number())
+
+				return nil
+			},
+		},
+		time.Second,
+		&mockSyncPeerClient{
+			getHeadersHandler: getHeaders,
+			getBlocksHandler: func(id peer.ID, start, end uint64, _ time.Duration) (<-chan *types.Block, error) {
+				test.lock.Lock()
+				test.requests[id]++
+				test.lock.Unlock()
+
+				blocks, err := getBlocks(id, start, end)
+				if err != nil {
+					return nil, err
+				}
+
+				return blocksToCh(blocks, 0), nil
+			},
+		},
+		&mockProgression{},
+	)
+
+	for _, p := range peers {
+		test.syncer.peerMap.Put(p)
+	}
+
+	return test
+}
+
+func (test *parallelSyncTest) requestsTo(id peer.ID) int {
+	test.lock.Lock()
+	defer test.lock.Unlock()
+
+	return test.requests[id]
+}
+
+func (test *parallelSyncTest) failures(id peer.ID) int {
+	test.syncer.peerThroughput.lock.RLock()
+	defer test.syncer.peerThroughput.lock.RUnlock()
+
+	if throughput, ok := test.syncer.peerThroughput.peers[id]; ok {
+		return throughput.failures
+	}
+
+	return 0
+}
+
+func expectedNumbers(num int) []uint64 {
+	numbers := make([]uint64, num)
+	for i := range numbers {
+		numbers[i] = uint64(i + 1)
+	}
+
+	return numbers
+}
+
+func Test_parallelSyncWithPeers(t *testing.T) {
+	t.Parallel()
+
+	const numBlocks = 300
+
+	var (
+		blocks = createChainedBlocks(numBlocks, 0)
+		forked = createChainedBlocks(numBlocks, 1)
+
+		peerA = &NoForkPeer{ID: peer.ID("A"), Number: numBlocks, Distance: big.NewInt(1)}
+		peerB = &NoForkPeer{ID: peer.ID("B"), Number: numBlocks, Distance: big.NewInt(2)}
+		peerC = &NoForkPeer{ID: peer.ID("C"), Number: numBlocks, Distance: big.NewInt(3)}
+	)
+
+	getHeaders := func(_ peer.ID, from, skip, amount uint64) ([]*types.Header, error) {
+		return headersFrom(blocks, from, skip, amount), nil
+	}
+
+	t.Run("should download the ranges from all the peers", func(t *testing.T) {
+		t.Parallel()
+
+		test := newParallelSyncTest(
+			t,
+			[]*NoForkPeer{peerA, peerB, peerC},
+			getHeaders,
+			func(_ peer.ID, start, end uint64) ([]*types.Block, error) {
+				return blocksRange(blocks, start, end), nil
+			},
+		)
+
+		lastNumber, shouldTerminate, err := test.syncer.parallelSyncWithPeers(
+			peerA,
+			map[peer.ID]bool{},
+			func(*types.FullBlock) bool { return false },
+		)
+
+		require.NoError(t, err)
+		assert.False(t, shouldTerminate)
+		assert.Equal(t, uint64(numBlocks), lastNumber)
+		assert.Equal(t, expectedNumbers(numBlocks), test.written)
+
+		for _, p := range []*NoForkPeer{peerA, peerB, peerC} {
+			assert.Zero(t, test.failures(p.ID))
+		}
+	})
+
+	t.Run("should download the ranges from the other peers if a peer is faulty", func(t *testing.T) {
+		t.Parallel()
+
+		test := newParallelSyncTest(
+			t,
+			[]*NoForkPeer{peerA, peerB, peerC},
+			getHeaders,
+			func(id peer.ID, start, end uint64) ([]*types.Block, error) {
+				switch id {
+				case peerB.ID:
+					// blocks of another chain, not matching the skeleton
+					return blocksRange(forked, start, end), nil
+				case peerC.ID:
+					// blocks missing in the middle of the range
+					bs := blocksRange(blocks, start, end)
+
+					return append(bs[:1:1], bs[2:]...), nil
+				default:
+					return blocksRange(blocks, start, end), nil
+				}
+			},
+		)
+
+		lastNumber, shouldTerminate, err := test.syncer.parallelSyncWithPeers(
+			peerA,
+			map[peer.ID]bool{},
+			func(*types.FullBlock) bool { return false },
+		)
+
+		require.NoError(t, err)
+		assert.False(t, shouldTerminate)
+		assert.Equal(t, uint64(numBlocks), lastNumber)
+		assert.Equal(t, expectedNumbers(numBlocks), test.written)
+
+		// the faulty peers are left out after their first failed range
+		for _, p := range []*NoForkPeer{peerB, peerC} {
+			assert.LessOrEqual(t, test.requestsTo(p.ID), 1)
+			assert.Equal(t, test.requestsTo(p.ID), test.failures(p.ID))
+			assert.Zero(t, test.syncer.peerThroughput.Rate(p.ID))
+		}
+
+		assert.Zero(t, test.failures(peerA.ID))
+		assert.Greater(t, test.syncer.peerThroughput.Rate(peerA.ID), 0.0)
+	})
+
+	t.Run("should sync from the best peer only if the skeleton is not available", func(t *testing.T) {
+		t.Parallel()
+
+		test := newParallelSyncTest(
+			t,
+			[]*NoForkPeer{peerA, peerB, peerC},
+			nil,
+			func(_ peer.ID, start, end uint64) ([]*types.Block, error) {
+				return blocksRange(blocks, start, end), nil
+			},
+		)
+
+		lastNumber, _, err := test.syncer.parallelSyncWithPeers(
+			peerA,
+			map[peer.ID]bool{},
+			func(*types.FullBlock) bool { return false },
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(numBlocks), lastNumber)
+		assert.Equal(t, expectedNumbers(numBlocks), test.written)
+		assert.Equal(t, 1, test.requestsTo(peerA.ID))
+		assert.Zero(t, test.requestsTo(peerB.ID))
+		assert.Zero(t, test.requestsTo(peerC.ID))
+	})
+
+	t.Run("should sync from the best peer only if the other peers are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		test := newParallelSyncTest(
+			t,
+			[]*NoForkPeer{peerA, peerB},
+			getHeaders,
+			func(_ peer.ID, start, end uint64) ([]*types.Block, error) {
+				return blocksRange(blocks, start, end), nil
+			},
+		)
+
+		lastNumber, _, err := test.syncer.parallelSyncWithPeers(
+			peerA,
+			map[peer.ID]bool{peerB.ID: true},
+			func(*types.FullBlock) bool { return false },
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(numBlocks), lastNumber)
+		assert.Equal(t, expectedNumbers(numBlocks), test.written)
+		assert.Zero(t, test.requestsTo(peerB.ID))
+	})
+
+	t.Run("should fail if all the peers are faulty", func(t *testing.T) {
+		t.Parallel()
+
+		test := newParallelSyncTest(
+			t,
+			[]*NoForkPeer{peerA, peerB},
+			getHeaders,
+			func(_ peer.ID, start, end uint64) ([]*types.Block, error) {
+				return blocksRange(forked, start, end), nil
+			},
+		)
+
+		_, _, err := test.syncer.parallelSyncWithPeers(
+			peerA,
+			map[peer.ID]bool{},
+			func(*types.FullBlock) bool { return false },
+		)
+
+		assert.ErrorIs(t, err, errNoRangePeers)
+		assert.Empty(t, test.written)
+	})
+
+	t.Run("should stop syncing when the callback returns true", func(t *testing.T) {
+		t.Parallel()
+
+		const terminateAt = 100
+
+		test := newParallelSyncTest(
+			t,
+			[]*NoForkPeer{peerA, peerB, peerC},
+			getHeaders,
+			func(_ peer.ID, start, end uint64) ([]*types.Block, error) {
+				return blocksRange(blocks, start, end), nil
+			},
+		)
+
+		lastNumber, shouldTerminate, err := test.syncer.parallelSyncWithPeers(
+			peerA,
+			map[peer.ID]bool{},
+			func(b *types.FullBlock) bool { return b.Block.Number() == terminateAt },
+		)
+
+		require.NoError(t, err)
+		assert.True(t, shouldTerminate)
+		assert.Equal(t, uint64(terminateAt), lastNumber)
+		assert.Equal(t, expectedNumbers(terminateAt), test.written)
+	})
+}
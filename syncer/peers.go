@@ -2,7 +2,9 @@ package syncer
 
 import (
 	"math/big"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 )
@@ -42,6 +44,18 @@ func (m *PeerMap) Put(peers ...*NoForkPeer) {
 	}
 }
 
+// Get returns the status of the peer, or nil if it is unknown
+func (m *PeerMap) Get(peerID peer.ID) *NoForkPeer {
+	value, ok := m.Load(peerID.String())
+	if !ok {
+		return nil
+	}
+
+	peer, _ := value.(*NoForkPeer)
+
+	return peer
+}
+
 // Remove removes a peer from heap if it exists
 func (m *PeerMap) Remove(peerID peer.ID) {
 	m.Delete(peerID.String())
@@ -67,3 +81,127 @@ func (m *PeerMap) BestPeer(skipMap map[peer.ID]bool) *NoForkPeer {
 
 	return bestPeer
 }
+
+// SyncPeers returns the peers having at least the given block, except the skipped ones
+func (m *PeerMap) SyncPeers(minNumber uint64, skipMap map[peer.ID]bool) []*NoForkPeer {
+	peers := make([]*NoForkPeer, 0)
+
+	m.Range(func(key, value interface{}) bool {
+		peer, _ := value.(*NoForkPeer)
+
+		if peer.Number >= minNumber && !skipMap[peer.ID] {
+			peers = append(peers, peer)
+		}
+
+		return true
+	})
+
+	return peers
+}
+
+// throughputSmoothing is the weight of the latest measurement in the throughput moving average
+const throughputSmoothing = 0.3
+
+type peerThroughput struct {
+	// rate is the moving average of the downloaded blocks per second
+	rate float64
+	// failures is the number of the failed downloads
+	failures int
+}
+
+// PeerThroughput scores the peers by their block download throughput
+// and the number of their failed downloads
+type PeerThroughput struct {
+	lock  sync.RWMutex
+	peers map[peer.ID]*peerThroughput
+}
+
+func NewPeerThroughput() *PeerThroughput {
+	return &PeerThroughput{
+		peers: make(map[peer.ID]*peerThroughput),
+	}
+}
+
+// Update records the blocks downloaded from the peer in the given time
+func (t *PeerThroughput) Update(peerID peer.ID, blocks int, elapsed time.Duration) {
+	if elapsed <= 0 {
+		elapsed = time.Millisecond
+	}
+
+	rate := float64(blocks) / elapsed.Seconds()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	throughput, ok := t.peers[peerID]
+	if !ok {
+		t.peers[peerID] = &peerThroughput{rate: rate}
+
+		return
+	}
+
+	throughput.rate = throughputSmoothing*rate + (1-throughputSmoothing)*throughput.rate
+}
+
+// Fail records a failed download from the peer
+func (t *PeerThroughput) Fail(peerID peer.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	throughput, ok := t.peers[peerID]
+	if !ok {
+		throughput = &peerThroughput{}
+		t.peers[peerID] = throughput
+	}
+
+	throughput.failures++
+}
+
+// Rate returns the download throughput of the peer in blocks per second, zero if unknown
+func (t *PeerThroughput) Rate(peerID peer.ID) float64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if throughput, ok := t.peers[peerID]; ok {
+		return throughput.rate
+	}
+
+	return 0
+}
+
+// Remove drops the score of the peer
+func (t *PeerThroughput) Remove(peerID peer.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.peers, peerID)
+}
+
+// Sort orders the peers from the best one: the fewer failures first,
+// then the higher throughput, then the better status
+func (t *PeerThroughput) Sort(peers []*NoForkPeer) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	score := func(id peer.ID) peerThroughput {
+		if throughput, ok := t.peers[id]; ok {
+			return *throughput
+		}
+
+		return peerThroughput{}
+	}
+
+	sort.SliceStable(peers, func(i, j int) bool {
+		a, b := score(peers[i].ID), score(peers[j].ID)
+
+		if a.failures != b.failures {
+			return a.failures < b.failures
+		}
+
+		if a.rate != b.rate {
+			return a.rate > b.rate
+		}
+
+		return peers[i].IsBetter(peers[j])
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/assert"
@@ -160,3 +161,61 @@ func TestBestPeer(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPeer(t *testing.T) {
+	t.Parallel()
+
+	peerMap := NewPeerMap(peers)
+
+	assert.Equal(t, peers[1], peerMap.Get(peers[1].ID))
+	assert.Nil(t, peerMap.Get(peer.ID("D")))
+
+	peerMap.Remove(peers[1].ID)
+
+	assert.Nil(t, peerMap.Get(peers[1].ID))
+}
+
+func TestSyncPeers(t *testing.T) {
+	t.Parallel()
+
+	peerMap := NewPeerMap(peers)
+
+	syncPeers := peerMap.SyncPeers(20, nil)
+	sortNoForkPeers(syncPeers)
+
+	assert.Equal(t, []*NoForkPeer{peers[2], peers[1]}, syncPeers)
+	assert.Equal(t, []*NoForkPeer{peers[1]}, peerMap.SyncPeers(20, map[peer.ID]bool{peers[2].ID: true}))
+	assert.Empty(t, peerMap.SyncPeers(21, nil))
+}
+
+func TestPeerThroughput(t *testing.T) {
+	t.Parallel()
+
+	var (
+		throughput = NewPeerThroughput()
+		peerA      = &NoForkPeer{ID: peer.ID("A"), Number: 10, Distance: big.NewInt(1)}
+		peerB      = &NoForkPeer{ID: peer.ID("B"), Number: 10, Distance: big.NewInt(2)}
+		peerC      = &NoForkPeer{ID: peer.ID("C"), Number: 20, Distance: big.NewInt(3)}
+		peerD      = &NoForkPeer{ID: peer.ID("D"), Number: 10, Distance: big.NewInt(0)}
+	)
+
+	// the first measurement is taken as is, the next ones are averaged
+	throughput.Update(peerA.ID, 100, time.Second)
+	assert.Equal(t, 100.0, throughput.Rate(peerA.ID))
+
+	throughput.Update(peerA.ID, 200, time.Second)
+	assert.InDelta(t, 130.0, throughput.Rate(peerA.ID), 0.001)
+
+	throughput.Update(peerB.ID, 50, time.Second)
+	throughput.Update(peerC.ID, 500, time.Second)
+	throughput.Fail(peerC.ID)
+
+	// the failed peers are last, the unknown peers are ordered by their status
+	sorted := []*NoForkPeer{peerD, peerC, peerB, peerA}
+	throughput.Sort(sorted)
+
+	assert.Equal(t, []*NoForkPeer{peerA, peerB, peerD, peerC}, sorted)
+
+	throughput.Remove(peerA.ID)
+	assert.Zero(t, throughput.Rate(peerA.ID))
+}
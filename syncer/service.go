@@ -11,6 +11,9 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
+// maxHeadersAmount is the maximum number of headers returned by a single GetHeaders request
+const maxHeadersAmount = 256
+
 var (
 	ErrBlockNotFound = errors.New("block not found")
 )
@@ -38,8 +41,12 @@ func (s *syncPeerService) Start() {
 	s.setupGRPCServer()
 }
 
-// Close closes syncPeerService
+// Close closes syncPeerService. The service of the consensus not started yet has no stream to close
 func (s *syncPeerService) Close() error {
+	if s.stream == nil {
+		return nil
+	}
+
 	return s.stream.Close()
 }
 
@@ -57,8 +64,13 @@ func (s *syncPeerService) GetBlocks(
 	req *proto.GetBlocksRequest,
 	stream proto.SyncPeer_GetBlocksServer,
 ) error {
-	// from to latest
-	for i := req.From; i <= s.blockchain.Header().Number; i++ {
+	// from to latest, or to the requested block if it is lower
+	to := s.blockchain.Header().Number
+	if req.To != 0 && req.To < to {
+		to = req.To
+	}
+
+	for i := req.From; i <= to; i++ {
 		block, ok := s.blockchain.GetBlockByNumber(i, true)
 		if !ok {
 			return ErrBlockNotFound
@@ -76,6 +88,36 @@ func (s *syncPeerService) GetBlocks(
 	return nil
 }
 
+// GetHeaders is a gRPC endpoint to return the headers from the specific height,
+// with the given number of blocks skipped between them, up to the latest block
+func (s *syncPeerService) GetHeaders(
+	ctx context.Context,
+	req *proto.GetHeadersRequest,
+) (*proto.Headers, error) {
+	amount := req.Amount
+	if amount > maxHeadersAmount {
+		amount = maxHeadersAmount
+	}
+
+	var (
+		latest  = s.blockchain.Header().Number
+		headers = make([][]byte, 0, amount)
+	)
+
+	for i, number := uint64(0), req.From; i < amount && number <= latest; i, number = i+1, number+req.Skip+1 {
+		block, ok := s.blockchain.GetBlockByNumber(number, false)
+		if !ok {
+			return nil, ErrBlockNotFound
+		}
+
+		headers = append(headers, block.Header.MarshalRLP())
+	}
+
+	return &proto.Headers{
+		Headers: headers,
+	}, nil
+}
+
 // GetStatus is a gRPC endpoint to return the latest block number as a node status
 func (s *syncPeerService) GetStatus(
 	ctx context.Context,
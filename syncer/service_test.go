@@ -60,6 +60,7 @@ func Test_syncPeerService_GetBlocks(t *testing.T) {
 	tests := []struct {
 		name           string
 		from           uint64
+		to             uint64
 		latest         uint64
 		blocks         []*types.Block
 		receivedBlocks []*types.Block
@@ -73,6 +74,15 @@ func Test_syncPeerService_GetBlocks(t *testing.T) {
 			receivedBlocks: blocks[4:], // from 5
 			err:            io.EOF,
 		},
+		{
+			name:           "should send the blocks to the requested block",
+			from:           5,
+			to:             7,
+			latest:         10,
+			blocks:         blocks,
+			receivedBlocks: blocks[4:7], // from 5 to 7
+			err:            io.EOF,
+		},
 		{
 			name:           "should return ErrBlockNotFound",
 			from:           5,
@@ -112,6 +122,7 @@ func Test_syncPeerService_GetBlocks(t *testing.T) {
 
 			stream, err := client.GetBlocks(context.Background(), &proto.GetBlocksRequest{
 				From: test.from,
+				To:   test.to,
 			})
 
 			assert.NoError(t, err)
@@ -132,10 +143,71 @@ func Test_syncPeerService_GetBlocks(t *testing.T) {
 
 				count++
 			}
+
+			assert.Equal(t, len(test.receivedBlocks), count)
 		})
 	}
 }
 
+func Test_syncPeerService_GetHeaders(t *testing.T) {
+	t.Parallel()
+
+	blocks := createMockBlocks(10)
+
+	service := &syncPeerService{
+		blockchain: &mockBlockchain{
+			headerHandler: newSimpleHeaderHandler(10),
+			getBlockByNumberHandler: func(u uint64, _ bool) (*types.Block, bool) {
+				if u == 0 || u > uint64(len(blocks)) {
+					return nil, false
+				}
+
+				return blocks[u-1], true
+			},
+		},
+	}
+
+	client := newMockGrpcClient(t, service)
+
+	tests := []struct {
+		name     string
+		request  *proto.GetHeadersRequest
+		expected []uint64
+	}{
+		{
+			name:     "should return the headers at the interval",
+			request:  &proto.GetHeadersRequest{From: 2, Skip: 2, Amount: 3},
+			expected: []uint64{2, 5, 8},
+		},
+		{
+			name:     "should stop at the latest block",
+			request:  &proto.GetHeadersRequest{From: 4, Skip: 3, Amount: 10},
+			expected: []uint64{4, 8},
+		},
+		{
+			name:     "should return the consecutive headers without skip",
+			request:  &proto.GetHeadersRequest{From: 9, Amount: 5},
+			expected: []uint64{9, 10},
+		},
+	}
+
+	for _, test := range tests {
+		resp, err := client.GetHeaders(context.Background(), test.request)
+		assert.NoError(t, err, test.name)
+
+		numbers := make([]uint64, len(resp.Headers))
+
+		for i, raw := range resp.Headers {
+			header := &types.Header{}
+			assert.NoError(t, header.UnmarshalRLP(raw), test.name)
+
+			numbers[i] = header.Number
+		}
+
+		assert.Equal(t, test.expected, numbers, test.name)
+	}
+}
+
 func TestGetStatus(t *testing.T) {
 	t.Parallel()
 
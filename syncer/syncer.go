@@ -1,16 +1,20 @@
 package syncer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/network/event"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -20,6 +24,8 @@ const (
 
 var (
 	errTimeout = errors.New("timeout awaiting block from peer")
+
+	spanTracer = tracing.Tracer("syncer")
 )
 
 // XXX: Don't use this syncer for the consensus that may cause fork.
@@ -30,6 +36,8 @@ type syncer struct {
 	syncProgression Progression
 
 	peerMap         *PeerMap
+	peerThroughput  *PeerThroughput
+	checkpointPeers checkpointPeers
 	syncPeerService SyncPeerService
 	syncPeerClient  SyncPeerClient
 
@@ -55,6 +63,7 @@ func NewSyncer(
 		blockTimeout:    blockTimeout,
 		newStatusCh:     make(chan struct{}),
 		peerMap:         new(PeerMap),
+		peerThroughput:  NewPeerThroughput(),
 	}
 }
 
@@ -135,6 +144,7 @@ func (s *syncer) putToPeerMap(status *NoForkPeer) {
 // removeFromPeerMap removes the peer from peer map
 func (s *syncer) removeFromPeerMap(peerID peer.ID) {
 	s.peerMap.Remove(peerID)
+	s.peerThroughput.Remove(peerID)
 }
 
 // notifyNewStatusEvent emits signal to newStatusCh
@@ -159,7 +169,13 @@ func (s *syncer) HasSyncPeer() bool {
 	return bestPeer != nil && bestPeer.Number > header.Number
 }
 
-// Sync syncs block with the best peer until callback returns true
+// PeerStatus returns the latest known status of the peer, or nil if it is unknown
+func (s *syncer) PeerStatus(peerID peer.ID) *NoForkPeer {
+	return s.peerMap.Get(peerID)
+}
+
+// Sync syncs block with the best peer until callback returns true.
+// While the node is far behind, the ranges of blocks are downloaded from multiple peers in parallel
 func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 	localLatest := s.blockchain.Header().Number
 	skipList := make(map[peer.ID]bool)
@@ -187,8 +203,17 @@ func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 			continue
 		}
 
-		// fetch block from the peer
-		lastNumber, shouldTerminate, err := s.bulkSyncWithPeer(bestPeer.ID, callback)
+		// do not sync from the peer until its chain is known to contain the checkpoint
+		if err := s.verifyCheckpoint(bestPeer); err != nil {
+			s.logger.Warn("failed to verify checkpoint with peer, try to next one", "peer ID", bestPeer.ID, "error", err)
+
+			skipList[bestPeer.ID] = true
+
+			continue
+		}
+
+		// fetch blocks from the peer, and from the other peers in parallel if far behind
+		lastNumber, shouldTerminate, err := s.parallelSyncWithPeers(bestPeer, skipList, callback)
 		if err != nil {
 			s.logger.Warn("failed to complete bulk sync with peer, try to next one", "peer ID", "error", bestPeer.ID, err)
 		}
@@ -209,11 +234,20 @@ func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 }
 
 // bulkSyncWithPeer syncs block with a given peer
-func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.FullBlock) bool) (uint64, bool, error) {
+func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.FullBlock) bool) (
+	lastReceivedNumber uint64, shouldTerminate bool, err error) {
 	localLatest := s.blockchain.Header().Number
-	shouldTerminate := false
 
-	blockCh, err := s.syncPeerClient.GetBlocks(peerID, localLatest+1, s.blockTimeout)
+	ctx, span := spanTracer.Start(context.Background(), "syncer.bulk_sync", trace.WithAttributes(
+		attribute.String("peer.id", peerID.String()),
+		attribute.Int64("block.from", int64(localLatest+1)),
+	))
+	defer func() {
+		span.SetAttributes(attribute.Int64("block.to", int64(lastReceivedNumber)))
+		tracing.End(span, err)
+	}()
+
+	blockCh, err := s.syncPeerClient.GetBlocks(peerID, localLatest+1, 0, s.blockTimeout)
 	if err != nil {
 		return 0, false, err
 	}
@@ -225,11 +259,13 @@ func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.F
 		}
 	}()
 
-	var lastReceivedNumber uint64
-
 	for {
+		_, fetchSpan := spanTracer.Start(ctx, "syncer.fetch_block")
+
 		select {
 		case block, ok := <-blockCh:
+			fetchSpan.End()
+
 			if !ok {
 				return lastReceivedNumber, shouldTerminate, nil
 			}
@@ -239,17 +275,14 @@ func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.F
 				continue
 			}
 
-			fullBlock, err := s.blockchain.VerifyFinalizedBlock(block)
-			if err != nil {
-				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
-
-				return lastReceivedNumber, false, fmt.Errorf("unable to verify block, %w", err)
-			}
-
-			if err := s.blockchain.WriteFullBlock(fullBlock, syncerName); err != nil {
-				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+			importCtx, importSpan := spanTracer.Start(ctx, "syncer.import_block", trace.WithAttributes(
+				attribute.Int64("block.number", int64(block.Number())),
+				attribute.Int("block.txs", len(block.Transactions)),
+			))
+			fullBlock, err := s.importBlock(importCtx, block)
 
-				return lastReceivedNumber, false, fmt.Errorf("failed to write block while bulk syncing: %w", err)
+			if tracing.End(importSpan, err); err != nil {
+				return lastReceivedNumber, false, err
 			}
 
 			updateMetrics(fullBlock)
@@ -257,11 +290,32 @@ func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.F
 
 			lastReceivedNumber = block.Number()
 		case <-time.After(s.blockTimeout):
+			tracing.End(fetchSpan, errTimeout)
+
 			return lastReceivedNumber, shouldTerminate, errTimeout
 		}
 	}
 }
 
+// importBlock verifies the finalized block received from the peer and writes it to the chain,
+// tracing both within the given context
+func (s *syncer) importBlock(ctx context.Context, block *types.Block) (*types.FullBlock, error) {
+	fullBlock, err := s.blockchain.VerifyFinalizedBlockWithContext(ctx, block)
+	if err != nil {
+		metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+		return nil, fmt.Errorf("unable to verify block, %w", err)
+	}
+
+	if err := s.blockchain.WriteFullBlockWithContext(ctx, fullBlock, syncerName); err != nil {
+		metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+		return nil, fmt.Errorf("failed to write block while bulk syncing: %w", err)
+	}
+
+	return fullBlock, nil
+}
+
 func updateMetrics(fullBlock *types.FullBlock) {
 	metrics.SetGauge([]string{syncerMetrics, "tx_num"}, float32(len(fullBlock.Block.Transactions)))
 	metrics.SetGauge([]string{syncerMetrics, "receipts_num"}, float32(len(fullBlock.Receipts)))
@@ -1,6 +1,7 @@
 package syncer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -42,6 +43,7 @@ type mockBlockchain struct {
 	verifyFinalizedBlockHandler func(*types.Block) (*types.FullBlock, error)
 	writeBlockHandler           func(*types.Block) error
 	writeFullBlockHandler       func(*types.FullBlock) error
+	checkpoint                  *blockchain.Checkpoint
 }
 
 func (m *mockBlockchain) SubscribeEvents() blockchain.Subscription {
@@ -56,7 +58,7 @@ func (m *mockBlockchain) GetBlockByNumber(number uint64, full bool) (*types.Bloc
 	return m.getBlockByNumberHandler(number, full)
 }
 
-func (m *mockBlockchain) VerifyFinalizedBlock(b *types.Block) (*types.FullBlock, error) {
+func (m *mockBlockchain) VerifyFinalizedBlockWithContext(_ context.Context, b *types.Block) (*types.FullBlock, error) {
 	return m.verifyFinalizedBlockHandler(b)
 }
 
@@ -64,10 +66,14 @@ func (m *mockBlockchain) WriteBlock(b *types.Block, s string) error {
 	return m.writeBlockHandler(b)
 }
 
-func (m *mockBlockchain) WriteFullBlock(b *types.FullBlock, s string) error {
+func (m *mockBlockchain) WriteFullBlockWithContext(_ context.Context, b *types.FullBlock, s string) error {
 	return m.writeFullBlockHandler(b)
 }
 
+func (m *mockBlockchain) Checkpoint() *blockchain.Checkpoint {
+	return m.checkpoint
+}
+
 func newSimpleHeaderHandler(num uint64) func() *types.Header {
 	return func() *types.Header {
 		return &types.Header{
@@ -89,7 +95,8 @@ func (m *mockProgression) StopProgression() {}
 type mockSyncPeerClient struct {
 	getPeerStatusHandler                  func(peer.ID) (*NoForkPeer, error)
 	getConnectedPeerStatusesHandler       func() []*NoForkPeer
-	getBlocksHandler                      func(peer.ID, uint64, time.Duration) (<-chan *types.Block, error)
+	getBlocksHandler                      func(peer.ID, uint64, uint64, time.Duration) (<-chan *types.Block, error)
+	getHeadersHandler                     func(peer.ID, uint64, uint64, uint64) ([]*types.Header, error)
 	getPeerStatusUpdateChHandler          func() <-chan *NoForkPeer
 	getPeerConnectionUpdateEventChHandler func() <-chan *event.PeerEvent
 }
@@ -115,9 +122,22 @@ func (m *mockSyncPeerClient) GetConnectedPeerStatuses() []*NoForkPeer {
 func (m *mockSyncPeerClient) GetBlocks(
 	id peer.ID,
 	start uint64,
+	end uint64,
 	timeoutPerBlock time.Duration,
 ) (<-chan *types.Block, error) {
-	return m.getBlocksHandler(id, start, timeoutPerBlock)
+	return m.getBlocksHandler(id, start, end, timeoutPerBlock)
+}
+
+func (m *mockSyncPeerClient) GetHeaders(
+	id peer.ID,
+	from, skip, amount uint64,
+	_ time.Duration,
+) ([]*types.Header, error) {
+	if m.getHeadersHandler == nil {
+		return nil, errors.New("headers not supported")
+	}
+
+	return m.getHeadersHandler(id, from, skip, amount)
 }
 
 func (m *mockSyncPeerClient) GetPeerStatusUpdateCh() <-chan *NoForkPeer {
@@ -173,6 +193,7 @@ func NewTestSyncer(
 		blockTimeout:    blockTimeout,
 		newStatusCh:     make(chan struct{}),
 		peerMap:         new(PeerMap),
+		peerThroughput:  NewPeerThroughput(),
 	}
 }
 
@@ -624,7 +645,7 @@ func TestSync(t *testing.T) {
 					},
 					time.Second,
 					&mockSyncPeerClient{
-						getBlocksHandler: func(i peer.ID, u uint64, _ time.Duration) (<-chan *types.Block, error) {
+						getBlocksHandler: func(i peer.ID, u, _ uint64, _ time.Duration) (<-chan *types.Block, error) {
 							// should not panic
 							peerCh := test.peerBlocksCh[i]
 
@@ -691,7 +712,7 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 		blockCallback   func(*types.FullBlock) bool
 
 		// peers
-		getBlocksHandler func(id peer.ID, start uint64, timeoutPerBlock time.Duration) (<-chan *types.Block, error)
+		getBlocksHandler func(id peer.ID, start, end uint64, timeoutPerBlock time.Duration) (<-chan *types.Block, error)
 
 		// handlers
 		verifyFinalizedBlockHandler func(*types.Block) (*types.FullBlock, error)
@@ -710,7 +731,7 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 			blockCallback: func(b *types.FullBlock) bool {
 				return false
 			},
-			getBlocksHandler: func(id peer.ID, start uint64, _ time.Duration) (<-chan *types.Block, error) {
+			getBlocksHandler: func(id peer.ID, start, _ uint64, _ time.Duration) (<-chan *types.Block, error) {
 				return blocksToCh(blocks[:10], 0), nil
 			},
 			verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
@@ -731,7 +752,7 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 			blockCallback: func(b *types.FullBlock) bool {
 				return false
 			},
-			getBlocksHandler: func(id peer.ID, start uint64, _ time.Duration) (<-chan *types.Block, error) {
+			getBlocksHandler: func(id peer.ID, start, _ uint64, _ time.Duration) (<-chan *types.Block, error) {
 				return nil, errPeerNoResponse
 			},
 			verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
@@ -752,7 +773,7 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 			blockCallback: func(b *types.FullBlock) bool {
 				return false
 			},
-			getBlocksHandler: func(id peer.ID, start uint64, _ time.Duration) (<-chan *types.Block, error) {
+			getBlocksHandler: func(id peer.ID, start, _ uint64, _ time.Duration) (<-chan *types.Block, error) {
 				return blocksToCh(blocks[:10], 0), nil
 			},
 			verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
@@ -777,7 +798,7 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 			blockCallback: func(b *types.FullBlock) bool {
 				return false
 			},
-			getBlocksHandler: func(id peer.ID, start uint64, _ time.Duration) (<-chan *types.Block, error) {
+			getBlocksHandler: func(id peer.ID, start, _ uint64, _ time.Duration) (<-chan *types.Block, error) {
 				return blocksToCh(blocks[:10], 0), nil
 			},
 			verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
@@ -802,7 +823,7 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 			blockCallback: func(b *types.FullBlock) bool {
 				return false
 			},
-			getBlocksHandler: func(id peer.ID, start uint64, _ time.Duration) (<-chan *types.Block, error) {
+			getBlocksHandler: func(id peer.ID, start, _ uint64, _ time.Duration) (<-chan *types.Block, error) {
 				return blocksToCh(blocks[:10], time.Second*1), nil
 			},
 			verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
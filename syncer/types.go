@@ -25,12 +25,31 @@ type Blockchain interface {
 	Header() *types.Header
 	// GetBlockByNumber returns block by number
 	GetBlockByNumber(uint64, bool) (*types.Block, bool)
-	// VerifyFinalizedBlock verifies finalized block
-	VerifyFinalizedBlock(block *types.Block) (*types.FullBlock, error)
+	// VerifyFinalizedBlockWithContext verifies finalized block, tracing its execution within the given context
+	VerifyFinalizedBlockWithContext(ctx context.Context, block *types.Block) (*types.FullBlock, error)
 	// WriteBlock writes a given block to chain
 	WriteBlock(*types.Block, string) error
-	// WriteFullBlock writes a given block to chain and saves its receipts to cache
-	WriteFullBlock(*types.FullBlock, string) error
+	// WriteFullBlockWithContext writes a given block to chain and saves its receipts to cache,
+	// tracing the write within the given context
+	WriteFullBlockWithContext(context.Context, *types.FullBlock, string) error
+	// Checkpoint returns the trusted block the chain has to contain, or nil if it is not set
+	Checkpoint() *blockchain.Checkpoint
+}
+
+// LightBlockchain is the blockchain of a light node, which keeps the verified headers only
+type LightBlockchain interface {
+	Blockchain
+	// VerifyFinalizedHeader verifies the committed seals of the header against its parent
+	VerifyFinalizedHeader(*types.Header) error
+	// WriteHeader writes a given header to chain, without its body
+	WriteHeader(*types.Header, string) error
+}
+
+// CheckpointBlockchain is the empty blockchain started from the trusted checkpoint
+type CheckpointBlockchain interface {
+	Blockchain
+	// VerifyCheckpointHeaders verifies the headers up to the checkpoint against it
+	VerifyCheckpointHeaders([]*types.Header) error
 }
 
 type Network interface {
@@ -48,7 +67,7 @@ type Network interface {
 	// and returns a reference to the connection
 	NewProtoConnection(protocol string, peerID peer.ID) (*rawGrpc.ClientConn, error)
 	// NewTopic Creates New Topic for gossip
-	NewTopic(protoID string, obj proto.Message) (*network.Topic, error)
+	NewTopic(protoID string, obj proto.Message, opts ...network.TopicOption) (*network.Topic, error)
 	// IsConnected returns the node is connecting to the peer associated with the given ID
 	IsConnected(peerID peer.ID) bool
 	// SaveProtocolStream saves stream
@@ -68,6 +87,21 @@ type Syncer interface {
 	HasSyncPeer() bool
 	// Sync starts routine to sync blocks
 	Sync(func(*types.FullBlock) bool) error
+	// PeerStatus returns the latest known status of the peer, or nil if it is unknown
+	PeerStatus(peerID peer.ID) *NoForkPeer
+}
+
+type LightSyncer interface {
+	// Start starts light syncer processes
+	Start() error
+	// Close terminates light syncer process
+	Close() error
+	// HasSyncPeer returns whether light syncer has the peer it can sync with
+	HasSyncPeer() bool
+	// Sync starts routine to sync headers
+	Sync(func(*types.Header) bool) error
+	// PeerStatus returns the latest known status of the peer, or nil if it is unknown
+	PeerStatus(peerID peer.ID) *NoForkPeer
 }
 
 type Progression interface {
@@ -97,8 +131,10 @@ type SyncPeerClient interface {
 	GetPeerStatus(id peer.ID) (*NoForkPeer, error)
 	// GetConnectedPeerStatuses fetches the statuses of all connecting peers
 	GetConnectedPeerStatuses() []*NoForkPeer
-	// GetBlocks returns a stream of blocks from given height to peer's latest
-	GetBlocks(peer.ID, uint64, time.Duration) (<-chan *types.Block, error)
+	// GetBlocks returns a stream of blocks from given height to the given one, or to peer's latest if zero
+	GetBlocks(peer.ID, uint64, uint64, time.Duration) (<-chan *types.Block, error)
+	// GetHeaders fetches the headers from given height with the given number of blocks skipped between them
+	GetHeaders(peerID peer.ID, from, skip, amount uint64, timeout time.Duration) ([]*types.Header, error)
 	// GetPeerStatusUpdateCh returns a channel of peer's status update
 	GetPeerStatusUpdateCh() <-chan *NoForkPeer
 	// GetPeerConnectionUpdateEventCh returns peer's connection change event
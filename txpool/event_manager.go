@@ -82,6 +82,9 @@ func (em *eventManager) Close() {
 		subscription.close()
 	}
 
+	// the subscribers cancelling afterwards must not close the subscriptions again
+	em.subscriptions = make(map[subscriptionID]*eventSubscription)
+
 	atomic.StoreInt64(&em.numSubscriptions, 0)
 }
 
@@ -60,6 +60,25 @@ func (q *accountQueue) prune(nonce uint64) (
 	return
 }
 
+// removeFrom removes all transactions from the queue
+// with nonce higher than or equal to given.
+func (q *accountQueue) removeFrom(nonce uint64) (removed []*types.Transaction) {
+	kept := q.queue[:0]
+
+	for _, tx := range q.queue {
+		if tx.Nonce >= nonce {
+			removed = append(removed, tx)
+		} else {
+			kept = append(kept, tx)
+		}
+	}
+
+	q.queue = kept
+	heap.Init(&q.queue)
+
+	return
+}
+
 // clear removes all transactions from the queue.
 func (q *accountQueue) clear() (removed []*types.Transaction) {
 	// store txs
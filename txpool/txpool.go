@@ -63,6 +63,8 @@ var (
 	ErrNonceExistsInPool       = errors.New("tx with the same nonce is already present")
 	ErrReplacementUnderpriced  = errors.New("replacement tx underpriced")
 	ErrDynamicTxNotAllowed     = errors.New("dynamic tx not allowed currently")
+	ErrTxNotFound              = errors.New("transaction not found in the pool")
+	ErrGossipDisabled          = errors.New("transaction gossip is disabled")
 )
 
 // indicates origin of a transaction
@@ -227,7 +229,11 @@ func NewTxPool(
 
 	if network != nil {
 		// subscribe to the gossip protocol
-		topic, err := network.NewTopic(topicNameV1, &proto.Txn{})
+		topic, err := network.NewTopic(
+			topicNameV1,
+			&proto.Txn{},
+			gossipTxTopicOptions()...,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -316,13 +322,7 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 	// broadcast the transaction only if a topic
 	// subscription is present
 	if p.topic != nil {
-		tx := &proto.Txn{
-			Raw: &any.Any{
-				Value: tx.MarshalRLP(),
-			},
-		}
-
-		if err := p.topic.Publish(tx); err != nil {
+		if err := p.publish(tx); err != nil {
 			p.logger.Error("failed to topic tx", "err", err)
 		}
 	}
@@ -330,6 +330,29 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 	return nil
 }
 
+// RebroadcastTx gossips the transaction from the pool to the network again
+func (p *TxPool) RebroadcastTx(hash types.Hash) error {
+	tx, ok := p.index.get(hash)
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	if p.topic == nil {
+		return ErrGossipDisabled
+	}
+
+	return p.publish(tx)
+}
+
+// publish broadcasts the transaction on the gossip topic
+func (p *TxPool) publish(tx *types.Transaction) error {
+	return p.topic.Publish(&proto.Txn{
+		Raw: &any.Any{
+			Value: tx.MarshalRLP(),
+		},
+	})
+}
+
 // Prepare generates all the transactions
 // ready for execution. (primaries)
 func (p *TxPool) Prepare() {
@@ -486,6 +509,117 @@ func (p *TxPool) ResetWithHeaders(headers ...*types.Header) {
 	})
 }
 
+// Flush drops all the transactions from the pool and sets the accounts' nonces
+// to the ones in the state of the current header. Unlike ResetWithHeaders,
+// it also lowers the nonces, so it is used once the chain head has been moved back
+func (p *TxPool) Flush() {
+	header := p.store.Header()
+
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+		account, _ := value.(*account)
+
+		p.flushAccount(header, addr, account)
+
+		return true
+	})
+
+	p.SetBaseFee(header)
+}
+
+// FlushAccount drops all the transactions of the account from the pool,
+// sets its nonce to the one in the state of the current header
+// and returns the hashes of the dropped transactions
+func (p *TxPool) FlushAccount(addr types.Address) []types.Hash {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return nil
+	}
+
+	dropped := p.flushAccount(p.store.Header(), addr, account)
+
+	p.logger.Info("flushed account txs", "num", len(dropped), "address", addr.String())
+
+	return toHash(dropped...)
+}
+
+// flushAccount drops all the transactions of the account and sets its nonce
+// to the one in the state of the given header
+func (p *TxPool) flushAccount(header *types.Header, addr types.Address, account *account) []*types.Transaction {
+	account.promoted.lock(true)
+	account.enqueued.lock(true)
+	account.nonceToTx.lock()
+
+	promoted := account.promoted.clear()
+	dropped := append(promoted, account.enqueued.clear()...)
+
+	account.nonceToTx.reset()
+	account.setNonce(p.store.GetNonce(header.StateRoot, addr))
+
+	account.nonceToTx.unlock()
+	account.enqueued.unlock()
+	account.promoted.unlock()
+
+	if len(dropped) > 0 {
+		p.index.remove(dropped...)
+		p.gauge.decrease(slotsRequired(dropped...))
+		p.updatePending(-1 * int64(len(promoted)))
+		p.eventManager.signalEvent(proto.EventType_DROPPED, toHash(dropped...)...)
+	}
+
+	return dropped
+}
+
+// RemoveTx removes the transaction from the pool along with the following transactions
+// of its sender, which can not be executed without it, and returns the hashes of the removed transactions
+func (p *TxPool) RemoveTx(hash types.Hash) ([]types.Hash, error) {
+	tx, ok := p.index.get(hash)
+	if !ok {
+		return nil, ErrTxNotFound
+	}
+
+	account := p.accounts.get(tx.From)
+	if account == nil {
+		return nil, ErrTxNotFound
+	}
+
+	account.promoted.lock(true)
+	account.enqueued.lock(true)
+	account.nonceToTx.lock()
+
+	defer func() {
+		account.nonceToTx.unlock()
+		account.enqueued.unlock()
+		account.promoted.unlock()
+	}()
+
+	// the transaction might have been removed or replaced meanwhile
+	if account.nonceToTx.get(tx.Nonce) != tx {
+		return nil, ErrTxNotFound
+	}
+
+	promoted := account.promoted.removeFrom(tx.Nonce)
+	removed := append(promoted, account.enqueued.removeFrom(tx.Nonce)...)
+
+	account.nonceToTx.remove(removed...)
+
+	// the removed transaction is expected again
+	if account.getNonce() > tx.Nonce {
+		account.setNonce(tx.Nonce)
+	}
+
+	p.index.remove(removed...)
+	p.gauge.decrease(slotsRequired(removed...))
+	p.updatePending(-1 * int64(len(promoted)))
+
+	hashes := toHash(removed...)
+	p.eventManager.signalEvent(proto.EventType_DROPPED, hashes...)
+
+	p.logger.Info("removed txs", "hash", hash.String(), "num", len(removed), "address", tx.From.String())
+
+	return hashes, nil
+}
+
 // processEvent collects the latest nonces for each account contained
 // in the received event. Resets all known accounts with the new nonce.
 func (p *TxPool) processEvent(event *blockchain.Event) {
@@ -942,6 +1076,28 @@ func (p *TxPool) addGossipTx(obj interface{}, _ peer.ID) {
 	}
 }
 
+// gossipTxTopicOptions returns the options of the transactions gossip topic
+func gossipTxTopicOptions() []network.TopicOption {
+	return []network.TopicOption{
+		network.WithTopicValidator(validateGossipTx),
+	}
+}
+
+// validateGossipTx rejects the gossiped transactions which can not be decoded,
+// so they are not propagated further. The rest of the checks is done by the pool
+func validateGossipTx(obj interface{}, _ peer.ID) network.ValidationResult {
+	raw, ok := obj.(*proto.Txn)
+	if !ok || raw.Raw == nil {
+		return network.ValidationReject
+	}
+
+	if err := new(types.Transaction).UnmarshalRLP(raw.Raw.Value); err != nil {
+		return network.ValidationReject
+	}
+
+	return network.ValidationAccept
+}
+
 // resetAccounts updates existing accounts with the new nonce and prunes stale transactions.
 func (p *TxPool) resetAccounts(stateNonces map[types.Address]uint64) {
 	if len(stateNonces) == 0 {
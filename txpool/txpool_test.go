@@ -15,6 +15,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
@@ -1854,6 +1855,140 @@ func TestDrop(t *testing.T) {
 	assert.Equal(t, (*types.Transaction)(nil), acc.nonceToTx.get(tx1.Nonce))
 }
 
+func TestFlush(t *testing.T) {
+	t.Parallel()
+
+	store := NewDefaultMockStore(mockHeader)
+
+	pool, err := newTestPool(&store)
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	// promote three txs and enqueue one
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		require.NoError(t, pool.addTx(local, newTx(addr1, nonce, 1)))
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	require.NoError(t, pool.addTx(local, newTx(addr1, 5, 1)))
+
+	acc := pool.accounts.get(addr1)
+
+	assert.Equal(t, uint64(3), acc.getNonce())
+	assert.Equal(t, uint64(4), pool.gauge.read())
+
+	// the head was moved back to the state in which the account sent one tx
+	store.nonce = 1
+
+	pool.Flush()
+
+	assert.Equal(t, uint64(1), acc.getNonce())
+	assert.Equal(t, uint64(0), pool.gauge.read())
+	assert.Equal(t, uint64(0), acc.promoted.length())
+	assert.Equal(t, uint64(0), acc.enqueued.length())
+	assert.Equal(t, int64(0), pool.pending)
+	assert.Len(t, acc.nonceToTx.mapping, 0)
+
+	// the dropped txs can be sent again
+	assert.NoError(t, pool.addTx(local, newTx(addr1, 1, 1)))
+}
+
+func TestFlushAccount(t *testing.T) {
+	t.Parallel()
+
+	store := NewDefaultMockStore(mockHeader)
+
+	pool, err := newTestPool(&store)
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	tx1, tx2 := newTx(addr1, 0, 1), newTx(addr2, 0, 1)
+
+	require.NoError(t, pool.addTx(local, tx1))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+	require.NoError(t, pool.addTx(local, tx2))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	assert.Equal(t, []types.Hash{tx1.Hash}, pool.FlushAccount(addr1))
+	assert.Empty(t, pool.FlushAccount(addr3))
+
+	assert.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
+	assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+	assert.Equal(t, uint64(1), pool.accounts.get(addr2).promoted.length())
+	assert.Equal(t, uint64(1), pool.gauge.read())
+	assert.Equal(t, int64(1), pool.pending)
+
+	_, ok := pool.index.get(tx1.Hash)
+	assert.False(t, ok)
+}
+
+func TestRemoveTx(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	// promote three txs and enqueue two
+	txs := make([]*types.Transaction, 0, 5)
+
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		tx := newTx(addr1, nonce, 1)
+		require.NoError(t, pool.addTx(local, tx))
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		txs = append(txs, tx)
+	}
+
+	for _, nonce := range []uint64{4, 5} {
+		tx := newTx(addr1, nonce, 1)
+		require.NoError(t, pool.addTx(local, tx))
+
+		txs = append(txs, tx)
+	}
+
+	acc := pool.accounts.get(addr1)
+
+	// the enqueued tx is removed with the following one
+	removed, err := pool.RemoveTx(txs[3].Hash)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Hash{txs[3].Hash, txs[4].Hash}, removed)
+	assert.Equal(t, uint64(3), acc.getNonce())
+	assert.Equal(t, uint64(0), acc.enqueued.length())
+
+	// the promoted tx is removed with the following ones and its nonce is expected again
+	removed, err = pool.RemoveTx(txs[1].Hash)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Hash{txs[1].Hash, txs[2].Hash}, removed)
+
+	assert.Equal(t, uint64(1), acc.getNonce())
+	assert.Equal(t, uint64(1), acc.promoted.length())
+	assert.Equal(t, txs[0], acc.promoted.peek())
+	assert.Len(t, acc.nonceToTx.mapping, 1)
+	assert.Equal(t, uint64(1), pool.gauge.read())
+	assert.Equal(t, int64(1), pool.pending)
+
+	_, err = pool.RemoveTx(txs[1].Hash)
+	assert.ErrorIs(t, err, ErrTxNotFound)
+
+	// the removed tx can be sent again
+	assert.NoError(t, pool.addTx(local, txs[1]))
+}
+
+func TestRebroadcastTx(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	tx := newTx(addr1, 0, 1)
+	require.NoError(t, pool.addTx(local, tx))
+
+	assert.ErrorIs(t, pool.RebroadcastTx(types.StringToHash("0x1")), ErrTxNotFound)
+	assert.ErrorIs(t, pool.RebroadcastTx(tx.Hash), ErrGossipDisabled)
+}
+
 func TestDemote(t *testing.T) {
 	t.Parallel()
 
@@ -3761,3 +3896,19 @@ func BenchmarkAddTxTime(b *testing.B) {
 		}
 	})
 }
+
+func TestValidateGossipTx(t *testing.T) {
+	t.Parallel()
+
+	tx := newTx(types.ZeroAddress, 1, 1)
+
+	assert.Equal(t,
+		network.ValidationAccept,
+		validateGossipTx(&proto.Txn{Raw: &any.Any{Value: tx.MarshalRLP()}}, ""),
+	)
+	assert.Equal(t,
+		network.ValidationReject,
+		validateGossipTx(&proto.Txn{Raw: &any.Any{Value: []byte{0x1, 0x2}}}, ""),
+	)
+	assert.Equal(t, network.ValidationReject, validateGossipTx(&proto.Txn{}, ""))
+}
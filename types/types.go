@@ -206,3 +206,13 @@ type OverrideAccount struct {
 }
 
 type StateOverride map[Address]OverrideAccount
+
+// BlockOverride is the collection of overridden block context fields.
+// Nil fields keep the value of the original block
+type BlockOverride struct {
+	Number    *uint64
+	Timestamp *uint64
+	GasLimit  *uint64
+	Coinbase  *Address
+	BaseFee   *big.Int
+}